@@ -33,6 +33,7 @@ import {
 import {
   getUserData,
   getUserPermissions,
+  logout as logoutSession,
 } from '../modules/oauth2/resources/userResource';
 import { cleanToken } from '../helpers/oauth';
 import { AuthTypes, Menu } from '@/types';
@@ -52,9 +53,13 @@ function AppSidebar({ menus = [], oAuth2 }: AppSidebarProps): JSX.Element {
 
   const logout = useCallback(() => {
     setUser(null);
-    cleanToken();
     userDataFetched.current = false;
-    navigate('/login');
+    logoutSession()
+      .catch(() => toast.error('Failed to end the session on the server'))
+      .finally(() => {
+        cleanToken();
+        navigate('/login');
+      });
   }, [navigate]);
 
   useEffect(() => {
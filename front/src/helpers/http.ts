@@ -1,30 +1,17 @@
-import axios, {
-  AxiosError,
-  AxiosResponse,
-  InternalAxiosRequestConfig,
-} from 'axios';
+import axios, { AxiosResponse } from 'axios';
 import { toast } from 'sonner';
-import { getConfigBearerToken, cleanToken } from './oauth';
+import { cleanToken } from './oauth';
 
 interface ImportMeta {
   env?: Record<string, string>;
 }
 
+// The session cookie authenticates every call, including cross-origin API calls
 const http = axios.create({
   baseURL: (import.meta as ImportMeta).env?.VITE_API_URL,
+  withCredentials: true,
 });
 
-http.interceptors.request.use(
-  (config: InternalAxiosRequestConfig): InternalAxiosRequestConfig => {
-    const bearerConfig = getConfigBearerToken();
-    if (bearerConfig) {
-      config.headers.Authorization = bearerConfig.headers.Authorization;
-    }
-    return config;
-  },
-  (error: AxiosError) => Promise.reject(error)
-);
-
 http.interceptors.response.use(
   (response: AxiosResponse) => response,
   (error: unknown) => {
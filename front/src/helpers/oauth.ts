@@ -1,51 +1,32 @@
 import { getItem, setItem, removeItem } from './localStorage';
 
-const ACCESS_TOKEN_KEY = 'access_token';
+// The session itself lives in an HttpOnly cookie set by the API; this flag only
+// remembers that a login completed, since scripts cannot read that cookie
+const SESSION_KEY = 'session';
+const LOGIN_SUCCESS_PARAM = 'login';
 
-interface UrlVars {
-  [key: string]: string;
+function hasLoginSuccessParam(): boolean {
+  const params = new URLSearchParams(window.location.search);
+  return params.get(LOGIN_SUCCESS_PARAM) === 'success';
 }
 
-interface BearerConfig {
-  headers: {
-    Authorization: string;
-  };
-}
-
-function getUrlVars(): UrlVars {
-  const vars: UrlVars = {};
-  window.location.href.replace(/[?&]+([^=&]+)=([^&]*)/gi, (m, key, value) => {
-    vars[key] = value;
-    return m;
-  });
-  return vars;
-}
-
-function getUrlAccessToken(): string | null {
-  if (window.location.href.indexOf(ACCESS_TOKEN_KEY) > -1) {
-    return getUrlVars().access_token || null;
-  }
-  return null;
-}
-
-export function getToken(): string | null {
-  return getItem(ACCESS_TOKEN_KEY);
+export function hasSession(): boolean {
+  return getItem(SESSION_KEY) != null;
 }
 
 export function verifyToken(): boolean {
-  if (getToken() != null) {
-    return true;
-  }
-  const accessToken = getUrlAccessToken();
-  if (accessToken != null) {
+  if (hasLoginSuccessParam()) {
     const path =
       window.location.pathname === '/login' ? '/' : window.location.pathname;
     window.history.pushState({}, document.title, path);
-    setItem(ACCESS_TOKEN_KEY, accessToken);
+    setItem(SESSION_KEY, 'active');
+    return true;
+  }
+  if (hasSession()) {
     return true;
   }
 
-  // Redirect to login if no token is found
+  // Redirect to login if no session is found
   if (window.location.pathname !== '/login') {
     const currentPath = window.location.pathname;
     window.location.href = `/login?redirect_url=${encodeURIComponent(
@@ -56,17 +37,6 @@ export function verifyToken(): boolean {
   return false;
 }
 
-export function getConfigBearerToken(): BearerConfig | null {
-  const token = getToken();
-  return token
-    ? {
-        headers: {
-          Authorization: `Bearer ${token}`,
-        },
-      }
-    : null;
-}
-
 export function cleanToken(): void {
-  removeItem(ACCESS_TOKEN_KEY);
+  removeItem(SESSION_KEY);
 }
@@ -11,3 +11,7 @@ export function getUserPermissions(): Promise<
 > {
   return http.get('/v1/me/permissions');
 }
+
+export function logout(): Promise<AxiosResponse<void>> {
+  return http.post('/oauth/logout');
+}
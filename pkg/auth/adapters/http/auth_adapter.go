@@ -2,9 +2,13 @@ package http
 
 import (
 	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
 
 	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
 	authWire "github.com/dash-ops/dash-ops/pkg/auth/wire"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 )
 
 // AuthAdapter handles transformation between models and wire formats
@@ -87,6 +91,7 @@ func (aa *AuthAdapter) ModelToSessionResponse(session *authModels.AuthSession) a
 	return authWire.SessionResponse{
 		SessionID: session.SessionID,
 		UserID:    session.UserID,
+		Provider:  session.Provider,
 		CreatedAt: session.CreatedAt,
 		ExpiresAt: session.ExpiresAt,
 		LastUsed:  session.LastUsed,
@@ -95,18 +100,30 @@ func (aa *AuthAdapter) ModelToSessionResponse(session *authModels.AuthSession) a
 	}
 }
 
-// ConfigsToProvidersResponse converts auth configs to providers response
+// ConfigsToProvidersResponse converts auth configs to providers response; the first config is the default provider
 func (aa *AuthAdapter) ConfigsToProvidersResponse(configs []*authModels.AuthConfig) authWire.ProvidersResponse {
-	var providers []authWire.ProviderInfo
+	providers := []authWire.ProviderInfo{}
 
-	for _, config := range configs {
+	for i, config := range configs {
 		if config.Enabled {
+			displayName := config.DisplayName
+			if displayName == "" {
+				displayName = aa.getProviderDisplayName(config.Provider)
+			}
+			// LDAP users post their password instead of being sent to the provider
+			loginURL := "/api/oauth/" + config.GetName()
+			if config.IsLDAP() {
+				loginURL += "/login"
+			}
 			providers = append(providers, authWire.ProviderInfo{
-				Name:        string(config.Provider),
-				DisplayName: aa.getProviderDisplayName(config.Provider),
+				Name:        config.GetName(),
+				Type:        string(config.Provider),
+				DisplayName: displayName,
 				Method:      string(config.Method),
 				Enabled:     config.Enabled,
 				Scopes:      config.GetScopes(),
+				LoginURL:    loginURL,
+				Default:     i == 0,
 			})
 		}
 	}
@@ -149,6 +166,8 @@ func (aa *AuthAdapter) getProviderDisplayName(provider authModels.AuthProvider)
 		return "LDAP"
 	case authModels.ProviderJWT:
 		return "JWT"
+	case authModels.ProviderOIDC:
+		return "OpenID Connect"
 	default:
 		return string(provider)
 	}
@@ -167,8 +186,30 @@ type TokenExchangeRequest struct {
 	RedirectURL string
 }
 
-// UserPermissionsToResponse converts user permissions to response format
-func (aa *AuthAdapter) UserPermissionsToResponse(permissions *authModels.UserPermissions) map[string]interface{} {
+// IDTokenToProfileResponse converts the claims of an ID token to a user profile
+func (aa *AuthAdapter) IDTokenToProfileResponse(idToken *authModels.IDToken) authWire.ProfileResponse {
+	return authWire.ProfileResponse{
+		ID:        idToken.Subject,
+		Login:     idToken.Username,
+		Name:      idToken.Name,
+		Email:     idToken.Email,
+		AvatarURL: idToken.Picture,
+	}
+}
+
+// DirectoryUserToProfileResponse converts a user authenticated against an LDAP server to a user profile
+func (aa *AuthAdapter) DirectoryUserToProfileResponse(user *authModels.DirectoryUser) authWire.ProfileResponse {
+	return authWire.ProfileResponse{
+		ID:    user.DN,
+		Login: user.Username,
+		Name:  user.Name,
+		Email: user.Email,
+	}
+}
+
+// UserPermissionsToResponse converts user permissions to response format.
+// Roles and effective permissions are only included when RBAC is configured.
+func (aa *AuthAdapter) UserPermissionsToResponse(permissions *authModels.UserPermissions, effective *commonsModels.Permissions) map[string]interface{} {
 	// Convert teams to interface{} format for JSON compatibility
 	var teams []map[string]interface{}
 	for _, team := range permissions.Teams {
@@ -179,9 +220,100 @@ func (aa *AuthAdapter) UserPermissionsToResponse(permissions *authModels.UserPer
 		})
 	}
 
-	return map[string]interface{}{
+	response := map[string]interface{}{
 		"organization": permissions.Organization,
 		"teams":        teams,
 		"groups":       permissions.Groups,
 	}
+	if effective != nil {
+		response["roles"] = effective.Roles
+		response["permissions"] = effective.Grants
+	}
+	return response
+}
+
+// UserDataToCommons converts auth user data to the commons representation shared with other modules
+func (aa *AuthAdapter) UserDataToCommons(userData *authModels.UserData, token *oauth2.Token) *commonsModels.UserData {
+	commonsUserData := &commonsModels.UserData{
+		Org:      userData.Org,
+		Groups:   userData.Groups,
+		Username: userData.Username,
+		Email:    userData.Email,
+	}
+	if token != nil {
+		commonsUserData.Token = token.AccessToken
+		commonsUserData.ExpiresAt = token.Expiry
+	}
+	return commonsUserData
+}
+
+// RequestToAPITokenRequest converts a token request to its model; tokens are personal unless asked otherwise
+func (aa *AuthAdapter) RequestToAPITokenRequest(req authWire.CreateAPITokenRequest) *authModels.APITokenRequest {
+	kind := authModels.APITokenKind(req.Kind)
+	if kind == "" {
+		kind = authModels.APITokenPersonal
+	}
+
+	scopes := make([]authModels.RoleGrant, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, authModels.RoleGrant{
+			Permission: scope.Permission,
+			Scope: commonsModels.PermissionScope{
+				Cluster:   scope.Cluster,
+				Namespace: scope.Namespace,
+				Account:   scope.Account,
+				Service:   scope.Service,
+			},
+		})
+	}
+
+	return &authModels.APITokenRequest{
+		Name:      req.Name,
+		Kind:      kind,
+		Scopes:    scopes,
+		ExpiresIn: time.Duration(req.ExpiresInDays) * 24 * time.Hour,
+	}
+}
+
+// APITokenToResponse converts an API token to response format, leaving out its hash
+func (aa *AuthAdapter) APITokenToResponse(token *authModels.APIToken) authWire.APITokenResponse {
+	scopes := make([]authWire.APITokenScope, 0, len(token.Scopes))
+	for _, scope := range token.Scopes {
+		scopes = append(scopes, authWire.APITokenScope{
+			Permission: scope.Permission,
+			Cluster:    scope.Scope.Cluster,
+			Namespace:  scope.Scope.Namespace,
+			Account:    scope.Scope.Account,
+			Service:    scope.Scope.Service,
+		})
+	}
+
+	response := authWire.APITokenResponse{
+		ID:        token.ID,
+		Name:      token.Name,
+		Kind:      string(token.Kind),
+		Hint:      token.Hint,
+		Username:  token.Username,
+		Scopes:    scopes,
+		CreatedBy: token.CreatedBy,
+		CreatedAt: token.CreatedAt,
+		ExpiresAt: token.ExpiresAt,
+	}
+	if !token.LastUsedAt.IsZero() {
+		lastUsedAt := token.LastUsedAt
+		response.LastUsedAt = &lastUsedAt
+	}
+	return response
+}
+
+// APITokenToCommons converts the identity of an API token to the user data shared with other modules
+func (aa *AuthAdapter) APITokenToCommons(token *authModels.APIToken, org string, permissions *commonsModels.Permissions) *commonsModels.UserData {
+	return &commonsModels.UserData{
+		Org:         org,
+		Groups:      append([]string{}, token.Groups...),
+		Username:    token.Username,
+		Email:       token.Email,
+		ExpiresAt:   token.ExpiresAt,
+		Permissions: permissions,
+	}
 }
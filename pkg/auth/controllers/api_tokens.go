@@ -0,0 +1,259 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+var (
+	// ErrAPITokensDisabled is returned when API tokens are used without central roles to scope them
+	ErrAPITokensDisabled = errors.New("api tokens require rbac roles to be configured")
+	// ErrInvalidAPIToken is returned when an API token is unknown, revoked or expired
+	ErrInvalidAPIToken = errors.New("invalid or expired api token")
+	// ErrInvalidAPITokenRequest is returned when the settings of a token to issue are invalid
+	ErrInvalidAPITokenRequest = errors.New("invalid api token request")
+	// ErrAPITokenForbidden is returned when a user may not issue or manage a token
+	ErrAPITokenForbidden = errors.New("not allowed to manage api tokens")
+)
+
+// apiTokenTouchInterval limits how often the last use of an API token is written to storage
+const apiTokenTouchInterval = time.Minute
+
+// SetAPITokenRepository enables API tokens for every provider, stored in the given repository
+func (ac *AuthController) SetAPITokenRepository(apiTokens authPorts.APITokenRepository, manager *authLogic.APITokenManager) {
+	for _, provider := range ac.providers.controllers {
+		provider.apiTokens = apiTokens
+		provider.apiTokenManager = manager
+	}
+}
+
+// APITokensEnabled checks if API tokens can be issued and used; their scopes need central roles
+func (ac *AuthController) APITokensEnabled() bool {
+	return ac.apiTokens != nil && ac.rbacResolver != nil
+}
+
+// CreateAPIToken issues a token for the calling user and returns its secret, which is not stored and shown only once.
+// Service tokens need the token management permission, and every scope must be held by the caller.
+func (ac *AuthController) CreateAPIToken(ctx context.Context, userID string, user *commonsModels.UserData, request *authModels.APITokenRequest) (string, *authModels.APIToken, error) {
+	if !ac.APITokensEnabled() {
+		return "", nil, ErrAPITokensDisabled
+	}
+	if err := request.Validate(); err != nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrInvalidAPITokenRequest, err.Error())
+	}
+	if request.Kind == authModels.APITokenService && !canManageAPITokens(user) {
+		return "", nil, fmt.Errorf("%w: service tokens need the %s permission", ErrAPITokenForbidden, authModels.PermissionManageAPITokens)
+	}
+	if err := ac.apiTokenManager.CheckScopes(request.Scopes, user.Permissions); err != nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrAPITokenForbidden, err.Error())
+	}
+
+	id, err := randomString()
+	if err != nil {
+		return "", nil, err
+	}
+	secret, hash, hint, err := ac.apiTokenManager.GenerateSecret()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
+	token := &authModels.APIToken{
+		ID:        id,
+		Name:      request.Name,
+		Kind:      request.Kind,
+		Hash:      hash,
+		Hint:      hint,
+		Scopes:    request.Scopes,
+		CreatedBy: userID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(request.GetExpiresIn()),
+	}
+	if request.Kind == authModels.APITokenPersonal {
+		token.OwnerID = userID
+		token.Username = user.Username
+		token.Email = user.Email
+		token.Groups = append([]string(nil), user.Groups...)
+	} else {
+		token.Username = "service:" + request.Name
+	}
+
+	if err := ac.apiTokens.DeleteExpired(ctx); err != nil {
+		return "", nil, fmt.Errorf("failed to clean up api tokens: %w", err)
+	}
+	if err := ac.apiTokens.Create(ctx, token); err != nil {
+		return "", nil, fmt.Errorf("failed to store api token: %w", err)
+	}
+	return secret, token, nil
+}
+
+// ListAPITokens returns the tokens issued by or for the calling user, or every token for token managers asking for all
+func (ac *AuthController) ListAPITokens(ctx context.Context, userID string, user *commonsModels.UserData, all bool) ([]*authModels.APIToken, error) {
+	if !ac.APITokensEnabled() {
+		return nil, ErrAPITokensDisabled
+	}
+	if all && !canManageAPITokens(user) {
+		return nil, fmt.Errorf("%w: listing all tokens needs the %s permission", ErrAPITokenForbidden, authModels.PermissionManageAPITokens)
+	}
+
+	tokens, err := ac.apiTokens.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visible := make([]*authModels.APIToken, 0, len(tokens))
+	for _, token := range tokens {
+		if all || ownsAPIToken(userID, token) {
+			visible = append(visible, token)
+		}
+	}
+	sort.Slice(visible, func(i, j int) bool {
+		return visible[i].CreatedAt.Before(visible[j].CreatedAt)
+	})
+	return visible, nil
+}
+
+// GetAPIToken returns a token visible to the calling user; tokens of other users are reported as not found
+func (ac *AuthController) GetAPIToken(ctx context.Context, userID string, user *commonsModels.UserData, id string) (*authModels.APIToken, error) {
+	if !ac.APITokensEnabled() {
+		return nil, ErrAPITokensDisabled
+	}
+
+	token, err := ac.apiTokens.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !ownsAPIToken(userID, token) && !canManageAPITokens(user) {
+		return nil, authPorts.ErrAPITokenNotFound
+	}
+	return token, nil
+}
+
+// RenameAPIToken changes the name of a token; its secret and scopes cannot change
+func (ac *AuthController) RenameAPIToken(ctx context.Context, userID string, user *commonsModels.UserData, id, name string) (*authModels.APIToken, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: token name is required", ErrInvalidAPITokenRequest)
+	}
+	token, err := ac.GetAPIToken(ctx, userID, user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	token.Name = name
+	if err := ac.apiTokens.Update(ctx, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// RevokeAPIToken deletes a token visible to the calling user
+func (ac *AuthController) RevokeAPIToken(ctx context.Context, userID string, user *commonsModels.UserData, id string) error {
+	if _, err := ac.GetAPIToken(ctx, userID, user, id); err != nil {
+		return err
+	}
+	return ac.apiTokens.Delete(ctx, id)
+}
+
+// AuthenticateAPIToken returns the active token of a secret and records its use
+func (ac *AuthController) AuthenticateAPIToken(ctx context.Context, secret string) (*authModels.APIToken, error) {
+	if !ac.APITokensEnabled() {
+		return nil, ErrAPITokensDisabled
+	}
+
+	token, err := ac.apiTokens.GetByHash(ctx, ac.apiTokenManager.HashSecret(secret))
+	if errors.Is(err, authPorts.ErrAPITokenNotFound) {
+		return nil, ErrInvalidAPIToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if token.IsExpired(time.Now()) {
+		return nil, ErrInvalidAPIToken
+	}
+
+	if time.Since(token.LastUsedAt) > apiTokenTouchInterval {
+		token.LastUsedAt = time.Now()
+		if err := ac.apiTokens.Update(ctx, token); err != nil {
+			return nil, fmt.Errorf("failed to update api token: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// APITokenPermissions returns the permissions a token carries.
+// Personal tokens lose the scopes their owner's groups no longer get from the configured roles.
+func (ac *AuthController) APITokenPermissions(token *authModels.APIToken) *commonsModels.Permissions {
+	var held *commonsModels.Permissions
+	if token.Kind == authModels.APITokenPersonal {
+		held = ac.ResolvePermissions(token.Groups)
+	}
+	return ac.apiTokenManager.Permissions(token, held)
+}
+
+// Organization returns the organization DashOps users belong to
+func (ac *AuthController) Organization() string {
+	return ac.config.OrgPermission
+}
+
+// CurrentUserID returns the provider-prefixed ID of the user a request is authenticated as
+func (ac *AuthController) CurrentUserID(ctx context.Context, token *oauth2.Token) (string, error) {
+	if session, ok := SessionFromContext(ctx); ok {
+		return session.UserID, nil
+	}
+	if token == nil {
+		return "", fmt.Errorf("token is required")
+	}
+
+	if ac.isOIDC() {
+		idToken, err := ac.oidcIdentity(ctx, token)
+		if err != nil {
+			return "", err
+		}
+		return ac.ProviderName() + ":" + idToken.Subject, nil
+	}
+	if ac.isLDAP() {
+		return "", fmt.Errorf("%w: ldap users must log in with a session", authPorts.ErrInvalidToken)
+	}
+
+	user, err := ac.githubService.GetUser(ctx, token)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user profile: %w", err)
+	}
+	if user == nil {
+		return "", fmt.Errorf("failed to get user profile")
+	}
+	return ac.ProviderName() + ":" + strconv.FormatInt(user.GetID(), 10), nil
+}
+
+type apiTokenKey struct{}
+
+// WithAPIToken returns a context carrying the API token a request was authenticated with
+func WithAPIToken(ctx context.Context, token *authModels.APIToken) context.Context {
+	return context.WithValue(ctx, apiTokenKey{}, token)
+}
+
+// APITokenFromContext returns the API token a request was authenticated with, if any
+func APITokenFromContext(ctx context.Context) (*authModels.APIToken, bool) {
+	token, ok := ctx.Value(apiTokenKey{}).(*authModels.APIToken)
+	return token, ok && token != nil
+}
+
+// ownsAPIToken checks if a user issued a token or is the one it acts for
+func ownsAPIToken(userID string, token *authModels.APIToken) bool {
+	return token.CreatedBy == userID || token.OwnerID == userID
+}
+
+// canManageAPITokens checks if central roles let a user issue service tokens and manage every token
+func canManageAPITokens(user *commonsModels.UserData) bool {
+	return user.Can(authModels.PermissionManageAPITokens, commonsModels.PermissionScope{})
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	"github.com/dash-ops/dash-ops/pkg/auth/repositories"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// newAPITokenTestController returns a controller where dash-ops*dev may scale prod deployments and dash-ops*admins may manage tokens
+func newAPITokenTestController(t *testing.T) *AuthController {
+	t.Helper()
+	rbac := &authModels.RBACConfig{
+		Roles: []authModels.Role{
+			{Name: "deployer", Permissions: []authModels.RoleGrant{
+				{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod"}},
+			}},
+			{Name: "admin", Permissions: []authModels.RoleGrant{
+				{Permission: "k8s:*"},
+				{Permission: authModels.PermissionManageAPITokens},
+			}},
+		},
+		Bindings: []authModels.RoleBinding{
+			{Group: "dash-ops*dev", Roles: []string{"deployer"}},
+			{Group: "dash-ops*admins", Roles: []string{"admin"}},
+		},
+	}
+	require.NoError(t, rbac.Validate())
+
+	controller := NewAuthController(&authModels.AuthConfig{OrgPermission: "dash-ops"}, authLogic.NewOAuth2Processor(), authLogic.NewSessionManager(24*time.Hour), &MockGitHubService{})
+	controller.SetRBACResolver(authLogic.NewRBACResolver(rbac))
+	controller.SetAPITokenRepository(repositories.NewMemoryAPITokenRepository(), authLogic.NewAPITokenManager())
+	return controller
+}
+
+// apiTokenTestUser returns the user data of a member of the given groups, with the permissions of their roles
+func apiTokenTestUser(controller *AuthController, username string, groups ...string) *commonsModels.UserData {
+	return &commonsModels.UserData{
+		Username:    username,
+		Groups:      groups,
+		Permissions: controller.ResolvePermissions(groups),
+	}
+}
+
+func TestAuthController_CreateAPIToken_WithHeldScope_AuthenticatesWithScopedPermissions(t *testing.T) {
+	// Arrange
+	controller := newAPITokenTestController(t)
+	user := apiTokenTestUser(controller, "octocat", "dash-ops*dev")
+	request := &authModels.APITokenRequest{
+		Name:   "deploy-bot",
+		Kind:   authModels.APITokenPersonal,
+		Scopes: []authModels.RoleGrant{{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments"}}},
+	}
+
+	// Act
+	secret, created, err := controller.CreateAPIToken(context.Background(), "github:1", user, request)
+	require.NoError(t, err)
+	token, authErr := controller.AuthenticateAPIToken(context.Background(), secret)
+	permissions := controller.APITokenPermissions(token)
+
+	// Assert
+	require.NoError(t, authErr)
+	assert.Equal(t, created.ID, token.ID)
+	assert.Equal(t, "octocat", token.Username)
+	assert.NotContains(t, token.Hash, secret)
+	assert.True(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments"}))
+	assert.False(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "prod", Namespace: "billing"}))
+}
+
+func TestAuthController_CreateAPIToken_WithScopeNotHeld_ReturnsForbidden(t *testing.T) {
+	// Arrange
+	controller := newAPITokenTestController(t)
+	user := apiTokenTestUser(controller, "octocat", "dash-ops*dev")
+
+	// Act
+	_, _, personalErr := controller.CreateAPIToken(context.Background(), "github:1", user, &authModels.APITokenRequest{
+		Name:   "too-broad",
+		Kind:   authModels.APITokenPersonal,
+		Scopes: []authModels.RoleGrant{{Permission: "k8s:pods:exec", Scope: commonsModels.PermissionScope{Cluster: "prod"}}},
+	})
+	_, _, serviceErr := controller.CreateAPIToken(context.Background(), "github:1", user, &authModels.APITokenRequest{
+		Name:   "ci",
+		Kind:   authModels.APITokenService,
+		Scopes: []authModels.RoleGrant{{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod"}}},
+	})
+
+	// Assert
+	assert.ErrorIs(t, personalErr, ErrAPITokenForbidden)
+	assert.ErrorIs(t, serviceErr, ErrAPITokenForbidden)
+}
+
+func TestAuthController_AuthenticateAPIToken_WithExpiredOrRevokedToken_ReturnsInvalid(t *testing.T) {
+	// Arrange
+	controller := newAPITokenTestController(t)
+	admin := apiTokenTestUser(controller, "admin", "dash-ops*admins")
+	request := &authModels.APITokenRequest{
+		Name:   "ci",
+		Kind:   authModels.APITokenService,
+		Scopes: []authModels.RoleGrant{{Permission: "k8s:deployments:scale"}},
+	}
+	expiredSecret, expired, err := controller.CreateAPIToken(context.Background(), "github:2", admin, request)
+	require.NoError(t, err)
+	expired.ExpiresAt = time.Now().Add(-time.Minute)
+	require.NoError(t, controller.apiTokens.Update(context.Background(), expired))
+	revokedSecret, revoked, err := controller.CreateAPIToken(context.Background(), "github:2", admin, request)
+	require.NoError(t, err)
+	require.NoError(t, controller.RevokeAPIToken(context.Background(), "github:2", admin, revoked.ID))
+
+	// Act
+	_, expiredErr := controller.AuthenticateAPIToken(context.Background(), expiredSecret)
+	_, revokedErr := controller.AuthenticateAPIToken(context.Background(), revokedSecret)
+
+	// Assert
+	assert.ErrorIs(t, expiredErr, ErrInvalidAPIToken)
+	assert.ErrorIs(t, revokedErr, ErrInvalidAPIToken)
+}
+
+func TestAuthController_RevokeAPIToken_WithTokenOfAnotherUser_ReturnsNotFound(t *testing.T) {
+	// Arrange
+	controller := newAPITokenTestController(t)
+	owner := apiTokenTestUser(controller, "octocat", "dash-ops*dev")
+	other := apiTokenTestUser(controller, "hubot", "dash-ops*dev")
+	admin := apiTokenTestUser(controller, "admin", "dash-ops*admins")
+	_, token, err := controller.CreateAPIToken(context.Background(), "github:1", owner, &authModels.APITokenRequest{
+		Name:   "deploy-bot",
+		Kind:   authModels.APITokenPersonal,
+		Scopes: []authModels.RoleGrant{{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod"}}},
+	})
+	require.NoError(t, err)
+
+	// Act
+	otherErr := controller.RevokeAPIToken(context.Background(), "github:3", other, token.ID)
+	adminErr := controller.RevokeAPIToken(context.Background(), "github:2", admin, token.ID)
+
+	// Assert
+	assert.ErrorIs(t, otherErr, authPorts.ErrAPITokenNotFound)
+	assert.NoError(t, adminErr)
+}
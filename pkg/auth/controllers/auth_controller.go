@@ -2,20 +2,61 @@ package controllers
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
 	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
 	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	"github.com/dash-ops/dash-ops/pkg/auth/repositories"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 	"golang.org/x/oauth2"
 )
 
+var (
+	// ErrInvalidLoginState is returned when a callback does not match a pending login
+	ErrInvalidLoginState = errors.New("invalid or expired login state")
+	// ErrLoginFailed is returned when the provider does not return usable credentials
+	ErrLoginFailed = errors.New("login failed")
+)
+
+// sessionTouchInterval limits how often the last use of a session is written to storage
+const sessionTouchInterval = time.Minute
+
 // AuthController orchestrates authentication business logic
 type AuthController struct {
 	config          *authModels.AuthConfig
 	oauth2Processor *authLogic.OAuth2Processor
 	sessionManager  *authLogic.SessionManager
 	githubService   authPorts.GitHubService
+
+	// OpenID Connect provider, set instead of GitHub for OIDC configs
+	oidcService   authPorts.OIDCService
+	oidcProcessor *authLogic.OIDCProcessor
+	pendingLogins *repositories.PendingLoginsRepository
+
+	// LDAP or Active Directory server checking passwords, set for LDAP configs
+	ldapService authPorts.LDAPService
+
+	sessions authPorts.SessionRepository
+
+	// Central roles; nil when RBAC is not configured
+	rbacResolver *authLogic.RBACResolver
+
+	// API tokens for automation; nil when not enabled
+	apiTokens       authPorts.APITokenRepository
+	apiTokenManager *authLogic.APITokenManager
+
+	// Provider lookups reused across requests made with the same token
+	userDataCache *repositories.TokenCache[*authModels.UserData]
+	profileCache  *repositories.TokenCache[interface{}]
+
+	// Every configured provider, shared by their controllers
+	providers *providerSet
 }
 
 // NewAuthController creates a new auth controller
@@ -25,31 +66,190 @@ func NewAuthController(
 	sessionManager *authLogic.SessionManager,
 	githubService authPorts.GitHubService,
 ) *AuthController {
-	return &AuthController{
+	controller := &AuthController{
 		config:          config,
 		oauth2Processor: oauth2Processor,
 		sessionManager:  sessionManager,
 		githubService:   githubService,
+		userDataCache:   repositories.NewTokenCache[*authModels.UserData](config.GetCacheTTL(), authModels.RejectedTokenCacheTTL, isRejectedToken),
+		profileCache:    repositories.NewTokenCache[interface{}](config.GetCacheTTL(), authModels.RejectedTokenCacheTTL, isRejectedToken),
 	}
+	controller.providers = &providerSet{controllers: []*AuthController{controller}}
+	return controller
+}
+
+// SetOIDCProvider makes the controller authenticate against an OpenID Connect provider
+func (ac *AuthController) SetOIDCProvider(service authPorts.OIDCService, processor *authLogic.OIDCProcessor, pendingLogins *repositories.PendingLoginsRepository) {
+	ac.oidcService = service
+	ac.oidcProcessor = processor
+	ac.pendingLogins = pendingLogins
+}
+
+// SetSessionRepository sets where login sessions of every provider are stored
+func (ac *AuthController) SetSessionRepository(sessions authPorts.SessionRepository) {
+	for _, provider := range ac.providers.controllers {
+		provider.sessions = sessions
+	}
+}
+
+// SetRBACResolver makes the controllers of every provider resolve central roles and permissions for users
+func (ac *AuthController) SetRBACResolver(resolver *authLogic.RBACResolver) {
+	for _, provider := range ac.providers.controllers {
+		provider.rbacResolver = resolver
+	}
+}
+
+// ResolvePermissions returns the effective RBAC permissions of the given groups, or nil when RBAC is not configured
+func (ac *AuthController) ResolvePermissions(groups []string) *commonsModels.Permissions {
+	if ac.rbacResolver == nil {
+		return nil
+	}
+	return ac.rbacResolver.Resolve(groups)
 }
 
 // GenerateAuthURL generates OAuth2 authorization URL
 func (ac *AuthController) GenerateAuthURL(ctx context.Context, redirectURL string) (string, error) {
+	if ac.isOIDC() {
+		return ac.startOIDCLogin(ctx, redirectURL)
+	}
 	return ac.oauth2Processor.GenerateAuthURL(ac.config, redirectURL)
 }
 
+// CompleteLogin handles the provider callback, starts a session holding the provider token
+// and returns where to send the browser
+func (ac *AuthController) CompleteLogin(ctx context.Context, code, state, ipAddress, userAgent string) (string, *authModels.AuthSession, error) {
+	if ac.isOIDC() {
+		return ac.completeOIDCLogin(ctx, code, state, ipAddress, userAgent)
+	}
+
+	token, err := ac.ExchangeCodeForToken(ctx, code)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: failed to exchange code for token: %s", ErrLoginFailed, err.Error())
+	}
+	if !token.Valid() {
+		return "", nil, fmt.Errorf("%w: retrieved invalid token", ErrLoginFailed)
+	}
+
+	githubUser, err := ac.githubService.GetUser(ctx, token)
+	if err != nil || githubUser == nil {
+		return "", nil, fmt.Errorf("%w: failed to get user profile", ErrLoginFailed)
+	}
+	user := &authModels.User{
+		ID:       strconv.FormatInt(githubUser.GetID(), 10),
+		Username: githubUser.GetLogin(),
+		Email:    githubUser.GetEmail(),
+	}
+
+	session, err := ac.startSession(ctx, user, token, nil, nil, ipAddress, userAgent)
+	if err != nil {
+		return "", nil, err
+	}
+	return ac.BuildRedirectURL(state), session, nil
+}
+
+// AuthenticateSession returns the active session of a session ID
+func (ac *AuthController) AuthenticateSession(ctx context.Context, sessionID string) (*authModels.AuthSession, error) {
+	if ac.sessions == nil {
+		return nil, authPorts.ErrSessionNotFound
+	}
+
+	session, err := ac.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ac.sessionManager.ValidateSession(session); err != nil {
+		if deleteErr := ac.sessions.Delete(ctx, sessionID); deleteErr != nil {
+			return nil, fmt.Errorf("failed to delete inactive session: %w", deleteErr)
+		}
+		return nil, err
+	}
+
+	if time.Since(session.LastUsed) > sessionTouchInterval {
+		if err := ac.sessions.UpdateLastUsed(ctx, sessionID); err != nil {
+			return nil, fmt.Errorf("failed to update session: %w", err)
+		}
+	}
+	return session, nil
+}
+
+// Logout revokes a session and forgets what was cached for its token
+func (ac *AuthController) Logout(ctx context.Context, sessionID string) error {
+	if ac.sessions == nil || sessionID == "" {
+		return nil
+	}
+
+	session, err := ac.sessions.GetByID(ctx, sessionID)
+	if errors.Is(err, authPorts.ErrSessionNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := ac.sessions.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+	ac.InvalidateCachedUser(session)
+	return nil
+}
+
+// InvalidateCachedUser drops the user data and profile cached for the token of a session by its provider
+func (ac *AuthController) InvalidateCachedUser(session *authModels.AuthSession) {
+	if session.Token == nil {
+		return
+	}
+	provider := ac.ForSession(session)
+	provider.userDataCache.Invalidate(session.Token.AccessToken)
+	provider.profileCache.Invalidate(session.Token.AccessToken)
+}
+
+// ListSessions returns the sessions of the user owning a session
+func (ac *AuthController) ListSessions(ctx context.Context, current *authModels.AuthSession) ([]*authModels.AuthSession, error) {
+	return ac.sessions.GetByUserID(ctx, current.UserID)
+}
+
+// RevokeSession revokes one of the sessions of the user owning the current session
+func (ac *AuthController) RevokeSession(ctx context.Context, current *authModels.AuthSession, sessionID string) error {
+	session, err := ac.sessions.GetByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != current.UserID {
+		return authPorts.ErrSessionNotFound
+	}
+	if err := ac.sessions.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+	ac.InvalidateCachedUser(session)
+	return nil
+}
+
+// RevokeAllSessions revokes every session of the user owning the current session
+func (ac *AuthController) RevokeAllSessions(ctx context.Context, current *authModels.AuthSession) error {
+	sessions, err := ac.sessions.GetByUserID(ctx, current.UserID)
+	if err != nil {
+		return err
+	}
+	if err := ac.sessions.DeleteByUserID(ctx, current.UserID); err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		ac.InvalidateCachedUser(session)
+	}
+	return nil
+}
+
 // ExchangeCodeForToken exchanges authorization code for access token
 func (ac *AuthController) ExchangeCodeForToken(ctx context.Context, code string) (*oauth2.Token, error) {
 	return ac.oauth2Processor.ExchangeCodeForToken(ctx, ac.config, code)
 }
 
-// BuildRedirectURL builds the final redirect URL with token
-func (ac *AuthController) BuildRedirectURL(token *oauth2.Token, state string) string {
+// BuildRedirectURL builds the final redirect URL; the session travels in a cookie, never in the URL
+func (ac *AuthController) BuildRedirectURL(state string) string {
 	baseURL := ac.config.URLLoginSuccess
 	if state != "" {
 		baseURL += state
 	}
-	return baseURL + "?access_token=" + token.AccessToken
+	return baseURL + "?login=success"
 }
 
 // GetUserProfile gets user profile from provider
@@ -62,12 +262,20 @@ func (ac *AuthController) GetUserProfile(ctx context.Context, token *oauth2.Toke
 		return nil, fmt.Errorf("token is invalid")
 	}
 
-	user, err := ac.githubService.GetUser(ctx, token)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	if ac.isOIDC() {
+		return ac.oidcIdentity(ctx, token)
+	}
+	if ac.isLDAP() {
+		return ac.directoryUser(ctx)
 	}
 
-	return user, nil
+	return ac.profileCache.Get(token.AccessToken, func() (interface{}, error) {
+		user, err := ac.githubService.GetUser(ctx, token)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user profile: %w", err)
+		}
+		return user, nil
+	})
 }
 
 // GetUserPermissions gets user permissions from provider
@@ -81,6 +289,21 @@ func (ac *AuthController) GetUserPermissions(ctx context.Context, token *oauth2.
 	}
 
 	orgPermission := ac.config.OrgPermission
+	if ac.isOIDC() {
+		idToken, err := ac.oidcIdentity(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		return &authModels.UserPermissions{Organization: orgPermission, Teams: []authModels.Team{}, Groups: idToken.Groups}, nil
+	}
+	if ac.isLDAP() {
+		directoryUser, err := ac.directoryUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &authModels.UserPermissions{Organization: orgPermission, Teams: []authModels.Team{}, Groups: directoryUser.Groups}, nil
+	}
+
 	teams, err := ac.githubService.GetUserTeams(ctx, token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch user teams: %w", err)
@@ -92,7 +315,18 @@ func (ac *AuthController) GetUserPermissions(ctx context.Context, token *oauth2.
 
 // ValidateToken validates the provided token
 func (ac *AuthController) ValidateToken(ctx context.Context, token *oauth2.Token) error {
-	return ac.sessionManager.ValidateToken(token)
+	if err := ac.sessionManager.ValidateToken(token); err != nil {
+		return err
+	}
+	if ac.isOIDC() {
+		_, err := ac.verifyIDToken(ctx, token.AccessToken, "")
+		return err
+	}
+	if ac.isLDAP() {
+		_, err := ac.directoryUser(ctx)
+		return err
+	}
+	return nil
 }
 
 // BuildUserData builds user data for context
@@ -101,6 +335,29 @@ func (ac *AuthController) BuildUserData(ctx context.Context, token *oauth2.Token
 		return nil, fmt.Errorf("token is required")
 	}
 
+	orgPermission := ac.config.OrgPermission
+	if ac.isOIDC() {
+		idToken, err := ac.oidcIdentity(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		return ac.oidcProcessor.BuildUserData(idToken, orgPermission), nil
+	}
+	if ac.isLDAP() {
+		directoryUser, err := ac.directoryUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return directoryUser.ToUserData(orgPermission), nil
+	}
+
+	return ac.userDataCache.Get(token.AccessToken, func() (*authModels.UserData, error) {
+		return ac.buildGitHubUserData(ctx, token)
+	})
+}
+
+// buildGitHubUserData resolves user data from the GitHub teams and profile of a token
+func (ac *AuthController) buildGitHubUserData(ctx context.Context, token *oauth2.Token) (*authModels.UserData, error) {
 	orgPermission := ac.config.OrgPermission
 	teams, err := ac.githubService.GetUserTeams(ctx, token)
 	if err != nil {
@@ -108,5 +365,161 @@ func (ac *AuthController) BuildUserData(ctx context.Context, token *oauth2.Token
 	}
 
 	// Process teams to build user data
-	return ac.oauth2Processor.BuildUserData(teams, orgPermission)
+	userData, err := ac.oauth2Processor.BuildUserData(teams, orgPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	// The login identifies the user to other modules, e.g. for Kubernetes impersonation
+	user, err := ac.githubService.GetUser(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user profile: %w", err)
+	}
+	if user != nil {
+		userData.Username = user.GetLogin()
+		userData.Email = user.GetEmail()
+	}
+	return userData, nil
+}
+
+// isOIDC checks if logins go through an OpenID Connect provider
+func (ac *AuthController) isOIDC() bool {
+	return ac.config.IsOIDC() && ac.oidcService != nil
+}
+
+// startOIDCLogin stores a pending login with its PKCE verifier and nonce and returns the provider URL
+func (ac *AuthController) startOIDCLogin(ctx context.Context, redirectPath string) (string, error) {
+	state, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", err
+	}
+
+	login := &authModels.PendingLogin{
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: oauth2.GenerateVerifier(),
+		RedirectPath: redirectPath,
+		ExpiresAt:    time.Now().Add(authModels.PendingLoginTTL),
+	}
+	authURL, err := ac.oidcService.AuthCodeURL(ctx, login.State, login.Nonce, login.CodeVerifier)
+	if err != nil {
+		return "", err
+	}
+
+	ac.pendingLogins.SaveLogin(login)
+	return authURL, nil
+}
+
+// completeOIDCLogin exchanges the code of a pending login and verifies the returned ID token.
+// The session keeps the verified claims, so they are not checked against the ID token expiry again.
+func (ac *AuthController) completeOIDCLogin(ctx context.Context, code, state, ipAddress, userAgent string) (string, *authModels.AuthSession, error) {
+	login, found := ac.pendingLogins.TakeLogin(state)
+	if !found || login.IsExpired(time.Now()) {
+		return "", nil, ErrInvalidLoginState
+	}
+
+	token, err := ac.oidcService.Exchange(ctx, code, login.CodeVerifier)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: failed to exchange code for token: %s", ErrLoginFailed, err.Error())
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return "", nil, fmt.Errorf("%w: provider returned no ID token", ErrLoginFailed)
+	}
+	idToken, err := ac.verifyIDToken(ctx, rawIDToken, login.Nonce)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %s", ErrLoginFailed, err.Error())
+	}
+
+	user := &authModels.User{ID: idToken.Subject, Username: idToken.Username, Email: idToken.Email}
+	session, err := ac.startSession(ctx, user, &oauth2.Token{AccessToken: rawIDToken, TokenType: "Bearer"}, idToken, nil, ipAddress, userAgent)
+	if err != nil {
+		return "", nil, err
+	}
+	return ac.BuildRedirectURL(login.RedirectPath), session, nil
+}
+
+// oidcIdentity returns the claims verified when the session logged in, or verifies an ID token sent as bearer token
+func (ac *AuthController) oidcIdentity(ctx context.Context, token *oauth2.Token) (*authModels.IDToken, error) {
+	if session, ok := SessionFromContext(ctx); ok && session.Identity != nil {
+		return session.Identity, nil
+	}
+	return ac.verifyIDToken(ctx, token.AccessToken, "")
+}
+
+// verifyIDToken verifies an ID token with the provider and maps its claims
+func (ac *AuthController) verifyIDToken(ctx context.Context, rawIDToken, nonce string) (*authModels.IDToken, error) {
+	claims, err := ac.oidcService.VerifyIDToken(ctx, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return ac.oidcProcessor.MapClaims(claims, ac.config), nil
+}
+
+// startSession stores a new session for a logged in user, with the ID token claims or directory user it logged in with.
+// Provider tokens without expiry, and ID tokens whose claims the session keeps, last as long as the session.
+func (ac *AuthController) startSession(ctx context.Context, user *authModels.User, token *oauth2.Token, identity *authModels.IDToken, directory *authModels.DirectoryUser, ipAddress, userAgent string) (*authModels.AuthSession, error) {
+	if ac.sessions == nil {
+		return nil, fmt.Errorf("session storage is not configured")
+	}
+
+	// User IDs are only unique per provider
+	user.ID = ac.ProviderName() + ":" + user.ID
+	session, err := ac.sessionManager.CreateSession(user, &authModels.Token{
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+		TokenType:    token.TokenType,
+		ExpiresAt:    token.Expiry,
+		Provider:     ac.config.Provider,
+	}, ipAddress, userAgent)
+	if err != nil {
+		return nil, err
+	}
+	if session.Token.ExpiresAt.IsZero() || identity != nil {
+		session.Token.ExpiresAt = session.ExpiresAt
+	}
+	session.Username = user.Username
+	session.Email = user.Email
+	session.Identity = identity
+	session.Directory = directory
+	session.Provider = ac.ProviderName()
+
+	if err := ac.sessions.DeleteExpired(ctx); err != nil {
+		return nil, fmt.Errorf("failed to clean up sessions: %w", err)
+	}
+	if err := ac.sessions.Create(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to store session: %w", err)
+	}
+	return session, nil
+}
+
+type sessionKey struct{}
+
+// WithSession returns a context carrying the session a request was authenticated with
+func WithSession(ctx context.Context, session *authModels.AuthSession) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+// SessionFromContext returns the session a request was authenticated with, if any
+func SessionFromContext(ctx context.Context) (*authModels.AuthSession, bool) {
+	session, ok := ctx.Value(sessionKey{}).(*authModels.AuthSession)
+	return session, ok && session != nil
+}
+
+// isRejectedToken checks if a lookup failed because the provider rejected the token, so retrying is pointless
+func isRejectedToken(err error) bool {
+	return errors.Is(err, authPorts.ErrInvalidToken)
+}
+
+// randomString generates an unguessable value for login states and nonces
+func randomString() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate random value: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
 }
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// ErrPasswordLoginUnsupported is returned when a provider logs users in through its own pages instead of with a password
+var ErrPasswordLoginUnsupported = errors.New("provider does not support password login")
+
+// SetLDAPProvider makes the controller check passwords against an LDAP or Active Directory server
+func (ac *AuthController) SetLDAPProvider(service authPorts.LDAPService) {
+	ac.ldapService = service
+}
+
+// UsesPasswordLogin checks if users log in by posting a username and password instead of being sent to the provider
+func (ac *AuthController) UsesPasswordLogin() bool {
+	return ac.isLDAP()
+}
+
+// PasswordLogin checks a username and password against the directory and starts a session.
+// The groups resolved at login are kept with the session, so the directory is only asked again at the next login.
+func (ac *AuthController) PasswordLogin(ctx context.Context, username, password, ipAddress, userAgent string) (*authModels.AuthSession, error) {
+	if !ac.isLDAP() {
+		return nil, ErrPasswordLoginUnsupported
+	}
+
+	directoryUser, err := ac.ldapService.Authenticate(ctx, username, password)
+	if errors.Is(err, authPorts.ErrInvalidCredentials) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrLoginFailed, err.Error())
+	}
+
+	// There is no provider token; the session gets a random one so it is handled like other sessions
+	accessToken, err := randomString()
+	if err != nil {
+		return nil, err
+	}
+
+	user := &authModels.User{
+		ID:       strings.ToLower(directoryUser.Username),
+		Username: directoryUser.Username,
+		Email:    directoryUser.Email,
+	}
+	return ac.startSession(ctx, user, &oauth2.Token{AccessToken: accessToken, TokenType: "Bearer"}, nil, directoryUser, ipAddress, userAgent)
+}
+
+// isLDAP checks if logins go through an LDAP or Active Directory server
+func (ac *AuthController) isLDAP() bool {
+	return ac.config.IsLDAP() && ac.ldapService != nil
+}
+
+// directoryUser returns the user resolved when the session logged in.
+// Directory users only authenticate with sessions, since there is no provider that could check a bearer token.
+func (ac *AuthController) directoryUser(ctx context.Context) (*authModels.DirectoryUser, error) {
+	if session, ok := SessionFromContext(ctx); ok && session.Directory != nil {
+		return session.Directory, nil
+	}
+	return nil, fmt.Errorf("%w: ldap users must log in with a session", authPorts.ErrInvalidToken)
+}
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	"github.com/dash-ops/dash-ops/pkg/auth/repositories"
+)
+
+// MockLDAPService is a mock implementation of LDAPService for testing
+type MockLDAPService struct {
+	AuthenticateFunc func(ctx context.Context, username, password string) (*authModels.DirectoryUser, error)
+}
+
+func (m *MockLDAPService) Authenticate(ctx context.Context, username, password string) (*authModels.DirectoryUser, error) {
+	return m.AuthenticateFunc(ctx, username, password)
+}
+
+// newLDAPTestController returns a controller of an LDAP provider named corp checking passwords with the given service
+func newLDAPTestController(service *MockLDAPService) *AuthController {
+	config := &authModels.AuthConfig{Name: "corp", Provider: authModels.ProviderLDAP, Method: authModels.MethodLDAP, OrgPermission: "dash-ops", URLLoginSuccess: "http://localhost:3000"}
+	controller := NewAuthController(config, authLogic.NewOAuth2Processor(), authLogic.NewSessionManager(24*time.Hour), &MockGitHubService{})
+	controller.SetLDAPProvider(service)
+	controller.SetSessionRepository(repositories.NewMemorySessionRepository())
+	return controller
+}
+
+func TestAuthController_PasswordLogin_WithValidCredentials_StartsSessionWithDirectoryGroups(t *testing.T) {
+	// Arrange
+	controller := newLDAPTestController(&MockLDAPService{
+		AuthenticateFunc: func(ctx context.Context, username, password string) (*authModels.DirectoryUser, error) {
+			return &authModels.DirectoryUser{DN: "uid=jdoe,ou=people,dc=example,dc=com", Username: "JDoe", Email: "jdoe@example.com", Groups: []string{"developers", "staff"}}, nil
+		},
+	})
+
+	// Act
+	session, err := controller.PasswordLogin(context.Background(), "jdoe", "s3cret", "10.0.0.1", "test")
+	require.NoError(t, err)
+	authenticated, err := controller.AuthenticateSession(context.Background(), session.SessionID)
+	require.NoError(t, err)
+	ctx := WithSession(context.Background(), authenticated)
+	token := &oauth2.Token{AccessToken: authenticated.Token.AccessToken}
+	userData, dataErr := controller.ForRequest(ctx).BuildUserData(ctx, token)
+	permissions, permissionsErr := controller.GetUserPermissions(ctx, token)
+
+	// Assert
+	require.NoError(t, dataErr)
+	require.NoError(t, permissionsErr)
+	assert.True(t, controller.UsesPasswordLogin())
+	assert.Equal(t, "corp:jdoe", session.UserID)
+	assert.Equal(t, "corp", session.Provider)
+	assert.NotEmpty(t, session.Token.AccessToken)
+	assert.Equal(t, &authModels.UserData{Username: "JDoe", Email: "jdoe@example.com", Groups: []string{"developers", "staff"}, Org: "dash-ops"}, userData)
+	assert.Equal(t, []string{"developers", "staff"}, permissions.Groups)
+}
+
+func TestAuthController_PasswordLogin_WithRejectedOrFailedLogin_ReturnsError(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		expectedErr error
+	}{
+		{name: "invalid credentials", err: authPorts.ErrInvalidCredentials, expectedErr: authPorts.ErrInvalidCredentials},
+		{name: "directory unreachable", err: errors.New("failed to connect to LDAP server"), expectedErr: ErrLoginFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			controller := newLDAPTestController(&MockLDAPService{
+				AuthenticateFunc: func(ctx context.Context, username, password string) (*authModels.DirectoryUser, error) {
+					return nil, tt.err
+				},
+			})
+
+			// Act
+			session, err := controller.PasswordLogin(context.Background(), "jdoe", "wrong", "10.0.0.1", "test")
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, session)
+		})
+	}
+}
+
+func TestAuthController_ValidateToken_WithLDAPProviderAndNoSession_ReturnsError(t *testing.T) {
+	// Arrange
+	controller := newLDAPTestController(&MockLDAPService{})
+	token := &oauth2.Token{AccessToken: "guessed-token", TokenType: "Bearer"}
+
+	// Act
+	err := controller.ValidateToken(context.Background(), token)
+	_, loginErr := NewAuthController(&authModels.AuthConfig{Provider: authModels.ProviderGitHub}, authLogic.NewOAuth2Processor(), authLogic.NewSessionManager(time.Hour), &MockGitHubService{}).
+		PasswordLogin(context.Background(), "jdoe", "s3cret", "10.0.0.1", "test")
+
+	// Assert
+	assert.ErrorIs(t, err, authPorts.ErrInvalidToken)
+	assert.ErrorIs(t, loginErr, ErrPasswordLoginUnsupported)
+}
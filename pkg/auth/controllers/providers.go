@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// providerSet holds the controllers of every configured provider, the default one first
+type providerSet struct {
+	controllers []*AuthController
+}
+
+// AddProvider creates the controller of another provider, active next to this one.
+// Providers share sessions, central roles and API tokens; each keeps its own login flow and user data cache.
+func (ac *AuthController) AddProvider(config *authModels.AuthConfig, githubService authPorts.GitHubService) *AuthController {
+	provider := NewAuthController(config, ac.oauth2Processor, ac.sessionManager, githubService)
+	provider.sessions = ac.sessions
+	provider.rbacResolver = ac.rbacResolver
+	provider.apiTokens = ac.apiTokens
+	provider.apiTokenManager = ac.apiTokenManager
+
+	provider.providers = ac.providers
+	ac.providers.controllers = append(ac.providers.controllers, provider)
+	return provider
+}
+
+// ProviderName returns the name identifying the provider of this controller in routes, sessions and user IDs
+func (ac *AuthController) ProviderName() string {
+	return ac.config.GetName()
+}
+
+// Provider returns the controller of a provider by name
+func (ac *AuthController) Provider(name string) (*AuthController, bool) {
+	for _, provider := range ac.providers.controllers {
+		if provider.ProviderName() == name {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
+// ProviderConfigs returns the configs of every provider, the default one first
+func (ac *AuthController) ProviderConfigs() []*authModels.AuthConfig {
+	configs := make([]*authModels.AuthConfig, 0, len(ac.providers.controllers))
+	for _, provider := range ac.providers.controllers {
+		configs = append(configs, provider.config)
+	}
+	return configs
+}
+
+// ForSession returns the controller of the provider a session logged in with.
+// Sessions started before several providers were configured belong to the default provider.
+func (ac *AuthController) ForSession(session *authModels.AuthSession) *AuthController {
+	if provider, ok := ac.Provider(session.Provider); ok {
+		return provider
+	}
+	return ac.providers.controllers[0]
+}
+
+// ForRequest returns the controller of the provider a request was authenticated with, the default one otherwise
+func (ac *AuthController) ForRequest(ctx context.Context) *AuthController {
+	if provider, ok := ctx.Value(providerKey{}).(*AuthController); ok && provider != nil {
+		return provider
+	}
+	if session, ok := SessionFromContext(ctx); ok {
+		return ac.ForSession(session)
+	}
+	return ac.providers.controllers[0]
+}
+
+// ForBearerToken returns the controller of the provider accepting a bearer token.
+// ID tokens are verified by the OpenID Connect providers; any other token goes to the first OAuth2 provider.
+// LDAP providers are skipped, as their users only authenticate with sessions.
+func (ac *AuthController) ForBearerToken(ctx context.Context, token *oauth2.Token) (*AuthController, error) {
+	providers := ac.providers.controllers
+	if len(providers) == 1 {
+		return providers[0], providers[0].ValidateToken(ctx, token)
+	}
+
+	err := fmt.Errorf("no provider accepts the token")
+	if isJWT(token.AccessToken) {
+		for _, provider := range providers {
+			if !provider.isOIDC() {
+				continue
+			}
+			if err = provider.ValidateToken(ctx, token); err == nil {
+				return provider, nil
+			}
+		}
+	}
+	for _, provider := range providers {
+		if !provider.isOIDC() && !provider.isLDAP() {
+			return provider, provider.ValidateToken(ctx, token)
+		}
+	}
+	return nil, err
+}
+
+type providerKey struct{}
+
+// WithProvider returns a context carrying the provider a bearer token was accepted by
+func WithProvider(ctx context.Context, provider *AuthController) context.Context {
+	return context.WithValue(ctx, providerKey{}, provider)
+}
+
+// isJWT checks if a token has the three dot-separated parts of a JSON web token
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
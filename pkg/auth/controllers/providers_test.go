@@ -0,0 +1,90 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/repositories"
+)
+
+// newMultiProviderTestController returns a GitHub default provider with an OIDC provider named contractors next to it
+func newMultiProviderTestController(t *testing.T, service *MockOIDCService) (*AuthController, *AuthController) {
+	t.Helper()
+	githubService := &MockGitHubService{
+		GetUserTeamsFunc: func(ctx context.Context, token *oauth2.Token) ([]*github.Team, error) {
+			t.Errorf("GitHub was asked for the teams of token %q", token.AccessToken)
+			return nil, nil
+		},
+	}
+	controller := NewAuthController(&authModels.AuthConfig{Provider: authModels.ProviderGitHub, OrgPermission: "dash-ops"}, authLogic.NewOAuth2Processor(), authLogic.NewSessionManager(24*time.Hour), githubService)
+	contractors := controller.AddProvider(&authModels.AuthConfig{Name: "contractors", Provider: authModels.ProviderOIDC, ClientID: "dash-ops", URLLoginSuccess: "http://localhost:3000"}, &MockGitHubService{})
+	contractors.SetOIDCProvider(service, authLogic.NewOIDCProcessor(), repositories.NewPendingLoginsRepository())
+	controller.SetSessionRepository(repositories.NewMemorySessionRepository())
+	return controller, contractors
+}
+
+func TestAuthController_AddProvider_WithOIDCLogin_TagsSessionAndResolvesGroupsWithItsProvider(t *testing.T) {
+	// Arrange
+	var state string
+	service := &MockOIDCService{
+		AuthCodeURLFunc: func(ctx context.Context, s, n, v string) (string, error) {
+			state = s
+			return "https://sso.example.com/authorize?state=" + s, nil
+		},
+		ExchangeFunc: func(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error) {
+			return (&oauth2.Token{AccessToken: "provider-access-token"}).WithExtra(map[string]interface{}{"id_token": testIDToken}), nil
+		},
+		VerifyFunc: func(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, error) {
+			return map[string]interface{}{"sub": "42", "preferred_username": "jane", "groups": []interface{}{"contractors"}}, nil
+		},
+	}
+	controller, contractors := newMultiProviderTestController(t, service)
+	provider, found := controller.Provider("contractors")
+	require.True(t, found)
+
+	// Act
+	_, err := provider.GenerateAuthURL(context.Background(), "")
+	require.NoError(t, err)
+	_, session, err := provider.CompleteLogin(context.Background(), "code", state, "10.0.0.1", "test")
+	require.NoError(t, err)
+	authenticated, err := controller.AuthenticateSession(context.Background(), session.SessionID)
+	require.NoError(t, err)
+	ctx := WithSession(context.Background(), authenticated)
+	userData, err := controller.ForRequest(ctx).BuildUserData(ctx, &oauth2.Token{AccessToken: authenticated.Token.AccessToken})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "contractors", session.Provider)
+	assert.Equal(t, "contractors:42", session.UserID)
+	assert.Same(t, contractors, controller.ForSession(authenticated))
+	assert.Equal(t, []string{"contractors"}, userData.Groups)
+}
+
+func TestAuthController_ForBearerToken_WithSeveralProviders_PicksProviderOfToken(t *testing.T) {
+	// Arrange
+	service := &MockOIDCService{
+		VerifyFunc: func(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, error) {
+			return map[string]interface{}{"sub": "42"}, nil
+		},
+	}
+	controller, contractors := newMultiProviderTestController(t, service)
+
+	// Act
+	oidcProvider, oidcErr := controller.ForBearerToken(context.Background(), &oauth2.Token{AccessToken: testIDToken})
+	githubProvider, githubErr := controller.ForBearerToken(context.Background(), &oauth2.Token{AccessToken: "gho_token"})
+
+	// Assert
+	require.NoError(t, oidcErr)
+	require.NoError(t, githubErr)
+	assert.Same(t, contractors, oidcProvider)
+	assert.Same(t, controller, githubProvider)
+	assert.Equal(t, []string{"github", "contractors"}, []string{controller.ProviderConfigs()[0].GetName(), controller.ProviderConfigs()[1].GetName()})
+}
@@ -2,23 +2,34 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"net"
 	"net/http"
+	"strings"
 
 	"github.com/gorilla/mux"
 
 	authAdapters "github.com/dash-ops/dash-ops/pkg/auth/adapters/http"
 	authControllers "github.com/dash-ops/dash-ops/pkg/auth/controllers"
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	authWire "github.com/dash-ops/dash-ops/pkg/auth/wire"
 	commonsHttp "github.com/dash-ops/dash-ops/pkg/commons/adapters/http"
 	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 	"golang.org/x/oauth2"
 )
 
+// websocketTokenPrefix marks the WebSocket subprotocol that carries an access token,
+// since browsers cannot set an Authorization header when opening a WebSocket
+const websocketTokenPrefix = "bearer."
+
 // HTTPHandler handles HTTP requests for auth module
 type HTTPHandler struct {
 	controller      *authControllers.AuthController
 	authAdapter     *authAdapters.AuthAdapter
 	responseAdapter *commonsHttp.ResponseAdapter
 	requestAdapter  *commonsHttp.RequestAdapter
+	sessionConfig   authModels.SessionConfig
 }
 
 // NewHTTPHandler creates a new HTTP handler
@@ -27,20 +38,30 @@ func NewHTTPHandler(
 	authAdapter *authAdapters.AuthAdapter,
 	responseAdapter *commonsHttp.ResponseAdapter,
 	requestAdapter *commonsHttp.RequestAdapter,
+	sessionConfig authModels.SessionConfig,
 ) *HTTPHandler {
 	return &HTTPHandler{
 		controller:      controller,
 		authAdapter:     authAdapter,
 		responseAdapter: responseAdapter,
 		requestAdapter:  requestAdapter,
+		sessionConfig:   sessionConfig,
 	}
 }
 
 // RegisterRoutes registers HTTP routes for the auth module
 func (h *HTTPHandler) RegisterRoutes(apiRouter, internalRouter *mux.Router) {
-	// OAuth2 routes (matching original oauth2 module)
+	// OAuth2 routes (matching original oauth2 module); they log in with the default provider
 	apiRouter.HandleFunc("/oauth", h.authorizeHandler).Methods("GET").Name("oauth")
 	apiRouter.HandleFunc("/oauth/redirect", h.redirectHandler).Methods("GET").Name("oauthRedirect")
+	apiRouter.HandleFunc("/oauth/logout", h.logoutHandler).Methods("POST").Name("oauthLogout")
+	apiRouter.HandleFunc("/oauth/providers", h.providersHandler).Methods("GET").Name("oauthProviders")
+	apiRouter.HandleFunc("/oauth/login", h.passwordLoginHandler).Methods("POST").Name("oauthPasswordLogin")
+
+	// Login routes of each configured provider
+	apiRouter.HandleFunc("/oauth/{provider}", h.authorizeHandler).Methods("GET").Name("oauthProvider")
+	apiRouter.HandleFunc("/oauth/{provider}/redirect", h.redirectHandler).Methods("GET").Name("oauthProviderRedirect")
+	apiRouter.HandleFunc("/oauth/{provider}/login", h.passwordLoginHandler).Methods("POST").Name("oauthProviderPasswordLogin")
 
 	// Add middleware to internal router (matching original)
 	internalRouter.Use(h.oAuthMiddleware)
@@ -48,6 +69,14 @@ func (h *HTTPHandler) RegisterRoutes(apiRouter, internalRouter *mux.Router) {
 	// Internal routes (matching original provider handlers)
 	internalRouter.HandleFunc("/me", h.meHandler).Methods("GET", "OPTIONS").Name("userLogger")
 	internalRouter.HandleFunc("/me/permissions", h.mePermissionsHandler).Methods("GET", "OPTIONS").Name("userPermissions")
+	internalRouter.HandleFunc("/me/sessions", h.listSessionsHandler).Methods("GET").Name("userSessions")
+	internalRouter.HandleFunc("/me/sessions", h.revokeAllSessionsHandler).Methods("DELETE").Name("revokeUserSessions")
+	internalRouter.HandleFunc("/me/sessions/{id}", h.revokeSessionHandler).Methods("DELETE").Name("revokeUserSession")
+	internalRouter.HandleFunc("/me/tokens", h.listAPITokensHandler).Methods("GET").Name("userAPITokens")
+	internalRouter.HandleFunc("/me/tokens", h.createAPITokenHandler).Methods("POST").Name("createUserAPIToken")
+	internalRouter.HandleFunc("/me/tokens/{id}", h.getAPITokenHandler).Methods("GET").Name("userAPIToken")
+	internalRouter.HandleFunc("/me/tokens/{id}", h.updateAPITokenHandler).Methods("PATCH").Name("updateUserAPIToken")
+	internalRouter.HandleFunc("/me/tokens/{id}", h.revokeAPITokenHandler).Methods("DELETE").Name("revokeUserAPIToken")
 
 	// Add organization permission middleware if configured
 	// This will be handled by the controller logic
@@ -55,10 +84,18 @@ func (h *HTTPHandler) RegisterRoutes(apiRouter, internalRouter *mux.Router) {
 
 // authorizeHandler handles OAuth2 authorization requests
 func (h *HTTPHandler) authorizeHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.loginProvider(w, r)
+	if !ok {
+		return
+	}
+	if provider.UsesPasswordLogin() {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Provider "+provider.ProviderName()+" logs in with a password at /api/oauth/"+provider.ProviderName()+"/login")
+		return
+	}
 	redirectURL := r.URL.Query().Get("redirect_url")
 
 	// Generate authorization URL using controller
-	authURL, err := h.controller.GenerateAuthURL(r.Context(), redirectURL)
+	authURL, err := provider.GenerateAuthURL(r.Context(), redirectURL)
 	if err != nil {
 		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to generate auth URL: "+err.Error())
 		return
@@ -70,6 +107,10 @@ func (h *HTTPHandler) authorizeHandler(w http.ResponseWriter, r *http.Request) {
 
 // redirectHandler handles OAuth2 callback/redirect requests
 func (h *HTTPHandler) redirectHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.loginProvider(w, r)
+	if !ok {
+		return
+	}
 	code := r.URL.Query().Get("code")
 	state := r.URL.Query().Get("state")
 
@@ -78,27 +119,160 @@ func (h *HTTPHandler) redirectHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Exchange code for token using controller
-	token, err := h.controller.ExchangeCodeForToken(r.Context(), code)
-	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Failed to exchange code for token: "+err.Error())
+	// Exchange code for token and start a session using controller
+	redirectURL, session, err := provider.CompleteLogin(r.Context(), code, state, clientIP(r), r.UserAgent())
+	if errors.Is(err, authControllers.ErrInvalidLoginState) {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	if !token.Valid() {
-		h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Retrieved invalid token")
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, err.Error())
 		return
 	}
 
-	// Build redirect URL using controller
-	redirectURL := h.controller.BuildRedirectURL(token, state)
+	h.setSessionCookie(w, session)
 
 	// Redirect to success URL
 	http.Redirect(w, r, redirectURL, http.StatusPermanentRedirect)
 }
 
+// passwordLoginHandler logs a user in with a username and password checked by an LDAP provider
+func (h *HTTPHandler) passwordLoginHandler(w http.ResponseWriter, r *http.Request) {
+	provider, ok := h.loginProvider(w, r)
+	if !ok {
+		return
+	}
+
+	var req authWire.PasswordLoginRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	session, err := provider.PasswordLogin(r.Context(), req.Username, req.Password, clientIP(r), r.UserAgent())
+	switch {
+	case errors.Is(err, authControllers.ErrPasswordLoginUnsupported):
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, authPorts.ErrInvalidCredentials):
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, err.Error())
+		return
+	case err != nil:
+		h.responseAdapter.WriteError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	h.setSessionCookie(w, session)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, authWire.AuthResponse{RedirectURL: provider.BuildRedirectURL(req.RedirectURL)})
+}
+
+// providersHandler lists the providers users can log in with, for the login page
+func (h *HTTPHandler) providersHandler(w http.ResponseWriter, r *http.Request) {
+	h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.ConfigsToProvidersResponse(h.controller.ProviderConfigs()))
+}
+
+// loginProvider returns the provider named in a login route, the default provider on the routes without a name
+func (h *HTTPHandler) loginProvider(w http.ResponseWriter, r *http.Request) (*authControllers.AuthController, bool) {
+	name, ok := mux.Vars(r)["provider"]
+	if !ok {
+		return h.controller, true
+	}
+	provider, found := h.controller.Provider(name)
+	if !found {
+		h.responseAdapter.WriteError(w, http.StatusNotFound, "Unknown auth provider: "+name)
+		return nil, false
+	}
+	return provider, true
+}
+
+// logoutHandler revokes the session of the browser and clears its cookie
+func (h *HTTPHandler) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	if cookie, err := r.Cookie(h.sessionConfig.GetCookieName()); err == nil {
+		if err := h.controller.Logout(r.Context(), cookie.Value); err != nil {
+			h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to revoke session: "+err.Error())
+			return
+		}
+	}
+
+	h.clearSessionCookie(w)
+	h.responseAdapter.WriteNoContent(w)
+}
+
+// listSessionsHandler lists the sessions of the current user
+func (h *HTTPHandler) listSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	current, ok := authControllers.SessionFromContext(r.Context())
+	if !ok {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Request is not authenticated with a session")
+		return
+	}
+
+	sessions, err := h.controller.ListSessions(r.Context(), current)
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list sessions: "+err.Error())
+		return
+	}
+
+	response := make([]authWire.SessionResponse, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, h.authAdapter.ModelToSessionResponse(session))
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// revokeSessionHandler revokes one session of the current user, e.g. a forgotten browser
+func (h *HTTPHandler) revokeSessionHandler(w http.ResponseWriter, r *http.Request) {
+	current, ok := authControllers.SessionFromContext(r.Context())
+	if !ok {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Request is not authenticated with a session")
+		return
+	}
+
+	sessionID := mux.Vars(r)["id"]
+	err := h.controller.RevokeSession(r.Context(), current, sessionID)
+	if errors.Is(err, authPorts.ErrSessionNotFound) {
+		h.responseAdapter.WriteError(w, http.StatusNotFound, "Session not found")
+		return
+	}
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to revoke session: "+err.Error())
+		return
+	}
+
+	if sessionID == current.SessionID {
+		h.clearSessionCookie(w)
+	}
+	h.responseAdapter.WriteNoContent(w)
+}
+
+// revokeAllSessionsHandler revokes every session of the current user, logging out all browsers
+func (h *HTTPHandler) revokeAllSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	current, ok := authControllers.SessionFromContext(r.Context())
+	if !ok {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Request is not authenticated with a session")
+		return
+	}
+
+	if err := h.controller.RevokeAllSessions(r.Context(), current); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to revoke sessions: "+err.Error())
+		return
+	}
+
+	h.clearSessionCookie(w)
+	h.responseAdapter.WriteNoContent(w)
+}
+
 // meHandler handles user profile requests
 func (h *HTTPHandler) meHandler(w http.ResponseWriter, r *http.Request) {
+	// API tokens answer with the identity they act for
+	if apiToken, ok := authControllers.APITokenFromContext(r.Context()); ok {
+		h.responseAdapter.WriteJSON(w, http.StatusOK, authWire.ProfileResponse{
+			ID:    apiToken.OwnerID,
+			Login: apiToken.Username,
+			Email: apiToken.Email,
+		})
+		return
+	}
+
 	// Get token from context
 	token, ok := r.Context().Value(commonsModels.TokenKey).(*oauth2.Token)
 	if !ok {
@@ -107,18 +281,35 @@ func (h *HTTPHandler) meHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Get user profile using controller
-	user, err := h.controller.GetUserProfile(r.Context(), token)
+	user, err := h.controller.ForRequest(r.Context()).GetUserProfile(r.Context(), token)
 	if err != nil {
 		h.responseAdapter.WriteError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	// OIDC and LDAP users get the same profile fields as GitHub users
+	if idToken, ok := user.(*authModels.IDToken); ok {
+		h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.IDTokenToProfileResponse(idToken))
+		return
+	}
+	if directoryUser, ok := user.(*authModels.DirectoryUser); ok {
+		h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.DirectoryUserToProfileResponse(directoryUser))
+		return
+	}
+
 	// Return user profile (matching original contract)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, user)
 }
 
 // mePermissionsHandler handles user permissions requests
 func (h *HTTPHandler) mePermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	// API tokens carry their scopes instead of the permissions of their owner
+	if apiToken, ok := authControllers.APITokenFromContext(r.Context()); ok {
+		permissions := &authModels.UserPermissions{Organization: h.controller.Organization(), Teams: []authModels.Team{}, Groups: apiToken.Groups}
+		h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.UserPermissionsToResponse(permissions, h.controller.APITokenPermissions(apiToken)))
+		return
+	}
+
 	// Get token from context
 	token, ok := r.Context().Value(commonsModels.TokenKey).(*oauth2.Token)
 	if !ok {
@@ -127,24 +318,173 @@ func (h *HTTPHandler) mePermissionsHandler(w http.ResponseWriter, r *http.Reques
 	}
 
 	// Get user permissions using controller
-	permissions, err := h.controller.GetUserPermissions(r.Context(), token)
+	permissions, err := h.controller.ForRequest(r.Context()).GetUserPermissions(r.Context(), token)
 	if err != nil {
 		h.responseAdapter.WriteError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	// Transform to response format using adapter
-	response := h.authAdapter.UserPermissionsToResponse(permissions)
+	// Transform to response format using adapter, adding the effective permissions of central roles
+	response := h.authAdapter.UserPermissionsToResponse(permissions, h.controller.ResolvePermissions(permissions.Groups))
 
 	// Return permissions (matching original contract)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// oAuthMiddleware validates OAuth2 tokens
+// listAPITokensHandler lists the API tokens of the current user, or all tokens with ?all=true for token managers
+func (h *HTTPHandler) listAPITokensHandler(w http.ResponseWriter, r *http.Request) {
+	userID, userData, ok := h.apiTokenCaller(w, r)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.controller.ListAPITokens(r.Context(), userID, userData, r.URL.Query().Get("all") == "true")
+	if err != nil {
+		h.writeAPITokenError(w, err)
+		return
+	}
+
+	response := make([]authWire.APITokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		response = append(response, h.authAdapter.APITokenToResponse(token))
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// createAPITokenHandler issues an API token; its secret is only returned in this response
+func (h *HTTPHandler) createAPITokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, userData, ok := h.apiTokenCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req authWire.CreateAPITokenRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	secret, token, err := h.controller.CreateAPIToken(r.Context(), userID, userData, h.authAdapter.RequestToAPITokenRequest(req))
+	if err != nil {
+		h.writeAPITokenError(w, err)
+		return
+	}
+
+	h.responseAdapter.WriteCreated(w, "", authWire.CreatedAPITokenResponse{
+		APITokenResponse: h.authAdapter.APITokenToResponse(token),
+		Token:            secret,
+	})
+}
+
+// getAPITokenHandler returns an API token of the current user
+func (h *HTTPHandler) getAPITokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, userData, ok := h.apiTokenCaller(w, r)
+	if !ok {
+		return
+	}
+
+	token, err := h.controller.GetAPIToken(r.Context(), userID, userData, mux.Vars(r)["id"])
+	if err != nil {
+		h.writeAPITokenError(w, err)
+		return
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.APITokenToResponse(token))
+}
+
+// updateAPITokenHandler renames an API token of the current user
+func (h *HTTPHandler) updateAPITokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, userData, ok := h.apiTokenCaller(w, r)
+	if !ok {
+		return
+	}
+
+	var req authWire.UpdateAPITokenRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	token, err := h.controller.RenameAPIToken(r.Context(), userID, userData, mux.Vars(r)["id"], req.Name)
+	if err != nil {
+		h.writeAPITokenError(w, err)
+		return
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, h.authAdapter.APITokenToResponse(token))
+}
+
+// revokeAPITokenHandler revokes an API token of the current user
+func (h *HTTPHandler) revokeAPITokenHandler(w http.ResponseWriter, r *http.Request) {
+	userID, userData, ok := h.apiTokenCaller(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.controller.RevokeAPIToken(r.Context(), userID, userData, mux.Vars(r)["id"]); err != nil {
+		h.writeAPITokenError(w, err)
+		return
+	}
+	h.responseAdapter.WriteNoContent(w)
+}
+
+// apiTokenCaller returns the ID and user data of the user managing API tokens and writes the error response when unavailable.
+// Requests made with an API token cannot manage tokens, so a leaked token cannot mint new ones.
+func (h *HTTPHandler) apiTokenCaller(w http.ResponseWriter, r *http.Request) (string, *commonsModels.UserData, bool) {
+	if _, ok := authControllers.APITokenFromContext(r.Context()); ok {
+		h.responseAdapter.WriteError(w, http.StatusForbidden, "API tokens cannot manage API tokens")
+		return "", nil, false
+	}
+	if !h.controller.APITokensEnabled() {
+		h.writeAPITokenError(w, authControllers.ErrAPITokensDisabled)
+		return "", nil, false
+	}
+
+	userData, ok := r.Context().Value(commonsModels.UserDataKey).(*commonsModels.UserData)
+	if !ok || userData == nil {
+		h.responseAdapter.WriteError(w, http.StatusForbidden, "User permissions are not available")
+		return "", nil, false
+	}
+
+	token, _ := r.Context().Value(commonsModels.TokenKey).(*oauth2.Token)
+	userID, err := h.controller.ForRequest(r.Context()).CurrentUserID(r.Context(), token)
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Failed to identify user: "+err.Error())
+		return "", nil, false
+	}
+	return userID, userData, true
+}
+
+// writeAPITokenError writes the response matching an API token error
+func (h *HTTPHandler) writeAPITokenError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, authControllers.ErrAPITokensDisabled), errors.Is(err, authPorts.ErrAPITokenNotFound):
+		h.responseAdapter.WriteError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, authControllers.ErrInvalidAPITokenRequest):
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, authControllers.ErrAPITokenForbidden):
+		h.responseAdapter.WriteError(w, http.StatusForbidden, err.Error())
+	default:
+		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to manage API tokens: "+err.Error())
+	}
+}
+
+// oAuthMiddleware validates session cookies and OAuth2 bearer tokens
 func (h *HTTPHandler) oAuthMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		const bearerSchema = "Bearer "
 		authHeader := r.Header.Get("Authorization")
+		if authHeader == "" {
+			if token := websocketAccessToken(r); token != "" {
+				authHeader = bearerSchema + token
+			}
+		}
+
+		// Browsers authenticate with the session cookie; the provider token never leaves the server
+		if authHeader == "" {
+			if cookie, err := r.Cookie(h.sessionConfig.GetCookieName()); err == nil && cookie.Value != "" {
+				h.serveSession(w, r, next, cookie.Value)
+				return
+			}
+		}
 
 		if authHeader == "" {
 			h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Authorization header is required")
@@ -162,26 +502,92 @@ func (h *HTTPHandler) oAuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
+		// API tokens are checked against their stored hash instead of the provider
+		if strings.HasPrefix(accessToken, authModels.APITokenPrefix) {
+			h.serveAPIToken(w, r, next, accessToken)
+			return
+		}
+
 		// Create token object
 		token := &oauth2.Token{AccessToken: accessToken, TokenType: "Bearer"}
 
-		// Validate token using controller
-		if err := h.controller.ValidateToken(r.Context(), token); err != nil {
+		// Validate token with the provider that issued it
+		provider, err := h.controller.ForBearerToken(r.Context(), token)
+		if err != nil {
 			h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Invalid token: "+err.Error())
 			return
 		}
 
-		// Add token to context
+		// Add token and its provider to context
 		ctx := context.WithValue(r.Context(), commonsModels.TokenKey, token)
+		ctx = authControllers.WithProvider(ctx, provider)
 		r = r.WithContext(ctx)
 
 		next.ServeHTTP(w, r)
 	})
 }
 
+// serveSession authenticates a request with a session and puts its provider token in the context
+func (h *HTTPHandler) serveSession(w http.ResponseWriter, r *http.Request, next http.Handler, sessionID string) {
+	session, err := h.controller.AuthenticateSession(r.Context(), sessionID)
+	if err != nil {
+		h.clearSessionCookie(w)
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Session expired or revoked")
+		return
+	}
+
+	token := &oauth2.Token{AccessToken: session.Token.AccessToken, TokenType: "Bearer", Expiry: session.Token.ExpiresAt}
+	ctx := context.WithValue(r.Context(), commonsModels.TokenKey, token)
+	ctx = authControllers.WithSession(ctx, session)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// serveAPIToken authenticates a request with an API token and puts the token in the context
+func (h *HTTPHandler) serveAPIToken(w http.ResponseWriter, r *http.Request, next http.Handler, secret string) {
+	apiToken, err := h.controller.AuthenticateAPIToken(r.Context(), secret)
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Invalid token: "+err.Error())
+		return
+	}
+	next.ServeHTTP(w, r.WithContext(authControllers.WithAPIToken(r.Context(), apiToken)))
+}
+
+// setSessionCookie hands the session ID to the browser in a cookie scripts cannot read
+func (h *HTTPHandler) setSessionCookie(w http.ResponseWriter, session *authModels.AuthSession) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.sessionConfig.GetCookieName(),
+		Value:    session.SessionID,
+		Path:     "/api",
+		Expires:  session.ExpiresAt,
+		Secure:   !h.sessionConfig.InsecureCookie,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// clearSessionCookie removes the session cookie from the browser
+func (h *HTTPHandler) clearSessionCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     h.sessionConfig.GetCookieName(),
+		Value:    "",
+		Path:     "/api",
+		MaxAge:   -1,
+		Secure:   !h.sessionConfig.InsecureCookie,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
 // OrgPermissionMiddleware validates organization permissions
 func (h *HTTPHandler) OrgPermissionMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// API tokens act for the identity stored with them, limited to their scopes
+		if apiToken, ok := authControllers.APITokenFromContext(r.Context()); ok {
+			userData := h.authAdapter.APITokenToCommons(apiToken, h.controller.Organization(), h.controller.APITokenPermissions(apiToken))
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), commonsModels.UserDataKey, userData)))
+			return
+		}
+
 		// Get token from context
 		token, ok := r.Context().Value(commonsModels.TokenKey).(*oauth2.Token)
 		if !ok {
@@ -190,16 +596,43 @@ func (h *HTTPHandler) OrgPermissionMiddleware(next http.Handler) http.Handler {
 		}
 
 		// Build user data using controller
-		userData, err := h.controller.BuildUserData(r.Context(), token)
+		userData, err := h.controller.ForRequest(r.Context()).BuildUserData(r.Context(), token)
 		if err != nil {
 			h.responseAdapter.WriteError(w, http.StatusUnauthorized, "Failed to validate organization permissions: "+err.Error())
 			return
 		}
 
-		// Add user data to context
-		ctx := context.WithValue(r.Context(), commonsModels.UserDataKey, userData)
+		// Add user data to context in the shared commons format, with the permissions of its central roles
+		commonsUserData := h.authAdapter.UserDataToCommons(userData, token)
+		commonsUserData.Permissions = h.controller.ResolvePermissions(userData.Groups)
+		ctx := context.WithValue(r.Context(), commonsModels.UserDataKey, commonsUserData)
 		r = r.WithContext(ctx)
 
 		next.ServeHTTP(w, r)
 	})
 }
+
+// websocketAccessToken returns the access token offered as a WebSocket subprotocol, if any
+func websocketAccessToken(r *http.Request) string {
+	if !strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		return ""
+	}
+	for _, header := range r.Header.Values("Sec-WebSocket-Protocol") {
+		for _, protocol := range strings.Split(header, ",") {
+			protocol = strings.TrimSpace(protocol)
+			if strings.HasPrefix(protocol, websocketTokenPrefix) {
+				return strings.TrimPrefix(protocol, websocketTokenPrefix)
+			}
+		}
+	}
+	return ""
+}
+
+// clientIP returns the address a request came from, without its port
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
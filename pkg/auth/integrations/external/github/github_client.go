@@ -2,9 +2,14 @@ package github
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/google/go-github/github"
 	"golang.org/x/oauth2"
+
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
 )
 
 // GitHubClient handles communication with GitHub API
@@ -23,7 +28,7 @@ func NewGitHubClient(oauthConfig *oauth2.Config) *GitHubClient {
 func (c *GitHubClient) GetUser(ctx context.Context, token *oauth2.Token) (*github.User, error) {
 	client := c.createAuthenticatedClient(token)
 	user, _, err := client.Users.Get(ctx, "")
-	return user, err
+	return user, providerError(err)
 }
 
 // GetUserTeams gets user teams from GitHub API
@@ -31,10 +36,19 @@ func (c *GitHubClient) GetUserTeams(ctx context.Context, token *oauth2.Token) ([
 	client := c.createAuthenticatedClient(token)
 	opt := github.ListOptions{}
 	teams, _, err := client.Teams.ListUserTeams(ctx, &opt)
-	return teams, err
+	return teams, providerError(err)
 }
 
 // createAuthenticatedClient creates an authenticated GitHub client
 func (c *GitHubClient) createAuthenticatedClient(token *oauth2.Token) *github.Client {
 	return github.NewClient(c.oauthConfig.Client(context.Background(), token))
 }
+
+// providerError marks errors caused by GitHub rejecting the token
+func providerError(err error) error {
+	var errorResponse *github.ErrorResponse
+	if errors.As(err, &errorResponse) && errorResponse.Response != nil && errorResponse.Response.StatusCode == http.StatusUnauthorized {
+		return fmt.Errorf("%w: %s", ports.ErrInvalidToken, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,25 @@
+package ldap
+
+import (
+	"context"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// LDAPAdapter exposes an LDAP or Active Directory server to the auth domain
+type LDAPAdapter struct {
+	client *LDAPClient
+}
+
+// NewLDAPAdapter creates a new LDAP adapter
+func NewLDAPAdapter(config *authModels.AuthConfig) ports.LDAPService {
+	return &LDAPAdapter{
+		client: NewLDAPClient(&config.LDAP),
+	}
+}
+
+// Authenticate checks the password of a user with a bind and returns the user with their groups
+func (a *LDAPAdapter) Authenticate(ctx context.Context, username, password string) (*authModels.DirectoryUser, error) {
+	return a.client.Authenticate(ctx, username, password)
+}
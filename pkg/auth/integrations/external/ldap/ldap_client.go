@@ -0,0 +1,223 @@
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// LDAPClient handles communication with an LDAP or Active Directory server
+type LDAPClient struct {
+	config *authModels.LDAPConfig
+}
+
+// NewLDAPClient creates a new LDAP client; each login uses its own connection
+func NewLDAPClient(config *authModels.LDAPConfig) *LDAPClient {
+	return &LDAPClient{config: config}
+}
+
+// Authenticate finds a user, checks their password with a bind and resolves their groups.
+// Unknown users and wrong passwords return the same error, so logins cannot probe for usernames.
+func (c *LDAPClient) Authenticate(ctx context.Context, username, password string) (*authModels.DirectoryUser, error) {
+	// An empty password would be an unauthenticated bind, which servers accept for any DN
+	if username == "" || password == "" {
+		return nil, ports.ErrInvalidCredentials
+	}
+
+	conn, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := c.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+	user, err := c.findUser(conn, username)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Bind(user.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ports.ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to bind as user: %w", err)
+	}
+
+	// Groups are searched with the service account, which may read more than the user
+	if err := c.bindServiceAccount(conn); err != nil {
+		return nil, err
+	}
+	if user.Groups, err = c.findGroups(conn, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// connect opens a connection, encrypted with LDAPS or StartTLS when configured
+func (c *LDAPClient) connect(ctx context.Context) (*goldap.Conn, error) {
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: c.config.GetTimeout()}
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.Deadline = deadline
+	}
+	conn, err := goldap.DialURL(c.config.URL, goldap.DialWithDialer(dialer), goldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to LDAP server: %w", err)
+	}
+	conn.SetTimeout(c.config.GetTimeout())
+
+	if c.config.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("failed to start TLS: %w", err)
+		}
+	}
+	return conn, nil
+}
+
+// tlsConfig builds the TLS settings, trusting the configured CA in addition to the system ones
+func (c *LDAPClient) tlsConfig() (*tls.Config, error) {
+	server, err := url.Parse(c.config.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         server.Hostname(),
+		InsecureSkipVerify: c.config.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if c.config.CAFile != "" {
+		pem, err := os.ReadFile(c.config.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read LDAP CA file: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("LDAP CA file %s has no certificates", c.config.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// bindServiceAccount binds with the configured service account; without one, searches stay anonymous
+func (c *LDAPClient) bindServiceAccount(conn *goldap.Conn) error {
+	if c.config.BindDN == "" {
+		return nil
+	}
+	if err := conn.Bind(c.config.BindDN, c.config.BindPassword); err != nil {
+		return fmt.Errorf("failed to bind service account: %w", err)
+	}
+	return nil
+}
+
+// findUser returns the only entry matching the user filter
+func (c *LDAPClient) findUser(conn *goldap.Conn, username string) (*authModels.DirectoryUser, error) {
+	filter := strings.ReplaceAll(c.config.GetUserFilter(), "{username}", goldap.EscapeFilter(username))
+	attributes := []string{c.config.GetUsernameAttribute(), c.config.GetEmailAttribute(), c.config.GetNameAttribute()}
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		c.config.UserSearchBase, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		2, 0, false, filter, attributes, nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("failed to search user: %w", err)
+	}
+	if len(result.Entries) == 0 {
+		return nil, ports.ErrInvalidCredentials
+	}
+	if len(result.Entries) > 1 {
+		return nil, fmt.Errorf("user filter matches several entries for %q", username)
+	}
+
+	entry := result.Entries[0]
+	user := &authModels.DirectoryUser{
+		DN:       entry.DN,
+		Username: entry.GetAttributeValue(c.config.GetUsernameAttribute()),
+		Email:    entry.GetAttributeValue(c.config.GetEmailAttribute()),
+		Name:     entry.GetAttributeValue(c.config.GetNameAttribute()),
+	}
+	if user.Username == "" {
+		user.Username = username
+	}
+	return user, nil
+}
+
+// findGroups returns the names of the groups of a user, sorted.
+// With nested groups, the groups of each group are followed too, skipping groups already seen so cycles end.
+func (c *LDAPClient) findGroups(conn *goldap.Conn, user *authModels.DirectoryUser) ([]string, error) {
+	names := make(map[string]bool)
+	seen := map[string]bool{strings.ToLower(user.DN): true}
+	members := []string{user.DN}
+
+	for depth := 0; len(members) > 0 && depth < authModels.MaxLDAPGroupDepth; depth++ {
+		var next []string
+		for _, member := range members {
+			entries, err := c.searchGroups(conn, member, user.Username)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				if name := entry.GetAttributeValue(c.config.GetGroupNameAttribute()); name != "" {
+					names[name] = true
+				}
+				if key := strings.ToLower(entry.DN); !seen[key] {
+					seen[key] = true
+					next = append(next, entry.DN)
+				}
+			}
+		}
+		if !c.config.NestedGroups {
+			break
+		}
+		members = next
+	}
+
+	groups := make([]string, 0, len(names))
+	for name := range names {
+		groups = append(groups, name)
+	}
+	sort.Strings(groups)
+	return groups, nil
+}
+
+// searchGroups returns the groups a DN is a direct member of
+func (c *LDAPClient) searchGroups(conn *goldap.Conn, memberDN, username string) ([]*goldap.Entry, error) {
+	filter := strings.ReplaceAll(c.config.GetGroupFilter(), "{dn}", goldap.EscapeFilter(memberDN))
+	filter = strings.ReplaceAll(filter, "{username}", goldap.EscapeFilter(username))
+
+	result, err := conn.Search(goldap.NewSearchRequest(
+		c.config.GetGroupSearchBase(), goldap.ScopeWholeSubtree, goldap.NeverDerefAliases,
+		0, 0, false, filter, []string{c.config.GetGroupNameAttribute()}, nil,
+	))
+	if err != nil {
+		var ldapErr *goldap.Error
+		if errors.As(err, &ldapErr) && ldapErr.ResultCode == goldap.LDAPResultNoSuchObject {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to search groups: %w", err)
+	}
+	return result.Entries, nil
+}
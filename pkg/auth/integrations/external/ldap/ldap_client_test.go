@@ -0,0 +1,415 @@
+package ldap
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+const (
+	testServiceDN = "cn=dash-ops,ou=services,dc=example,dc=com"
+	testUserDN    = "uid=jdoe,ou=people,dc=example,dc=com"
+)
+
+func TestLDAPClient_Authenticate_WithNestedGroups_ResolvesGroupsOfGroups(t *testing.T) {
+	// Arrange
+	directory := newTestDirectory(t, testEntries(), nil, false)
+	config := testLDAPConfig(directory)
+	config.NestedGroups = true
+	client := NewLDAPClient(config)
+
+	// Act
+	user, err := client.Authenticate(context.Background(), "jdoe", "s3cret")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, testUserDN, user.DN)
+	assert.Equal(t, "jdoe", user.Username)
+	assert.Equal(t, "jdoe@example.com", user.Email)
+	assert.Equal(t, "Jane Doe", user.Name)
+	assert.Equal(t, []string{"developers", "engineering", "staff"}, user.Groups, "the engineering/staff cycle must end")
+	assert.Equal(t, []string{testServiceDN, testUserDN, testServiceDN}, directory.Binds())
+}
+
+func TestLDAPClient_Authenticate_WithoutNestedGroups_ReturnsDirectGroups(t *testing.T) {
+	// Arrange
+	directory := newTestDirectory(t, testEntries(), nil, false)
+	client := NewLDAPClient(testLDAPConfig(directory))
+
+	// Act
+	user, err := client.Authenticate(context.Background(), "jdoe", "s3cret")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"developers"}, user.Groups)
+}
+
+func TestLDAPClient_Authenticate_WithInvalidCredentials_ReturnsInvalidCredentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{name: "wrong password", username: "jdoe", password: "wrong"},
+		{name: "unknown user", username: "nobody", password: "s3cret"},
+		{name: "filter injection", username: "*", password: "s3cret"},
+		{name: "empty password", username: "jdoe", password: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			directory := newTestDirectory(t, testEntries(), nil, false)
+			client := NewLDAPClient(testLDAPConfig(directory))
+
+			// Act
+			user, err := client.Authenticate(context.Background(), tt.username, tt.password)
+
+			// Assert
+			assert.ErrorIs(t, err, ports.ErrInvalidCredentials)
+			assert.Nil(t, user)
+			assert.NotContains(t, directory.Binds(), testUserDN)
+		})
+	}
+}
+
+func TestLDAPClient_Authenticate_WithTLS_EncryptsConnection(t *testing.T) {
+	tests := []struct {
+		name        string
+		implicitTLS bool
+	}{
+		{name: "StartTLS", implicitTLS: false},
+		{name: "LDAPS", implicitTLS: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			serverTLS, caFile := testCertificate(t)
+			directory := newTestDirectory(t, testEntries(), serverTLS, tt.implicitTLS)
+			config := testLDAPConfig(directory)
+			config.StartTLS = !tt.implicitTLS
+			config.CAFile = caFile
+			client := NewLDAPClient(config)
+
+			// Act
+			user, err := client.Authenticate(context.Background(), "jdoe", "s3cret")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, "jdoe", user.Username)
+			assert.True(t, directory.Encrypted())
+		})
+	}
+}
+
+// testLDAPConfig returns the settings of a provider searching the test directory with a service account
+func testLDAPConfig(directory *testDirectory) *authModels.LDAPConfig {
+	return &authModels.LDAPConfig{
+		URL:             directory.URL(),
+		BindDN:          testServiceDN,
+		BindPassword:    "service-secret",
+		UserSearchBase:  "ou=people,dc=example,dc=com",
+		GroupSearchBase: "ou=groups,dc=example,dc=com",
+		Timeout:         "5s",
+	}
+}
+
+// testEntries returns a directory where jdoe is in developers, developers in engineering, and engineering and staff contain each other
+func testEntries() []testEntry {
+	return []testEntry{
+		{dn: testServiceDN, password: "service-secret"},
+		{dn: testUserDN, password: "s3cret", attributes: map[string][]string{
+			"uid": {"jdoe"}, "mail": {"jdoe@example.com"}, "cn": {"Jane Doe"},
+		}},
+		{dn: "uid=other,ou=people,dc=example,dc=com", password: "other", attributes: map[string][]string{"uid": {"other"}}},
+		{dn: "cn=developers,ou=groups,dc=example,dc=com", attributes: map[string][]string{
+			"cn": {"developers"}, "member": {testUserDN},
+		}},
+		{dn: "cn=engineering,ou=groups,dc=example,dc=com", attributes: map[string][]string{
+			"cn": {"engineering"}, "member": {"CN=Developers,OU=Groups,DC=example,DC=com", "cn=staff,ou=groups,dc=example,dc=com"},
+		}},
+		{dn: "cn=staff,ou=groups,dc=example,dc=com", attributes: map[string][]string{
+			"cn": {"staff"}, "member": {"cn=engineering,ou=groups,dc=example,dc=com"},
+		}},
+		{dn: "cn=finance,ou=groups,dc=example,dc=com", attributes: map[string][]string{
+			"cn": {"finance"}, "member": {"uid=other,ou=people,dc=example,dc=com"},
+		}},
+	}
+}
+
+// testEntry represents an entry of the test directory; entries with a password accept binds
+type testEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// testDirectory is an in-process LDAP server supporting simple binds, searches with
+// and/or/not/equality/presence filters, StartTLS and LDAPS
+type testDirectory struct {
+	listener    net.Listener
+	entries     []testEntry
+	tlsConfig   *tls.Config
+	implicitTLS bool
+
+	mu        sync.Mutex
+	binds     []string
+	encrypted bool
+}
+
+// newTestDirectory starts a test directory; with a TLS config it accepts StartTLS, or only TLS connections when implicitTLS is set
+func newTestDirectory(t *testing.T, entries []testEntry, tlsConfig *tls.Config, implicitTLS bool) *testDirectory {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	if implicitTLS {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+
+	directory := &testDirectory{listener: listener, entries: entries, tlsConfig: tlsConfig, implicitTLS: implicitTLS}
+	go directory.serve()
+	t.Cleanup(func() { listener.Close() })
+	return directory
+}
+
+// URL returns the address of the directory
+func (d *testDirectory) URL() string {
+	if d.implicitTLS {
+		return "ldaps://" + d.listener.Addr().String()
+	}
+	return "ldap://" + d.listener.Addr().String()
+}
+
+// Binds returns the DNs of the successful binds
+func (d *testDirectory) Binds() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string(nil), d.binds...)
+}
+
+// Encrypted checks if a connection used TLS
+func (d *testDirectory) Encrypted() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.encrypted
+}
+
+func (d *testDirectory) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		if d.implicitTLS {
+			d.mu.Lock()
+			d.encrypted = true
+			d.mu.Unlock()
+		}
+		go d.handle(conn)
+	}
+}
+
+// handle answers the requests of one connection until it is closed or unbound
+func (d *testDirectory) handle(conn net.Conn) {
+	defer func() { conn.Close() }()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		id, _ := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+
+		switch request.Tag {
+		case goldap.ApplicationBindRequest:
+			dn, _ := request.Children[1].Value.(string)
+			d.write(conn, id, ldapResult(goldap.ApplicationBindResponse, d.bind(dn, request.Children[2].Data.String()), ""))
+		case goldap.ApplicationSearchRequest:
+			d.search(conn, id, request)
+		case goldap.ApplicationExtendedRequest:
+			if request.Children[0].Data.String() != "1.3.6.1.4.1.1466.20037" || d.tlsConfig == nil {
+				d.write(conn, id, ldapResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultProtocolError, "unsupported"))
+				continue
+			}
+			d.write(conn, id, ldapResult(goldap.ApplicationExtendedResponse, goldap.LDAPResultSuccess, ""))
+			conn = tls.Server(conn, d.tlsConfig)
+			d.mu.Lock()
+			d.encrypted = true
+			d.mu.Unlock()
+		default:
+			return
+		}
+	}
+}
+
+// bind checks the password of an entry and records successful binds
+func (d *testDirectory) bind(dn, password string) int64 {
+	for _, entry := range d.entries {
+		if strings.EqualFold(entry.dn, dn) && entry.password != "" && entry.password == password {
+			d.mu.Lock()
+			d.binds = append(d.binds, entry.dn)
+			d.mu.Unlock()
+			return goldap.LDAPResultSuccess
+		}
+	}
+	return goldap.LDAPResultInvalidCredentials
+}
+
+// search returns the entries below the base object matching the filter
+func (d *testDirectory) search(conn net.Conn, id int64, request *ber.Packet) {
+	base, _ := request.Children[0].Value.(string)
+	sizeLimit, _ := request.Children[3].Value.(int64)
+	filter := request.Children[6]
+	var attributes []string
+	for _, attribute := range request.Children[7].Children {
+		name, _ := attribute.Value.(string)
+		attributes = append(attributes, name)
+	}
+
+	found := int64(0)
+	for _, entry := range d.entries {
+		if !isBelow(entry.dn, base) || !entry.matches(filter) {
+			continue
+		}
+		if sizeLimit > 0 && found == sizeLimit {
+			d.write(conn, id, ldapResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSizeLimitExceeded, ""))
+			return
+		}
+		found++
+		d.write(conn, id, entry.packet(attributes))
+	}
+	d.write(conn, id, ldapResult(goldap.ApplicationSearchResultDone, goldap.LDAPResultSuccess, ""))
+}
+
+func (d *testDirectory) write(conn net.Conn, id int64, response *ber.Packet) {
+	message := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	message.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "MessageID"))
+	message.AppendChild(response)
+	_, _ = conn.Write(message.Bytes())
+}
+
+// matches evaluates a search filter against the entry; attribute values compare case-insensitively, like DNs
+func (e testEntry) matches(filter *ber.Packet) bool {
+	switch filter.Tag {
+	case goldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !e.matches(child) {
+				return false
+			}
+		}
+		return true
+	case goldap.FilterOr:
+		for _, child := range filter.Children {
+			if e.matches(child) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterNot:
+		return !e.matches(filter.Children[0])
+	case goldap.FilterEqualityMatch:
+		attribute, _ := filter.Children[0].Value.(string)
+		value, _ := filter.Children[1].Value.(string)
+		for _, candidate := range e.values(attribute) {
+			if strings.EqualFold(candidate, value) {
+				return true
+			}
+		}
+		return false
+	case goldap.FilterPresent:
+		return len(e.values(filter.Data.String())) > 0
+	default:
+		return false
+	}
+}
+
+func (e testEntry) values(attribute string) []string {
+	for name, values := range e.attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+// packet encodes the entry as a search result with the requested attributes
+func (e testEntry) packet(attributes []string) *ber.Packet {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "DN"))
+	list := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, name := range attributes {
+		values := e.values(name)
+		if len(values) == 0 {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		list.AppendChild(attribute)
+	}
+	response.AppendChild(list)
+	return response
+}
+
+// ldapResult encodes an LDAP result of the given response type
+func ldapResult(tag ber.Tag, code int64, message string) *ber.Packet {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	response.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "Result Code"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, message, "Diagnostic Message"))
+	return response
+}
+
+// isBelow checks if a DN is the base object or one of its descendants
+func isBelow(dn, base string) bool {
+	dn, base = strings.ToLower(dn), strings.ToLower(base)
+	return dn == base || strings.HasSuffix(dn, ","+base)
+}
+
+// testCertificate returns a server TLS config for 127.0.0.1 and the path of the PEM file trusting it
+func testCertificate(t *testing.T) (*tls.Config, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}, caFile
+}
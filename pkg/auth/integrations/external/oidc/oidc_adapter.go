@@ -0,0 +1,37 @@
+package oidc
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// OIDCAdapter exposes an OpenID Connect provider to the auth domain
+type OIDCAdapter struct {
+	client *OIDCClient
+}
+
+// NewOIDCAdapter creates a new OIDC adapter
+func NewOIDCAdapter(config *authModels.AuthConfig) ports.OIDCService {
+	return &OIDCAdapter{
+		client: NewOIDCClient(config),
+	}
+}
+
+// AuthCodeURL returns the authorization URL of a login using PKCE
+func (a *OIDCAdapter) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	return a.client.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+// Exchange exchanges an authorization code, proving the login with its PKCE verifier
+func (a *OIDCAdapter) Exchange(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error) {
+	return a.client.Exchange(ctx, code, codeVerifier)
+}
+
+// VerifyIDToken verifies an ID token and returns its claims
+func (a *OIDCAdapter) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, error) {
+	return a.client.VerifyIDToken(ctx, rawIDToken, nonce)
+}
@@ -0,0 +1,136 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// requestTimeout bounds each call to the identity provider
+const requestTimeout = 10 * time.Second
+
+// OIDCClient handles communication with an OpenID Connect provider
+type OIDCClient struct {
+	config     *authModels.AuthConfig
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCClient creates a new OIDC client; discovery happens on first use
+func NewOIDCClient(config *authModels.AuthConfig) *OIDCClient {
+	return &OIDCClient{
+		config:     config,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// AuthCodeURL returns the authorization URL of a login using PKCE
+func (c *OIDCClient) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	oauthConfig, err := c.oauth2Config(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauthConfig.AuthCodeURL(state,
+		oauth2.S256ChallengeOption(codeVerifier),
+		oauth2.SetAuthURLParam("nonce", nonce),
+	), nil
+}
+
+// Exchange exchanges an authorization code, proving the login with its PKCE verifier
+func (c *OIDCClient) Exchange(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error) {
+	oauthConfig, err := c.oauth2Config(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+	return oauthConfig.Exchange(ctx, code, oauth2.VerifierOption(codeVerifier))
+}
+
+// VerifyIDToken verifies the signature, issuer, audience and expiry of an ID token and returns its claims.
+// Keys are fetched from the provider JWKS, again whenever a token names a key ID not seen before.
+func (c *OIDCClient) VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, error) {
+	if _, err := c.discover(ctx); err != nil {
+		return nil, err
+	}
+
+	idToken, err := c.verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ports.ErrInvalidIDToken, err.Error())
+	}
+	if idToken.Subject == "" {
+		return nil, fmt.Errorf("%w: subject is missing", ports.ErrInvalidIDToken)
+	}
+	if nonce != "" && idToken.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce does not match the login", ports.ErrInvalidIDToken)
+	}
+
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, fmt.Errorf("%w: malformed claims", ports.ErrInvalidIDToken)
+	}
+	if azp, ok := claims["azp"].(string); ok && azp != c.config.ClientID {
+		return nil, fmt.Errorf("%w: token was issued to another client", ports.ErrInvalidIDToken)
+	}
+	return claims, nil
+}
+
+// discover fetches the provider metadata once; go-oidc checks it belongs to the configured issuer
+func (c *OIDCClient) discover(ctx context.Context) (*oidc.Provider, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.provider != nil {
+		return c.provider, nil
+	}
+
+	provider, err := oidc.NewProvider(oidc.ClientContext(ctx, c.httpClient), c.config.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OIDC provider: %w", err)
+	}
+
+	c.provider = provider
+	c.verifier = provider.Verifier(&oidc.Config{ClientID: c.config.ClientID})
+	return c.provider, nil
+}
+
+// oauth2Config builds the OAuth2 config from the discovered endpoints
+func (c *OIDCClient) oauth2Config(ctx context.Context) (*oauth2.Config, error) {
+	provider, err := c.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The openid scope is what makes the provider return an ID token
+	scopes := c.config.GetScopes()
+	if !containsScope(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	return &oauth2.Config{
+		ClientID:     c.config.ClientID,
+		ClientSecret: c.config.ClientSecret,
+		Scopes:       scopes,
+		RedirectURL:  c.config.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+	}, nil
+}
+
+// containsScope checks if a scope was requested
+func containsScope(scopes []string, scope string) bool {
+	for _, candidate := range scopes {
+		if candidate == scope {
+			return true
+		}
+	}
+	return false
+}
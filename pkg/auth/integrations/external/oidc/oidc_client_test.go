@@ -0,0 +1,153 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// newTestProvider serves a discovery document and a JWKS with the given key
+func newTestProvider(t *testing.T, key *rsa.PublicKey, issuerOverride string) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer := server.URL
+		if issuerOverride != "" {
+			issuer = issuerOverride
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"jwks_uri":               server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: key, KeyID: "key-1", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	return server
+}
+
+// signTestIDToken builds an RS256 signed ID token
+func signTestIDToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, (&jose.SignerOptions{}).WithHeader("kid", "key-1"))
+	require.NoError(t, err)
+	raw, err := jwt.Signed(signer).Claims(claims).Serialize()
+	require.NoError(t, err)
+	return raw
+}
+
+func TestOIDCClient_VerifyIDToken_WithValidToken_ReturnsClaims(t *testing.T) {
+	// Arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newTestProvider(t, &key.PublicKey, "")
+	client := NewOIDCClient(&authModels.AuthConfig{ClientID: "dash-ops", IssuerURL: server.URL})
+	raw := signTestIDToken(t, key, map[string]interface{}{
+		"iss": server.URL, "sub": "42", "aud": "dash-ops", "exp": time.Now().Add(time.Hour).Unix(),
+		"nonce": "n-0S6", "preferred_username": "jane",
+	})
+
+	// Act
+	claims, err := client.VerifyIDToken(context.Background(), raw, "n-0S6")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "42", claims["sub"])
+	assert.Equal(t, "jane", claims["preferred_username"])
+}
+
+func TestOIDCClient_VerifyIDToken_WithInvalidTokens_ReturnsInvalidIDToken(t *testing.T) {
+	// Arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newTestProvider(t, &key.PublicKey, "")
+	client := NewOIDCClient(&authModels.AuthConfig{ClientID: "dash-ops", IssuerURL: server.URL})
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		base := map[string]interface{}{"iss": server.URL, "sub": "42", "aud": "dash-ops", "exp": time.Now().Add(time.Hour).Unix(), "nonce": "n-0S6"}
+		for name, value := range overrides {
+			base[name] = value
+		}
+		return base
+	}
+	tokens := map[string]string{
+		"expired":        signTestIDToken(t, key, claims(map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})),
+		"other audience": signTestIDToken(t, key, claims(map[string]interface{}{"aud": "grafana"})),
+		"other client":   signTestIDToken(t, key, claims(map[string]interface{}{"azp": "grafana"})),
+		"wrong nonce":    signTestIDToken(t, key, claims(map[string]interface{}{"nonce": "replayed"})),
+		"not a jwt":      "opaque-access-token",
+	}
+
+	for name, raw := range tokens {
+		// Act
+		_, err := client.VerifyIDToken(context.Background(), raw, "n-0S6")
+
+		// Assert
+		assert.True(t, errors.Is(err, ports.ErrInvalidIDToken), name)
+	}
+}
+
+func TestOIDCClient_AuthCodeURL_AddsPKCEChallengeNonceAndOpenIDScope(t *testing.T) {
+	// Arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newTestProvider(t, &key.PublicKey, "")
+	client := NewOIDCClient(&authModels.AuthConfig{
+		Provider:    authModels.ProviderOIDC,
+		ClientID:    "dash-ops",
+		IssuerURL:   server.URL,
+		RedirectURL: "http://localhost:8080/api/oauth/redirect",
+		Scopes:      []string{"groups"},
+	})
+
+	// Act
+	authURL, err := client.AuthCodeURL(context.Background(), "state-1", "nonce-1", "verifier-with-enough-entropy-0123456789abc")
+
+	// Assert
+	require.NoError(t, err)
+	parsed, err := url.Parse(authURL)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, server.URL+"/authorize", parsed.Scheme+"://"+parsed.Host+parsed.Path)
+	assert.Equal(t, "state-1", query.Get("state"))
+	assert.Equal(t, "nonce-1", query.Get("nonce"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.NotEmpty(t, query.Get("code_challenge"))
+	assert.Equal(t, "openid groups", query.Get("scope"))
+}
+
+func TestOIDCClient_AuthCodeURL_WithMismatchedIssuer_ReturnsError(t *testing.T) {
+	// Arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server := newTestProvider(t, &key.PublicKey, "https://evil.example.com")
+	client := NewOIDCClient(&authModels.AuthConfig{IssuerURL: server.URL})
+
+	// Act
+	_, err = client.AuthCodeURL(context.Background(), "state-1", "nonce-1", "verifier-with-enough-entropy-0123456789abc")
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "evil.example.com")
+}
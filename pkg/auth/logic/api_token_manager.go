@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// ErrScopeNotHeld is returned when a token would carry a permission its issuer does not hold
+var ErrScopeNotHeld = errors.New("scope exceeds the permissions of the issuer")
+
+const (
+	// apiTokenSecretBytes is the entropy of a token secret; a fast hash is safe to store at this length
+	apiTokenSecretBytes = 32
+	// apiTokenHintLength is how many characters of the secret are kept to recognize a token
+	apiTokenHintLength = len(authModels.APITokenPrefix) + 4
+)
+
+// APITokenManager handles API token logic
+type APITokenManager struct{}
+
+// NewAPITokenManager creates a new API token manager
+func NewAPITokenManager() *APITokenManager {
+	return &APITokenManager{}
+}
+
+// GenerateSecret returns a new token secret, its hash and its hint
+func (m *APITokenManager) GenerateSecret() (secret, hash, hint string, err error) {
+	data := make([]byte, apiTokenSecretBytes)
+	if _, err := rand.Read(data); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	secret = authModels.APITokenPrefix + base64.RawURLEncoding.EncodeToString(data)
+	return secret, m.HashSecret(secret), secret[:apiTokenHintLength], nil
+}
+
+// HashSecret returns the hash a token secret is stored and looked up by
+func (m *APITokenManager) HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// CheckScopes verifies the issuer holds every requested scope, so tokens never exceed the rights of who issued them
+func (m *APITokenManager) CheckScopes(scopes []authModels.RoleGrant, held *commonsModels.Permissions) error {
+	for _, scope := range scopes {
+		if !holdsScope(held, scope) {
+			return fmt.Errorf("%w: %s", ErrScopeNotHeld, scope.Permission)
+		}
+	}
+	return nil
+}
+
+// Permissions returns the permissions a token carries.
+// When held is not nil, scopes its owner no longer holds are dropped, so removing a role also limits the owner's tokens.
+func (m *APITokenManager) Permissions(token *authModels.APIToken, held *commonsModels.Permissions) *commonsModels.Permissions {
+	permissions := &commonsModels.Permissions{
+		Roles:  []string{},
+		Grants: make([]commonsModels.PermissionGrant, 0, len(token.Scopes)),
+	}
+	for _, scope := range token.Scopes {
+		if held != nil && !holdsScope(held, scope) {
+			continue
+		}
+		permissions.Grants = append(permissions.Grants, commonsModels.PermissionGrant{
+			Permission: scope.Permission,
+			Scope:      scope.Scope,
+			Role:       "token:" + token.Name,
+		})
+	}
+	return permissions
+}
+
+// holdsScope checks if a grant includes a whole scope; wildcards in the scope must be matched by wildcards in the grant
+func holdsScope(held *commonsModels.Permissions, scope authModels.RoleGrant) bool {
+	if held == nil {
+		return false
+	}
+	for _, grant := range held.Grants {
+		if commonsModels.MatchPermission(grant.Permission, scope.Permission) && grant.Scope.Covers(scope.Scope) {
+			return true
+		}
+	}
+	return false
+}
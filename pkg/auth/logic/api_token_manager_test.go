@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+func TestAPITokenManager_GenerateSecret_ReturnsPrefixedSecretWithMatchingHash(t *testing.T) {
+	// Arrange
+	manager := NewAPITokenManager()
+
+	// Act
+	secret, hash, hint, err := manager.GenerateSecret()
+	other, _, _, otherErr := manager.GenerateSecret()
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, otherErr)
+	assert.True(t, strings.HasPrefix(secret, authModels.APITokenPrefix))
+	assert.True(t, strings.HasPrefix(secret, hint))
+	assert.Equal(t, manager.HashSecret(secret), hash)
+	assert.NotContains(t, hash, secret)
+	assert.NotEqual(t, secret, other)
+}
+
+func TestAPITokenManager_CheckScopes_WithScopeBroaderThanGrant_ReturnsError(t *testing.T) {
+	// Arrange
+	manager := NewAPITokenManager()
+	held := &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{
+		{Permission: "k8s:deployments:*", Scope: commonsModels.PermissionScope{Cluster: "prod"}},
+	}}
+
+	// Act
+	within := manager.CheckScopes([]authModels.RoleGrant{
+		{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments"}},
+	}, held)
+	otherCluster := manager.CheckScopes([]authModels.RoleGrant{
+		{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "staging"}},
+	}, held)
+	anyCluster := manager.CheckScopes([]authModels.RoleGrant{
+		{Permission: "k8s:deployments:scale"},
+	}, held)
+	otherPermission := manager.CheckScopes([]authModels.RoleGrant{
+		{Permission: "k8s:pods:exec", Scope: commonsModels.PermissionScope{Cluster: "prod"}},
+	}, held)
+
+	// Assert
+	assert.NoError(t, within)
+	assert.ErrorIs(t, otherCluster, ErrScopeNotHeld)
+	assert.ErrorIs(t, anyCluster, ErrScopeNotHeld)
+	assert.ErrorIs(t, otherPermission, ErrScopeNotHeld)
+}
+
+func TestAPITokenManager_Permissions_WithRevokedOwnerGrant_DropsScope(t *testing.T) {
+	// Arrange
+	manager := NewAPITokenManager()
+	token := &authModels.APIToken{Name: "ci", Scopes: []authModels.RoleGrant{
+		{Permission: "k8s:deployments:scale"},
+		{Permission: "aws:ec2:stop"},
+	}}
+	held := &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{{Permission: "k8s:*"}}}
+
+	// Act
+	limited := manager.Permissions(token, held)
+	service := manager.Permissions(token, nil)
+
+	// Assert
+	require.Len(t, limited.Grants, 1)
+	assert.Equal(t, "k8s:deployments:scale", limited.Grants[0].Permission)
+	assert.Equal(t, "token:ci", limited.Grants[0].Role)
+	assert.Len(t, service.Grants, 2)
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"strings"
+	"time"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+)
+
+// OIDCProcessor maps the claims of OpenID Connect ID tokens to DashOps users
+type OIDCProcessor struct{}
+
+// NewOIDCProcessor creates a new OIDC processor
+func NewOIDCProcessor() *OIDCProcessor {
+	return &OIDCProcessor{}
+}
+
+// BuildUserData builds user data from the claims of an ID token
+func (p *OIDCProcessor) BuildUserData(idToken *authModels.IDToken, orgPermission string) *authModels.UserData {
+	groups := make([]string, len(idToken.Groups))
+	copy(groups, idToken.Groups)
+
+	return &authModels.UserData{
+		Username: idToken.Username,
+		Email:    idToken.Email,
+		Groups:   groups,
+		Org:      orgPermission,
+	}
+}
+
+// MapClaims maps the configured claims of a verified ID token.
+// The username falls back to the email and then the subject, so every user gets a stable name.
+func (p *OIDCProcessor) MapClaims(claims map[string]interface{}, config *authModels.AuthConfig) *authModels.IDToken {
+	idToken := &authModels.IDToken{
+		Groups: stringValues(lookupClaim(claims, config.GetGroupsClaim())),
+		Claims: claims,
+	}
+	idToken.Subject, _ = claims["sub"].(string)
+	idToken.Issuer, _ = claims["iss"].(string)
+	idToken.Email, _ = lookupClaim(claims, config.GetEmailClaim()).(string)
+	idToken.Name, _ = claims["name"].(string)
+	idToken.Picture, _ = claims["picture"].(string)
+	idToken.ExpiresAt, _ = numericDate(claims["exp"])
+
+	idToken.Username, _ = lookupClaim(claims, config.GetUsernameClaim()).(string)
+	if idToken.Username == "" {
+		idToken.Username = idToken.Email
+	}
+	if idToken.Username == "" {
+		idToken.Username = idToken.Subject
+	}
+	return idToken
+}
+
+// lookupClaim returns a claim by name, or by dotted path into nested objects
+func lookupClaim(claims map[string]interface{}, name string) interface{} {
+	if value, ok := claims[name]; ok {
+		return value
+	}
+
+	var current interface{} = claims
+	for _, field := range strings.Split(name, ".") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		current = object[field]
+	}
+	return current
+}
+
+// stringValues returns a claim that is either a string or a list of strings as a list
+func stringValues(value interface{}) []string {
+	switch typed := value.(type) {
+	case string:
+		if typed == "" {
+			return []string{}
+		}
+		return []string{typed}
+	case []interface{}:
+		values := make([]string, 0, len(typed))
+		for _, item := range typed {
+			if text, ok := item.(string); ok && text != "" {
+				values = append(values, text)
+			}
+		}
+		return values
+	default:
+		return []string{}
+	}
+}
+
+// numericDate converts a JWT NumericDate claim to a time
+func numericDate(value interface{}) (time.Time, bool) {
+	seconds, ok := value.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(seconds), 0), true
+}
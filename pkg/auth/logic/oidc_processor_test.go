@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+)
+
+const testIssuer = "https://sso.example.com/realms/dash-ops"
+
+func TestOIDCProcessor_MapClaims_WithConfiguredClaims_MapsUser(t *testing.T) {
+	// Arrange
+	processor := NewOIDCProcessor()
+	config := &authModels.AuthConfig{Provider: authModels.ProviderOIDC, ClientID: "dash-ops", GroupsClaim: "realm_access.roles"}
+	expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	claims := map[string]interface{}{
+		"iss":                testIssuer,
+		"sub":                "f81d4fae",
+		"exp":                float64(expiresAt.Unix()),
+		"preferred_username": "jane",
+		"email":              "jane@example.com",
+		"realm_access":       map[string]interface{}{"roles": []interface{}{"sre", "payments"}},
+	}
+
+	// Act
+	idToken := processor.MapClaims(claims, config)
+
+	// Assert
+	assert.Equal(t, "f81d4fae", idToken.Subject)
+	assert.Equal(t, testIssuer, idToken.Issuer)
+	assert.Equal(t, "jane", idToken.Username)
+	assert.Equal(t, "jane@example.com", idToken.Email)
+	assert.Equal(t, []string{"sre", "payments"}, idToken.Groups)
+	assert.True(t, expiresAt.Equal(idToken.ExpiresAt))
+}
+
+func TestOIDCProcessor_MapClaims_WithoutUsernameOrEmail_FallsBackToSubject(t *testing.T) {
+	// Arrange
+	processor := NewOIDCProcessor()
+	config := &authModels.AuthConfig{Provider: authModels.ProviderOIDC, ClientID: "dash-ops"}
+	claims := map[string]interface{}{"iss": testIssuer, "sub": "42", "groups": "sre"}
+
+	// Act
+	idToken := processor.MapClaims(claims, config)
+
+	// Assert
+	assert.Equal(t, "42", idToken.Username)
+	assert.Equal(t, []string{"sre"}, idToken.Groups)
+}
+
+func TestOIDCProcessor_BuildUserData_UsesTokenGroups(t *testing.T) {
+	// Arrange
+	processor := NewOIDCProcessor()
+	idToken := &authModels.IDToken{Username: "jane", Email: "jane@example.com", Groups: []string{"sre"}}
+
+	// Act
+	userData := processor.BuildUserData(idToken, "acme")
+
+	// Assert
+	assert.Equal(t, "jane", userData.Username)
+	assert.Equal(t, "acme", userData.Org)
+	assert.Equal(t, []string{"sre"}, userData.Groups)
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"sort"
+	"strings"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// RBACResolver resolves the roles and permissions of users from their groups
+type RBACResolver struct {
+	roles    map[string]authModels.Role
+	bindings []authModels.RoleBinding
+}
+
+// NewRBACResolver creates a new resolver from a validated RBAC config
+func NewRBACResolver(config *authModels.RBACConfig) *RBACResolver {
+	roles := make(map[string]authModels.Role, len(config.Roles))
+	for _, role := range config.Roles {
+		roles[role.Name] = role
+	}
+	return &RBACResolver{
+		roles:    roles,
+		bindings: config.Bindings,
+	}
+}
+
+// Resolve returns the effective permissions of a member of the given groups.
+// Groups are compared case-insensitively, like the group lists of the modules.
+func (r *RBACResolver) Resolve(groups []string) *commonsModels.Permissions {
+	roleNames := make(map[string]bool)
+	for _, binding := range r.bindings {
+		if !containsFold(groups, binding.Group) {
+			continue
+		}
+		for _, role := range binding.Roles {
+			roleNames[role] = true
+		}
+	}
+
+	permissions := &commonsModels.Permissions{
+		Roles:  make([]string, 0, len(roleNames)),
+		Grants: []commonsModels.PermissionGrant{},
+	}
+	for name := range roleNames {
+		permissions.Roles = append(permissions.Roles, name)
+	}
+	sort.Strings(permissions.Roles)
+
+	seen := make(map[commonsModels.PermissionGrant]bool)
+	for _, name := range permissions.Roles {
+		for _, grant := range r.roles[name].Permissions {
+			effective := commonsModels.PermissionGrant{Permission: grant.Permission, Scope: grant.Scope, Role: name}
+			if seen[effective] {
+				continue
+			}
+			seen[effective] = true
+			permissions.Grants = append(permissions.Grants, effective)
+		}
+	}
+	return permissions
+}
+
+// containsFold checks if a list contains a value, ignoring case
+func containsFold(values []string, value string) bool {
+	for _, candidate := range values {
+		if strings.EqualFold(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+func newTestRBACResolver() *RBACResolver {
+	return NewRBACResolver(&authModels.RBACConfig{
+		Roles: []authModels.Role{
+			{Name: "sre", Permissions: []authModels.RoleGrant{{Permission: "k8s:*"}, {Permission: "aws:ec2:*"}}},
+			{Name: "payments-developer", Permissions: []authModels.RoleGrant{
+				{Permission: "k8s:deployments:scale", Scope: commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments-*"}},
+				{Permission: "catalog:service:edit", Scope: commonsModels.PermissionScope{Service: "payments-api"}},
+			}},
+		},
+		Bindings: []authModels.RoleBinding{
+			{Group: "dash-ops*sre", Roles: []string{"sre"}},
+			{Group: "dash-ops*payments", Roles: []string{"payments-developer"}},
+			{Group: "dash-ops*payments-leads", Roles: []string{"payments-developer"}},
+		},
+	})
+}
+
+func TestRBACResolver_Resolve_WithBoundGroups_ReturnsRolesAndDeduplicatedGrants(t *testing.T) {
+	// Arrange
+	resolver := newTestRBACResolver()
+
+	// Act
+	permissions := resolver.Resolve([]string{"Dash-Ops*Payments", "dash-ops*payments-leads", "dash-ops*other"})
+
+	// Assert
+	assert.Equal(t, []string{"payments-developer"}, permissions.Roles)
+	require.Len(t, permissions.Grants, 2)
+	assert.Equal(t, "payments-developer", permissions.Grants[0].Role)
+}
+
+func TestRBACResolver_Resolve_WithoutBoundGroups_ReturnsEmptyPermissions(t *testing.T) {
+	// Arrange
+	resolver := newTestRBACResolver()
+
+	// Act
+	permissions := resolver.Resolve([]string{"dash-ops*other"})
+
+	// Assert
+	require.NotNil(t, permissions, "RBAC users without roles get an empty set rather than the module group lists")
+	assert.Empty(t, permissions.Roles)
+	assert.False(t, permissions.Allows("k8s:pods:exec", commonsModels.PermissionScope{Cluster: "prod", Namespace: "default"}))
+}
+
+func TestRBACResolver_Resolve_WithWildcardGrants_AllowsMatchingPermissions(t *testing.T) {
+	// Arrange
+	resolver := newTestRBACResolver()
+
+	// Act
+	permissions := resolver.Resolve([]string{"dash-ops*sre"})
+
+	// Assert
+	assert.True(t, permissions.Allows("k8s:nodes:maintain", commonsModels.PermissionScope{Cluster: "prod"}))
+	assert.True(t, permissions.Allows("aws:ec2:stop", commonsModels.PermissionScope{Account: "production"}))
+	assert.False(t, permissions.Allows("catalog:service:edit", commonsModels.PermissionScope{Service: "payments-api"}))
+}
+
+func TestRBACResolver_Resolve_WithScopedGrants_OnlyAllowsCoveredScopes(t *testing.T) {
+	// Arrange
+	resolver := newTestRBACResolver()
+	permissions := resolver.Resolve([]string{"dash-ops*payments"})
+
+	// Act & Assert
+	assert.True(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments-eu"}))
+	assert.False(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "prod", Namespace: "checkout"}))
+	assert.False(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "staging", Namespace: "payments-eu"}))
+	assert.False(t, permissions.Allows("k8s:deployments:scale", commonsModels.PermissionScope{Cluster: "prod"}), "a namespaced grant does not cover cluster-wide checks")
+	assert.False(t, permissions.Allows("k8s:deployments:restart", commonsModels.PermissionScope{Cluster: "prod", Namespace: "payments-eu"}))
+	assert.True(t, permissions.Allows("catalog:service:edit", commonsModels.PermissionScope{Service: "payments-api"}))
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// APITokenPrefix marks API tokens, so they are told apart from provider tokens and found by secret scanners
+	APITokenPrefix = "dashops_"
+	// DefaultAPITokenDuration is how long API tokens last when no expiry is requested
+	DefaultAPITokenDuration = 90 * 24 * time.Hour
+	// MaxAPITokenDuration is the longest lifetime an API token can be issued with
+	MaxAPITokenDuration = 365 * 24 * time.Hour
+	// PermissionManageAPITokens lets a role issue service tokens and manage the tokens of every user
+	PermissionManageAPITokens = "auth:tokens:manage"
+)
+
+// APITokenKind represents who an API token acts for
+type APITokenKind string
+
+const (
+	// APITokenPersonal acts for the user who issued it
+	APITokenPersonal APITokenKind = "personal"
+	// APITokenService acts for an automation, such as a CI pipeline, and is issued by an admin
+	APITokenService APITokenKind = "service"
+)
+
+// APIToken represents a long-lived token for automation.
+// Only the hash of the secret is stored; its scopes are the only permissions the token carries.
+type APIToken struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Kind       APITokenKind `json:"kind"`
+	Hash       string       `json:"hash"`
+	Hint       string       `json:"hint"` // First characters of the secret, to recognize it
+	OwnerID    string       `json:"owner_id,omitempty"`
+	Username   string       `json:"username"`
+	Email      string       `json:"email,omitempty"`
+	Groups     []string     `json:"groups,omitempty"` // Groups of the owner when the token was issued
+	Scopes     []RoleGrant  `json:"scopes"`
+	CreatedBy  string       `json:"created_by"`
+	CreatedAt  time.Time    `json:"created_at"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+	LastUsedAt time.Time    `json:"last_used_at,omitempty"`
+}
+
+// APITokenRequest represents the settings of a token to issue
+type APITokenRequest struct {
+	Name      string
+	Kind      APITokenKind
+	Scopes    []RoleGrant
+	ExpiresIn time.Duration
+}
+
+// IsExpired checks if the token can no longer be used
+func (t *APIToken) IsExpired(now time.Time) bool {
+	return now.After(t.ExpiresAt)
+}
+
+// Validate validates the settings of a token to issue
+func (r *APITokenRequest) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("token name is required")
+	}
+	if r.Kind != APITokenPersonal && r.Kind != APITokenService {
+		return fmt.Errorf("unsupported token kind %q", r.Kind)
+	}
+	if len(r.Scopes) == 0 {
+		return fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range r.Scopes {
+		if err := ValidatePermissionPattern(scope.Permission); err != nil {
+			return err
+		}
+	}
+	if r.ExpiresIn < 0 || r.ExpiresIn > MaxAPITokenDuration {
+		return fmt.Errorf("token expiry must be at most %d days", int(MaxAPITokenDuration.Hours()/24))
+	}
+	return nil
+}
+
+// GetExpiresIn returns the requested lifetime, the default one when none was requested
+func (r *APITokenRequest) GetExpiresIn() time.Duration {
+	if r.ExpiresIn == 0 {
+		return DefaultAPITokenDuration
+	}
+	return r.ExpiresIn
+}
+
+// APITokenConfig represents where API tokens are stored; it accepts the same storage backends as sessions
+type APITokenConfig struct {
+	Storage string `yaml:"storage" json:"storage"` // memory or file
+	File    string `yaml:"file" json:"file,omitempty"`
+}
+
+// Validate validates the API token storage
+func (c *APITokenConfig) Validate() error {
+	switch c.GetStorage() {
+	case SessionStorageMemory:
+	case SessionStorageFile:
+		if c.File == "" {
+			return fmt.Errorf("api token file is required for file storage")
+		}
+	default:
+		return fmt.Errorf("unsupported api token storage %q", c.Storage)
+	}
+	return nil
+}
+
+// GetStorage returns the API token storage backend, memory by default
+func (c *APITokenConfig) GetStorage() string {
+	if c.Storage == "" {
+		return SessionStorageMemory
+	}
+	return c.Storage
+}
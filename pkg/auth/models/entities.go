@@ -2,6 +2,8 @@ package auth
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 	"time"
 )
 
@@ -15,6 +17,33 @@ const (
 	ProviderSAML   AuthProvider = "saml"
 	ProviderLDAP   AuthProvider = "ldap"
 	ProviderJWT    AuthProvider = "jwt"
+	ProviderOIDC   AuthProvider = "oidc"
+)
+
+// PendingLoginTTL is how long a started login waits for the provider callback
+const PendingLoginTTL = 10 * time.Minute
+
+// Session storage backends and defaults
+const (
+	SessionStorageMemory = "memory"
+	SessionStorageFile   = "file"
+
+	DefaultSessionCookieName = "dashops_session"
+	DefaultSessionDuration   = 24 * time.Hour
+)
+
+// Caching of user data resolved from the provider
+const (
+	DefaultUserCacheTTL = 5 * time.Minute
+	// RejectedTokenCacheTTL is how long a token rejected by the provider is not retried
+	RejectedTokenCacheTTL = 30 * time.Second
+)
+
+// Default claims read from OpenID Connect ID tokens
+const (
+	DefaultUsernameClaim = "preferred_username"
+	DefaultEmailClaim    = "email"
+	DefaultGroupsClaim   = "groups"
 )
 
 // AuthMethod represents different authentication methods
@@ -73,8 +102,17 @@ type Token struct {
 	Provider     AuthProvider `json:"provider"`
 }
 
+// reservedProviderNames are path segments of the login routes that cannot name a provider
+var reservedProviderNames = map[string]bool{"redirect": true, "logout": true, "providers": true, "login": true}
+
+// providerNamePattern restricts provider names to what fits in a URL path segment
+var providerNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
 // AuthConfig represents authentication configuration for a provider
 type AuthConfig struct {
+	// Name identifies the provider in its login routes and user IDs; it defaults to the provider type
+	Name            string       `yaml:"name" json:"name,omitempty"`
+	DisplayName     string       `yaml:"displayName" json:"display_name,omitempty"`
 	Provider        AuthProvider `yaml:"provider" json:"provider"`
 	Method          AuthMethod   `yaml:"method" json:"method"`
 	ClientID        string       `yaml:"clientId" json:"client_id"`
@@ -86,18 +124,72 @@ type AuthConfig struct {
 	OrgPermission   string       `yaml:"orgPermission" json:"org_permission"`
 	Scopes          []string     `yaml:"scopes" json:"scopes"`
 	Enabled         bool         `yaml:"enabled" json:"enabled"`
+
+	// OpenID Connect settings; endpoints are discovered from the issuer
+	IssuerURL     string `yaml:"issuerURL" json:"issuer_url,omitempty"`
+	UsernameClaim string `yaml:"usernameClaim" json:"username_claim,omitempty"`
+	EmailClaim    string `yaml:"emailClaim" json:"email_claim,omitempty"`
+	GroupsClaim   string `yaml:"groupsClaim" json:"groups_claim,omitempty"` // Dotted paths such as realm_access.roles are supported
+
+	// LDAP and Active Directory settings; users log in with a username and password
+	LDAP LDAPConfig `yaml:"ldap" json:"ldap,omitempty"`
+
+	// CacheTTL is how long user data and profiles resolved from the provider are reused; 0 disables caching
+	CacheTTL string `yaml:"cacheTTL" json:"cache_ttl,omitempty"`
+}
+
+// IDToken represents a verified OpenID Connect ID token
+type IDToken struct {
+	Subject   string                 `json:"sub"`
+	Issuer    string                 `json:"iss"`
+	Username  string                 `json:"username"`
+	Name      string                 `json:"name,omitempty"`
+	Email     string                 `json:"email,omitempty"`
+	Picture   string                 `json:"picture,omitempty"`
+	Groups    []string               `json:"groups"`
+	ExpiresAt time.Time              `json:"expires_at"`
+	Claims    map[string]interface{} `json:"-"`
+}
+
+// PendingLogin represents an authorization request waiting for its callback
+type PendingLogin struct {
+	State        string    `json:"state"`
+	Nonce        string    `json:"-"`
+	CodeVerifier string    `json:"-"` // PKCE verifier, never sent to the browser
+	RedirectPath string    `json:"redirect_path,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at"`
 }
 
 // AuthSession represents an active authentication session
 type AuthSession struct {
 	SessionID string    `json:"session_id"`
 	UserID    string    `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Email     string    `json:"email,omitempty"`
 	Token     *Token    `json:"token"`
 	CreatedAt time.Time `json:"created_at"`
 	ExpiresAt time.Time `json:"expires_at"`
 	LastUsed  time.Time `json:"last_used"`
 	IPAddress string    `json:"ip_address,omitempty"`
 	UserAgent string    `json:"user_agent,omitempty"`
+
+	// Provider is the name of the provider the user logged in with; empty for sessions of the default provider
+	Provider string `json:"provider,omitempty"`
+
+	// Identity holds the verified ID token claims of OpenID Connect logins
+	Identity *IDToken `json:"identity,omitempty"`
+
+	// Directory holds the LDAP user and groups resolved when the session logged in
+	Directory *DirectoryUser `json:"directory,omitempty"`
+}
+
+// SessionConfig represents how login sessions are stored and handed to browsers
+type SessionConfig struct {
+	Storage        string `yaml:"storage" json:"storage"` // memory or file
+	File           string `yaml:"file" json:"file,omitempty"`
+	Duration       string `yaml:"duration" json:"duration,omitempty"`
+	CookieName     string `yaml:"cookieName" json:"cookie_name,omitempty"`
+	InsecureCookie bool   `yaml:"insecureCookie" json:"insecure_cookie,omitempty"` // Only for plain HTTP deployments
 }
 
 // Methods for User entity
@@ -175,6 +267,39 @@ func (ac *AuthConfig) Validate() error {
 		return fmt.Errorf("method is required")
 	}
 
+	if ac.Name != "" && (!providerNamePattern.MatchString(ac.Name) || reservedProviderNames[ac.Name]) {
+		return fmt.Errorf("provider name %q must be lowercase letters, digits and dashes, and not a reserved route", ac.Name)
+	}
+
+	if ac.CacheTTL != "" {
+		if ttl, err := time.ParseDuration(ac.CacheTTL); err != nil || ttl < 0 {
+			return fmt.Errorf("cacheTTL must be a duration such as 5m")
+		}
+	}
+
+	// LDAP providers log in with passwords checked by the directory, not with OAuth2
+	if ac.IsLDAP() {
+		if ac.Method != MethodLDAP {
+			return fmt.Errorf("ldap providers must use the ldap method")
+		}
+		return ac.LDAP.Validate()
+	}
+
+	// OpenID Connect discovers its endpoints, and public clients authenticate with PKCE instead of a secret
+	if ac.IsOIDC() {
+		if ac.ClientID == "" {
+			return fmt.Errorf("clientId is required for OIDC")
+		}
+		issuer, err := url.Parse(ac.IssuerURL)
+		if ac.IssuerURL == "" || err != nil || issuer.Host == "" || (issuer.Scheme != "https" && issuer.Scheme != "http") {
+			return fmt.Errorf("issuerURL must be an absolute URL for OIDC")
+		}
+		if ac.RedirectURL == "" {
+			return fmt.Errorf("redirectURL is required for OIDC")
+		}
+		return nil
+	}
+
 	// OAuth2 specific validation
 	if ac.Method == MethodOAuth2 {
 		if ac.ClientID == "" {
@@ -197,11 +322,61 @@ func (ac *AuthConfig) Validate() error {
 	return nil
 }
 
+// GetName returns the name of the provider, its type when no name is set
+func (ac *AuthConfig) GetName() string {
+	if ac.Name == "" {
+		return string(ac.Provider)
+	}
+	return ac.Name
+}
+
 // IsOAuth2 checks if the config is for OAuth2 authentication
 func (ac *AuthConfig) IsOAuth2() bool {
 	return ac.Method == MethodOAuth2
 }
 
+// IsOIDC checks if the config is for an OpenID Connect provider
+func (ac *AuthConfig) IsOIDC() bool {
+	return ac.Provider == ProviderOIDC
+}
+
+// IsLDAP checks if the config is for an LDAP or Active Directory provider
+func (ac *AuthConfig) IsLDAP() bool {
+	return ac.Provider == ProviderLDAP
+}
+
+// GetUsernameClaim returns the ID token claim holding the username
+func (ac *AuthConfig) GetUsernameClaim() string {
+	if ac.UsernameClaim == "" {
+		return DefaultUsernameClaim
+	}
+	return ac.UsernameClaim
+}
+
+// GetEmailClaim returns the ID token claim holding the email
+func (ac *AuthConfig) GetEmailClaim() string {
+	if ac.EmailClaim == "" {
+		return DefaultEmailClaim
+	}
+	return ac.EmailClaim
+}
+
+// GetGroupsClaim returns the ID token claim holding the groups
+func (ac *AuthConfig) GetGroupsClaim() string {
+	if ac.GroupsClaim == "" {
+		return DefaultGroupsClaim
+	}
+	return ac.GroupsClaim
+}
+
+// GetCacheTTL returns how long resolved user data is cached
+func (ac *AuthConfig) GetCacheTTL() time.Duration {
+	if ttl, err := time.ParseDuration(ac.CacheTTL); err == nil && ttl >= 0 {
+		return ttl
+	}
+	return DefaultUserCacheTTL
+}
+
 // GetScopes returns scopes with defaults if empty
 func (ac *AuthConfig) GetScopes() []string {
 	if len(ac.Scopes) == 0 {
@@ -209,7 +384,7 @@ func (ac *AuthConfig) GetScopes() []string {
 		switch ac.Provider {
 		case ProviderGitHub:
 			return []string{"user", "read:org"}
-		case ProviderGoogle:
+		case ProviderGoogle, ProviderOIDC:
 			return []string{"openid", "profile", "email"}
 		default:
 			return []string{}
@@ -218,6 +393,60 @@ func (ac *AuthConfig) GetScopes() []string {
 	return ac.Scopes
 }
 
+// Methods for SessionConfig entity
+
+// Validate validates the session configuration
+func (sc *SessionConfig) Validate() error {
+	switch sc.GetStorage() {
+	case SessionStorageMemory:
+	case SessionStorageFile:
+		if sc.File == "" {
+			return fmt.Errorf("session file is required for file storage")
+		}
+	default:
+		return fmt.Errorf("unsupported session storage %q", sc.Storage)
+	}
+
+	if sc.Duration != "" {
+		duration, err := time.ParseDuration(sc.Duration)
+		if err != nil || duration <= 0 {
+			return fmt.Errorf("session duration must be a positive duration such as 24h")
+		}
+	}
+	return nil
+}
+
+// GetStorage returns the session storage backend, memory by default
+func (sc *SessionConfig) GetStorage() string {
+	if sc.Storage == "" {
+		return SessionStorageMemory
+	}
+	return sc.Storage
+}
+
+// GetDuration returns how long sessions last
+func (sc *SessionConfig) GetDuration() time.Duration {
+	if duration, err := time.ParseDuration(sc.Duration); err == nil && duration > 0 {
+		return duration
+	}
+	return DefaultSessionDuration
+}
+
+// GetCookieName returns the name of the session cookie
+func (sc *SessionConfig) GetCookieName() string {
+	if sc.CookieName == "" {
+		return DefaultSessionCookieName
+	}
+	return sc.CookieName
+}
+
+// Methods for PendingLogin entity
+
+// IsExpired checks if the login took too long to come back
+func (pl *PendingLogin) IsExpired(now time.Time) bool {
+	return now.After(pl.ExpiresAt)
+}
+
 // Methods for AuthSession entity
 
 // IsActive checks if the session is active and not expired
@@ -0,0 +1,164 @@
+package auth
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Defaults of LDAP and Active Directory providers
+const (
+	DefaultLDAPUserFilter         = "(uid={username})"
+	DefaultLDAPGroupFilter        = "(member={dn})"
+	DefaultLDAPUsernameAttribute  = "uid"
+	DefaultLDAPEmailAttribute     = "mail"
+	DefaultLDAPNameAttribute      = "cn"
+	DefaultLDAPGroupNameAttribute = "cn"
+	DefaultLDAPTimeout            = 10 * time.Second
+
+	// MaxLDAPGroupDepth bounds how many levels of nested groups are followed
+	MaxLDAPGroupDepth = 10
+)
+
+// LDAPConfig represents how users log in against an LDAP or Active Directory server.
+// Filters may use the {username} placeholder, and group filters the {dn} placeholder for the member DN.
+type LDAPConfig struct {
+	URL                string `yaml:"url" json:"url"` // ldap://host:389 or ldaps://host:636
+	StartTLS           bool   `yaml:"startTLS" json:"start_tls,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify" json:"insecure_skip_verify,omitempty"` // Only for test servers
+	CAFile             string `yaml:"caFile" json:"ca_file,omitempty"`
+	Timeout            string `yaml:"timeout" json:"timeout,omitempty"`
+
+	// Service account searching users and groups; searches are anonymous when empty
+	BindDN       string `yaml:"bindDN" json:"bind_dn,omitempty"`
+	BindPassword string `yaml:"bindPassword" json:"-"`
+
+	UserSearchBase    string `yaml:"userSearchBase" json:"user_search_base"`
+	UserFilter        string `yaml:"userFilter" json:"user_filter,omitempty"` // Active Directory: (sAMAccountName={username})
+	UsernameAttribute string `yaml:"usernameAttribute" json:"username_attribute,omitempty"`
+	EmailAttribute    string `yaml:"emailAttribute" json:"email_attribute,omitempty"`
+	NameAttribute     string `yaml:"nameAttribute" json:"name_attribute,omitempty"`
+
+	GroupSearchBase    string `yaml:"groupSearchBase" json:"group_search_base,omitempty"` // Defaults to the user search base
+	GroupFilter        string `yaml:"groupFilter" json:"group_filter,omitempty"`
+	GroupNameAttribute string `yaml:"groupNameAttribute" json:"group_name_attribute,omitempty"`
+	NestedGroups       bool   `yaml:"nestedGroups" json:"nested_groups,omitempty"` // Also resolve the groups of groups
+}
+
+// DirectoryUser represents a user authenticated against an LDAP server, with the groups resolved at login
+type DirectoryUser struct {
+	DN       string   `json:"dn"`
+	Username string   `json:"username"`
+	Name     string   `json:"name,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Groups   []string `json:"groups"`
+}
+
+// Validate validates the LDAP server and search settings
+func (lc *LDAPConfig) Validate() error {
+	server, err := url.Parse(lc.URL)
+	if lc.URL == "" || err != nil || server.Host == "" || (server.Scheme != "ldap" && server.Scheme != "ldaps") {
+		return fmt.Errorf("ldap url must be an ldap:// or ldaps:// URL")
+	}
+	if lc.StartTLS && server.Scheme == "ldaps" {
+		return fmt.Errorf("ldap startTLS cannot be combined with an ldaps:// URL")
+	}
+	if lc.UserSearchBase == "" {
+		return fmt.Errorf("ldap userSearchBase is required")
+	}
+	if lc.BindDN != "" && lc.BindPassword == "" {
+		return fmt.Errorf("ldap bindPassword is required with a bindDN")
+	}
+	if !strings.Contains(lc.GetUserFilter(), "{username}") {
+		return fmt.Errorf("ldap userFilter must contain {username}")
+	}
+	if lc.Timeout != "" {
+		if timeout, err := time.ParseDuration(lc.Timeout); err != nil || timeout <= 0 {
+			return fmt.Errorf("ldap timeout must be a positive duration such as 10s")
+		}
+	}
+	return nil
+}
+
+// UsesTLS checks if the connection to the server is encrypted
+func (lc *LDAPConfig) UsesTLS() bool {
+	return lc.StartTLS || strings.HasPrefix(lc.URL, "ldaps://")
+}
+
+// GetUserFilter returns the filter finding a user by username
+func (lc *LDAPConfig) GetUserFilter() string {
+	if lc.UserFilter == "" {
+		return DefaultLDAPUserFilter
+	}
+	return lc.UserFilter
+}
+
+// GetUsernameAttribute returns the attribute holding the username
+func (lc *LDAPConfig) GetUsernameAttribute() string {
+	if lc.UsernameAttribute == "" {
+		return DefaultLDAPUsernameAttribute
+	}
+	return lc.UsernameAttribute
+}
+
+// GetEmailAttribute returns the attribute holding the email
+func (lc *LDAPConfig) GetEmailAttribute() string {
+	if lc.EmailAttribute == "" {
+		return DefaultLDAPEmailAttribute
+	}
+	return lc.EmailAttribute
+}
+
+// GetNameAttribute returns the attribute holding the display name
+func (lc *LDAPConfig) GetNameAttribute() string {
+	if lc.NameAttribute == "" {
+		return DefaultLDAPNameAttribute
+	}
+	return lc.NameAttribute
+}
+
+// GetGroupSearchBase returns where groups are searched
+func (lc *LDAPConfig) GetGroupSearchBase() string {
+	if lc.GroupSearchBase == "" {
+		return lc.UserSearchBase
+	}
+	return lc.GroupSearchBase
+}
+
+// GetGroupFilter returns the filter finding the groups of a member
+func (lc *LDAPConfig) GetGroupFilter() string {
+	if lc.GroupFilter == "" {
+		return DefaultLDAPGroupFilter
+	}
+	return lc.GroupFilter
+}
+
+// GetGroupNameAttribute returns the attribute holding the group name mapped to user groups
+func (lc *LDAPConfig) GetGroupNameAttribute() string {
+	if lc.GroupNameAttribute == "" {
+		return DefaultLDAPGroupNameAttribute
+	}
+	return lc.GroupNameAttribute
+}
+
+// GetTimeout returns how long connecting and each request may take
+func (lc *LDAPConfig) GetTimeout() time.Duration {
+	if timeout, err := time.ParseDuration(lc.Timeout); err == nil && timeout > 0 {
+		return timeout
+	}
+	return DefaultLDAPTimeout
+}
+
+// ToUserData converts a directory user to the user data of a request, its groups mapped like provider groups
+func (du *DirectoryUser) ToUserData(orgPermission string) *UserData {
+	groups := make([]string, len(du.Groups))
+	copy(groups, du.Groups)
+
+	return &UserData{
+		Username: du.Username,
+		Email:    du.Email,
+		Groups:   groups,
+		Org:      orgPermission,
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLDAPConfig_Validate_WithInvalidConfigs_ReturnsError(t *testing.T) {
+	// Arrange
+	configs := map[string]LDAPConfig{
+		"missing url":         {UserSearchBase: "dc=example,dc=com"},
+		"http url":            {URL: "https://ldap.example.com", UserSearchBase: "dc=example,dc=com"},
+		"startTLS with ldaps": {URL: "ldaps://ldap.example.com", StartTLS: true, UserSearchBase: "dc=example,dc=com"},
+		"missing search base": {URL: "ldap://ldap.example.com"},
+		"bind without secret": {URL: "ldap://ldap.example.com", UserSearchBase: "dc=example,dc=com", BindDN: "cn=dash-ops,dc=example,dc=com"},
+		"filter no username":  {URL: "ldap://ldap.example.com", UserSearchBase: "dc=example,dc=com", UserFilter: "(objectClass=person)"},
+		"invalid timeout":     {URL: "ldap://ldap.example.com", UserSearchBase: "dc=example,dc=com", Timeout: "soon"},
+	}
+
+	for name, config := range configs {
+		// Act
+		err := config.Validate()
+
+		// Assert
+		assert.Error(t, err, name)
+	}
+}
+
+func TestAuthConfig_Validate_WithActiveDirectoryProvider_AcceptsConfig(t *testing.T) {
+	// Arrange
+	config := AuthConfig{
+		Provider: ProviderLDAP,
+		Method:   MethodLDAP,
+		LDAP: LDAPConfig{
+			URL:            "ldaps://ad.example.com:636",
+			BindDN:         "CN=dash-ops,OU=Services,DC=example,DC=com",
+			BindPassword:   "secret",
+			UserSearchBase: "OU=People,DC=example,DC=com",
+			UserFilter:     "(sAMAccountName={username})",
+			GroupFilter:    "(member:1.2.840.113556.1.4.1941:={dn})",
+		},
+	}
+
+	// Act
+	err := config.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, config.LDAP.UsesTLS())
+	assert.Equal(t, "OU=People,DC=example,DC=com", config.LDAP.GetGroupSearchBase())
+}
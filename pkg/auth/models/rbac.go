@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// permissionSegments is the number of segments of a permission such as k8s:deployments:scale
+const permissionSegments = 3
+
+// RBACConfig represents central role-based access control: groups are bound to roles and roles grant permissions
+type RBACConfig struct {
+	Roles    []Role        `yaml:"roles" json:"roles"`
+	Bindings []RoleBinding `yaml:"bindings" json:"bindings"`
+}
+
+// Role represents a named set of permissions
+type Role struct {
+	Name        string      `yaml:"name" json:"name"`
+	Description string      `yaml:"description" json:"description,omitempty"`
+	Permissions []RoleGrant `yaml:"permissions" json:"permissions"`
+}
+
+// RoleGrant represents a permission granted by a role, optionally limited to a scope
+type RoleGrant struct {
+	Permission string                        `yaml:"permission" json:"permission"`
+	Scope      commonsModels.PermissionScope `yaml:"scope" json:"scope"`
+}
+
+// RoleBinding represents the roles given to the members of a GitHub team (org*team) or OIDC group
+type RoleBinding struct {
+	Group string   `yaml:"group" json:"group"`
+	Roles []string `yaml:"roles" json:"roles"`
+}
+
+// UnmarshalYAML accepts either a plain permission or a permission with a scope
+func (g *RoleGrant) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var permission string
+	if err := unmarshal(&permission); err == nil {
+		*g = RoleGrant{Permission: permission}
+		return nil
+	}
+
+	type plain RoleGrant
+	var grant plain
+	if err := unmarshal(&grant); err != nil {
+		return err
+	}
+	*g = RoleGrant(grant)
+	return nil
+}
+
+// IsEnabled checks if central roles are configured
+func (rc *RBACConfig) IsEnabled() bool {
+	return rc != nil && len(rc.Roles) > 0
+}
+
+// Validate validates the roles, their permissions and the bindings
+func (rc *RBACConfig) Validate() error {
+	if len(rc.Roles) == 0 {
+		if len(rc.Bindings) > 0 {
+			return fmt.Errorf("rbac bindings require at least one role")
+		}
+		return nil
+	}
+
+	roles := make(map[string]bool, len(rc.Roles))
+	for _, role := range rc.Roles {
+		if role.Name == "" {
+			return fmt.Errorf("rbac role name is required")
+		}
+		if roles[role.Name] {
+			return fmt.Errorf("rbac role %q is defined twice", role.Name)
+		}
+		roles[role.Name] = true
+
+		for _, grant := range role.Permissions {
+			if err := ValidatePermissionPattern(grant.Permission); err != nil {
+				return fmt.Errorf("rbac role %q: %w", role.Name, err)
+			}
+		}
+	}
+
+	for _, binding := range rc.Bindings {
+		if binding.Group == "" {
+			return fmt.Errorf("rbac binding group is required")
+		}
+		for _, role := range binding.Roles {
+			if !roles[role] {
+				return fmt.Errorf("rbac binding of group %q references unknown role %q", binding.Group, role)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidatePermissionPattern checks a permission such as k8s:deployments:scale.
+// Any segment may be *, and a trailing * may stand for the remaining segments, as in k8s:*.
+func ValidatePermissionPattern(pattern string) error {
+	segments := strings.Split(pattern, ":")
+	if len(segments) > permissionSegments {
+		return fmt.Errorf("permission %q has more than %d segments", pattern, permissionSegments)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			return fmt.Errorf("permission %q has an empty segment", pattern)
+		}
+	}
+	if len(segments) < permissionSegments && segments[len(segments)-1] != "*" {
+		return fmt.Errorf("permission %q must have the form module:resource:action", pattern)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestRBACConfig_UnmarshalYAML_AcceptsPlainAndScopedGrants(t *testing.T) {
+	// Arrange
+	data := []byte(`
+roles:
+  - name: payments-developer
+    permissions:
+      - 'catalog:service:*'
+      - permission: 'k8s:deployments:scale'
+        scope:
+          cluster: prod
+          namespace: 'payments-*'
+bindings:
+  - group: 'dash-ops*payments'
+    roles: [payments-developer]
+`)
+
+	// Act
+	var config RBACConfig
+	err := yaml.Unmarshal(data, &config)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, config.Validate())
+	require.Len(t, config.Roles[0].Permissions, 2)
+	assert.Equal(t, "catalog:service:*", config.Roles[0].Permissions[0].Permission)
+	assert.Equal(t, "prod", config.Roles[0].Permissions[1].Scope.Cluster)
+	assert.Equal(t, "payments-*", config.Roles[0].Permissions[1].Scope.Namespace)
+}
+
+func TestRBACConfig_Validate_WithInvalidConfigs_ReturnsError(t *testing.T) {
+	// Arrange
+	configs := map[string]RBACConfig{
+		"unknown role":      {Roles: []Role{{Name: "sre"}}, Bindings: []RoleBinding{{Group: "sre", Roles: []string{"admin"}}}},
+		"duplicate role":    {Roles: []Role{{Name: "sre"}, {Name: "sre"}}},
+		"missing group":     {Roles: []Role{{Name: "sre"}}, Bindings: []RoleBinding{{Roles: []string{"sre"}}}},
+		"short permission":  {Roles: []Role{{Name: "sre", Permissions: []RoleGrant{{Permission: "k8s:deployments"}}}}},
+		"empty segment":     {Roles: []Role{{Name: "sre", Permissions: []RoleGrant{{Permission: "k8s::scale"}}}}},
+		"bindings no roles": {Bindings: []RoleBinding{{Group: "sre", Roles: []string{"sre"}}}},
+	}
+
+	for name, config := range configs {
+		// Act
+		err := config.Validate()
+
+		// Assert
+		assert.Error(t, err, name)
+	}
+}
+
+func TestRBACConfig_IsEnabled_WithoutRoles_ReturnsFalse(t *testing.T) {
+	// Arrange
+	config := &RBACConfig{}
+
+	// Act & Assert
+	assert.False(t, config.IsEnabled())
+	assert.NoError(t, config.Validate())
+}
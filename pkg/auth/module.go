@@ -2,7 +2,6 @@ package auth
 
 import (
 	"fmt"
-	"time"
 
 	"github.com/gorilla/mux"
 	"golang.org/x/oauth2"
@@ -12,14 +11,19 @@ import (
 	authControllers "github.com/dash-ops/dash-ops/pkg/auth/controllers"
 	authHandlers "github.com/dash-ops/dash-ops/pkg/auth/handlers"
 	"github.com/dash-ops/dash-ops/pkg/auth/integrations/external/github"
+	"github.com/dash-ops/dash-ops/pkg/auth/integrations/external/ldap"
+	"github.com/dash-ops/dash-ops/pkg/auth/integrations/external/oidc"
 	authLogic "github.com/dash-ops/dash-ops/pkg/auth/logic"
 	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	authPorts "github.com/dash-ops/dash-ops/pkg/auth/ports"
+	"github.com/dash-ops/dash-ops/pkg/auth/repositories"
 	commonsHttp "github.com/dash-ops/dash-ops/pkg/commons/adapters/http"
 )
 
 // Module represents the auth module - main entry point for the plugin
 type Module struct {
-	config     *authModels.AuthConfig
+	configs    []*authModels.AuthConfig
+	rbac       *authModels.RBACConfig
 	controller *authControllers.AuthController
 	handler    *authHandlers.HTTPHandler
 }
@@ -30,43 +34,83 @@ func NewModule(fileConfig []byte) (*Module, error) {
 		return nil, fmt.Errorf("module config cannot be nil")
 	}
 
-	// Parse configuration
-	config, err := ParseAuthConfigFromFileConfig(fileConfig)
+	// Parse configuration; the first provider is the default one
+	configs, err := ParseAuthConfigsFromFileConfig(fileConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse configuration: %w", err)
 	}
 
 	// Validate configuration
-	if err := config.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid auth config: %w", err)
+	names := make(map[string]bool, len(configs))
+	for _, providerConfig := range configs {
+		if err := providerConfig.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid auth config %q: %w", providerConfig.GetName(), err)
+		}
+		if names[providerConfig.GetName()] {
+			return nil, fmt.Errorf("auth provider %q is configured twice; set a distinct name for each", providerConfig.GetName())
+		}
+		names[providerConfig.GetName()] = true
 	}
+	config := configs[0]
 
-	// Create OAuth2 config for GitHub integration
-	oauthConfig := &oauth2.Config{
-		ClientID:     config.ClientID,
-		ClientSecret: config.ClientSecret,
-		Scopes:       config.Scopes,
-		RedirectURL:  config.RedirectURL,
-		Endpoint: oauth2.Endpoint{
-			AuthURL:  config.AuthURL,
-			TokenURL: config.TokenURL,
-		},
+	sessionConfig, err := ParseSessionConfigFromFileConfig(fileConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse session configuration: %w", err)
+	}
+	if err := sessionConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session config: %w", err)
+	}
+	sessionRepository, err := newSessionRepository(sessionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	rbacConfig, err := ParseRBACConfigFromFileConfig(fileConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rbac configuration: %w", err)
+	}
+	if err := rbacConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid rbac config: %w", err)
+	}
+
+	apiTokenConfig, err := ParseAPITokenConfigFromFileConfig(fileConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse api token configuration: %w", err)
+	}
+	if err := apiTokenConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid api token config: %w", err)
 	}
 
 	// Initialize logic components
 	oauth2Processor := authLogic.NewOAuth2Processor()
-	sessionManager := authLogic.NewSessionManager(24 * time.Hour)
-
-	// Initialize GitHub integration
-	githubAdapter := github.NewGitHubAdapter(oauthConfig)
+	sessionManager := authLogic.NewSessionManager(sessionConfig.GetDuration())
 
 	// Initialize controller with dependencies
 	controller := authControllers.NewAuthController(
 		config,
 		oauth2Processor,
 		sessionManager,
-		githubAdapter, // GitHub adapter implements GitHubService interface
+		newGitHubAdapter(config), // GitHub adapter implements GitHubService interface
 	)
+	setLoginProvider(controller, config)
+
+	// Further providers are active next to the default one, each with its own login routes
+	for _, providerConfig := range configs[1:] {
+		setLoginProvider(controller.AddProvider(providerConfig, newGitHubAdapter(providerConfig)), providerConfig)
+	}
+	controller.SetSessionRepository(sessionRepository)
+
+	// Central roles replace the group lists of the other modules when configured
+	if rbacConfig.IsEnabled() {
+		controller.SetRBACResolver(authLogic.NewRBACResolver(rbacConfig))
+
+		// API tokens are scoped with the central permissions, so they are only available with roles
+		apiTokenRepository, err := newAPITokenRepository(apiTokenConfig)
+		if err != nil {
+			return nil, err
+		}
+		controller.SetAPITokenRepository(apiTokenRepository, authLogic.NewAPITokenManager())
+	}
 
 	// Initialize adapters
 	authAdapter := authAdapters.NewAuthAdapter()
@@ -79,10 +123,12 @@ func NewModule(fileConfig []byte) (*Module, error) {
 		authAdapter,
 		responseAdapter,
 		requestAdapter,
+		*sessionConfig,
 	)
 
 	return &Module{
-		config:     config,
+		configs:    configs,
+		rbac:       rbacConfig,
 		controller: controller,
 		handler:    handler,
 	}, nil
@@ -99,17 +145,42 @@ func (m *Module) RegisterRoutes(apiRouter, internalRouter *mux.Router) {
 	// Delegate to handler (following hexagonal architecture)
 	m.handler.RegisterRoutes(apiRouter, internalRouter)
 
-	// Add organization permission middleware if configured
-	if m.config.OrgPermission != "" {
+	// Add organization permission middleware if configured; OIDC, LDAP and RBAC always
+	// build user data so other modules see the groups and permissions of the user
+	if m.needsUserData() {
 		internalRouter.Use(m.handler.OrgPermissionMiddleware)
 	}
 }
 
-// ParseAuthConfigFromFileConfig parses auth config from YAML bytes (exported for main.go)
+// needsUserData checks if any provider or the central roles need user data for each request
+func (m *Module) needsUserData() bool {
+	if m.rbac.IsEnabled() {
+		return true
+	}
+	for _, config := range m.configs {
+		if config.OrgPermission != "" || config.IsOIDC() || config.IsLDAP() {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseAuthConfigFromFileConfig parses the config of the default auth provider from YAML bytes (exported for main.go)
 func ParseAuthConfigFromFileConfig(fileConfig []byte) (*authModels.AuthConfig, error) {
+	configs, err := ParseAuthConfigsFromFileConfig(fileConfig)
+	if err != nil {
+		return nil, err
+	}
+	return configs[0], nil
+}
+
+// ParseAuthConfigsFromFileConfig parses the configs of every auth provider from YAML bytes, the default one first
+func ParseAuthConfigsFromFileConfig(fileConfig []byte) ([]*authModels.AuthConfig, error) {
 	// Parse YAML similar to the original oauth2.loadConfig
 	type dashYaml struct {
 		Auth []struct {
+			Name            string   `yaml:"name"`
+			DisplayName     string   `yaml:"displayName"`
 			Provider        string   `yaml:"provider"`
 			ClientID        string   `yaml:"clientId"`
 			ClientSecret    string   `yaml:"clientSecret"`
@@ -119,6 +190,13 @@ func ParseAuthConfigFromFileConfig(fileConfig []byte) (*authModels.AuthConfig, e
 			URLLoginSuccess string   `yaml:"urlLoginSuccess"`
 			OrgPermission   string   `yaml:"orgPermission"`
 			Scopes          []string `yaml:"scopes"`
+			IssuerURL       string   `yaml:"issuerURL"`
+			UsernameClaim   string   `yaml:"usernameClaim"`
+			EmailClaim      string   `yaml:"emailClaim"`
+			GroupsClaim     string   `yaml:"groupsClaim"`
+			CacheTTL        string   `yaml:"cacheTTL"`
+
+			LDAP authModels.LDAPConfig `yaml:"ldap"`
 		} `yaml:"auth"`
 	}
 
@@ -131,18 +209,129 @@ func ParseAuthConfigFromFileConfig(fileConfig []byte) (*authModels.AuthConfig, e
 		return nil, fmt.Errorf("no auth configuration found")
 	}
 
-	oauth := config.Auth[0]
-	return &authModels.AuthConfig{
-		Provider:        authModels.ProviderGitHub,
-		Method:          authModels.MethodOAuth2,
-		Enabled:         true,
-		ClientID:        oauth.ClientID,
-		ClientSecret:    oauth.ClientSecret,
-		AuthURL:         oauth.AuthURL,
-		TokenURL:        oauth.TokenURL,
-		RedirectURL:     oauth.RedirectURL,
-		URLLoginSuccess: oauth.URLLoginSuccess,
-		OrgPermission:   oauth.OrgPermission,
-		Scopes:          oauth.Scopes,
-	}, nil
+	configs := make([]*authModels.AuthConfig, 0, len(config.Auth))
+	for _, oauth := range config.Auth {
+		provider := authModels.AuthProvider(oauth.Provider)
+		if provider == "" {
+			provider = authModels.ProviderGitHub
+		}
+		method := authModels.MethodOAuth2
+		if provider == authModels.ProviderLDAP {
+			method = authModels.MethodLDAP
+		}
+
+		configs = append(configs, &authModels.AuthConfig{
+			Name:            oauth.Name,
+			DisplayName:     oauth.DisplayName,
+			Provider:        provider,
+			Method:          method,
+			Enabled:         true,
+			ClientID:        oauth.ClientID,
+			ClientSecret:    oauth.ClientSecret,
+			AuthURL:         oauth.AuthURL,
+			TokenURL:        oauth.TokenURL,
+			RedirectURL:     oauth.RedirectURL,
+			URLLoginSuccess: oauth.URLLoginSuccess,
+			OrgPermission:   oauth.OrgPermission,
+			Scopes:          oauth.Scopes,
+			IssuerURL:       oauth.IssuerURL,
+			UsernameClaim:   oauth.UsernameClaim,
+			EmailClaim:      oauth.EmailClaim,
+			GroupsClaim:     oauth.GroupsClaim,
+			CacheTTL:        oauth.CacheTTL,
+			LDAP:            oauth.LDAP,
+		})
+	}
+	return configs, nil
+}
+
+// ParseSessionConfigFromFileConfig parses the login session config from YAML bytes
+func ParseSessionConfigFromFileConfig(fileConfig []byte) (*authModels.SessionConfig, error) {
+	type dashYaml struct {
+		Session authModels.SessionConfig `yaml:"session"`
+	}
+
+	var config dashYaml
+	if err := yaml.Unmarshal(fileConfig, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	return &config.Session, nil
+}
+
+// ParseRBACConfigFromFileConfig parses the central roles and their group bindings from YAML bytes
+func ParseRBACConfigFromFileConfig(fileConfig []byte) (*authModels.RBACConfig, error) {
+	type dashYaml struct {
+		RBAC authModels.RBACConfig `yaml:"rbac"`
+	}
+
+	var config dashYaml
+	if err := yaml.Unmarshal(fileConfig, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	return &config.RBAC, nil
+}
+
+// ParseAPITokenConfigFromFileConfig parses the API token storage config from YAML bytes
+func ParseAPITokenConfigFromFileConfig(fileConfig []byte) (*authModels.APITokenConfig, error) {
+	type dashYaml struct {
+		APITokens authModels.APITokenConfig `yaml:"apiTokens"`
+	}
+
+	var config dashYaml
+	if err := yaml.Unmarshal(fileConfig, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	return &config.APITokens, nil
+}
+
+// newSessionRepository creates the configured session storage
+func newSessionRepository(config *authModels.SessionConfig) (authPorts.SessionRepository, error) {
+	if config.GetStorage() == authModels.SessionStorageFile {
+		sessionRepository, err := repositories.NewFileSessionRepository(config.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open session storage: %w", err)
+		}
+		return sessionRepository, nil
+	}
+	return repositories.NewMemorySessionRepository(), nil
+}
+
+// newAPITokenRepository creates the configured API token storage
+func newAPITokenRepository(config *authModels.APITokenConfig) (authPorts.APITokenRepository, error) {
+	if config.GetStorage() == authModels.SessionStorageFile {
+		apiTokenRepository, err := repositories.NewFileAPITokenRepository(config.File)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open api token storage: %w", err)
+		}
+		return apiTokenRepository, nil
+	}
+	return repositories.NewMemoryAPITokenRepository(), nil
+}
+
+// newGitHubAdapter creates the GitHub integration of a provider
+func newGitHubAdapter(config *authModels.AuthConfig) authPorts.GitHubService {
+	return github.NewGitHubAdapter(&oauth2.Config{
+		ClientID:     config.ClientID,
+		ClientSecret: config.ClientSecret,
+		Scopes:       config.Scopes,
+		RedirectURL:  config.RedirectURL,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  config.AuthURL,
+			TokenURL: config.TokenURL,
+		},
+	})
+}
+
+// setLoginProvider makes an OpenID Connect or LDAP provider replace GitHub for logins and user data
+func setLoginProvider(controller *authControllers.AuthController, config *authModels.AuthConfig) {
+	switch {
+	case config.IsOIDC():
+		controller.SetOIDCProvider(
+			oidc.NewOIDCAdapter(config),
+			authLogic.NewOIDCProcessor(),
+			repositories.NewPendingLoginsRepository(),
+		)
+	case config.IsLDAP():
+		controller.SetLDAPProvider(ldap.NewLDAPAdapter(config))
+	}
 }
@@ -2,10 +2,18 @@ package ports
 
 import (
 	"context"
+	"errors"
 
 	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
 )
 
+var (
+	// ErrSessionNotFound is returned when a session does not exist or was revoked
+	ErrSessionNotFound = errors.New("session not found")
+	// ErrAPITokenNotFound is returned when an API token does not exist or was revoked
+	ErrAPITokenNotFound = errors.New("api token not found")
+)
+
 // UserRepository defines the interface for user data access
 type UserRepository interface {
 	// Create creates a new user
@@ -57,6 +65,30 @@ type SessionRepository interface {
 	UpdateLastUsed(ctx context.Context, sessionID string) error
 }
 
+// APITokenRepository defines the interface for API token storage
+type APITokenRepository interface {
+	// Create stores a new token
+	Create(ctx context.Context, token *authModels.APIToken) error
+
+	// GetByID retrieves a token by ID
+	GetByID(ctx context.Context, id string) (*authModels.APIToken, error)
+
+	// GetByHash retrieves a token by the hash of its secret
+	GetByHash(ctx context.Context, hash string) (*authModels.APIToken, error)
+
+	// List retrieves all tokens
+	List(ctx context.Context) ([]*authModels.APIToken, error)
+
+	// Update updates a token
+	Update(ctx context.Context, token *authModels.APIToken) error
+
+	// Delete deletes a token
+	Delete(ctx context.Context, id string) error
+
+	// DeleteExpired deletes all expired tokens
+	DeleteExpired(ctx context.Context) error
+}
+
 // TokenRepository defines the interface for token storage (optional, for token persistence)
 type TokenRepository interface {
 	// Store stores a token
@@ -2,9 +2,21 @@ package ports
 
 import (
 	"context"
+	"errors"
 
 	"github.com/google/go-github/github"
 	"golang.org/x/oauth2"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+)
+
+var (
+	// ErrInvalidToken is returned when the provider rejects a token, e.g. because it was revoked
+	ErrInvalidToken = errors.New("token rejected by provider")
+	// ErrInvalidCredentials is returned when a directory rejects a username and password
+	ErrInvalidCredentials = errors.New("invalid username or password")
+	// ErrInvalidIDToken is returned when an ID token is malformed, badly signed or not meant for us
+	ErrInvalidIDToken = errors.New("invalid ID token")
 )
 
 // GitHubService defines the interface for GitHub operations needed by auth
@@ -14,6 +26,23 @@ type GitHubService interface {
 	GetUserTeams(ctx context.Context, token *oauth2.Token) ([]*github.Team, error)
 }
 
+// OIDCService defines the OpenID Connect provider operations needed by auth
+type OIDCService interface {
+	// AuthCodeURL returns the authorization URL of a login using PKCE
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange exchanges an authorization code, proving the login with its PKCE verifier
+	Exchange(ctx context.Context, code, codeVerifier string) (*oauth2.Token, error)
+	// VerifyIDToken verifies the signature, issuer, audience and expiry of an ID token and returns its claims.
+	// An empty nonce skips the nonce check, for tokens presented after the login completed.
+	VerifyIDToken(ctx context.Context, rawIDToken, nonce string) (map[string]interface{}, error)
+}
+
+// LDAPService defines the LDAP and Active Directory operations needed by auth
+type LDAPService interface {
+	// Authenticate checks the password of a user with a bind and returns the user with their groups
+	Authenticate(ctx context.Context, username, password string) (*authModels.DirectoryUser, error)
+}
+
 // Optional: Future provider interfaces
 type AuthProviderService interface {
 	GetUser(ctx context.Context, token *oauth2.Token) (interface{}, error)
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// FileAPITokenRepository implements APITokenRepository on top of a JSON file, so tokens survive restarts.
+// Tokens are served from memory and the file is rewritten after each change.
+type FileAPITokenRepository struct {
+	*MemoryAPITokenRepository
+
+	path    string
+	writeMu sync.Mutex
+}
+
+// NewFileAPITokenRepository creates a file API token repository, loading the tokens already stored
+func NewFileAPITokenRepository(path string) (ports.APITokenRepository, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path: %w", err)
+	}
+
+	repository := &FileAPITokenRepository{
+		MemoryAPITokenRepository: newMemoryAPITokenRepository(),
+		path:                     absPath,
+	}
+
+	data, err := os.ReadFile(absPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read api token file: %w", err)
+	}
+	if len(data) > 0 {
+		var tokens []authModels.APIToken
+		if err := json.Unmarshal(data, &tokens); err != nil {
+			return nil, fmt.Errorf("failed to parse api token file: %w", err)
+		}
+		for i := range tokens {
+			repository.tokens[tokens[i].ID] = tokens[i]
+			repository.hashes[tokens[i].Hash] = tokens[i].ID
+		}
+	}
+
+	return repository, nil
+}
+
+// Create stores a new token
+func (r *FileAPITokenRepository) Create(ctx context.Context, token *authModels.APIToken) error {
+	if err := r.MemoryAPITokenRepository.Create(ctx, token); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// Update updates a token; its secret cannot change
+func (r *FileAPITokenRepository) Update(ctx context.Context, token *authModels.APIToken) error {
+	if err := r.MemoryAPITokenRepository.Update(ctx, token); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// Delete deletes a token
+func (r *FileAPITokenRepository) Delete(ctx context.Context, id string) error {
+	if err := r.MemoryAPITokenRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// DeleteExpired deletes all expired tokens
+func (r *FileAPITokenRepository) DeleteExpired(ctx context.Context) error {
+	if err := r.MemoryAPITokenRepository.DeleteExpired(ctx); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// persist rewrites the token file atomically
+func (r *FileAPITokenRepository) persist() error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	data, err := json.Marshal(r.snapshot())
+	if err != nil {
+		return fmt.Errorf("failed to encode api tokens: %w", err)
+	}
+	if err := writeFileAtomically(r.path, data); err != nil {
+		return fmt.Errorf("failed to write api token file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+func TestFileAPITokenRepository_Create_PersistsTokensAcrossRestarts(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "data", "api-tokens.json")
+	repository, err := NewFileAPITokenRepository(path)
+	require.NoError(t, err)
+	token := &authModels.APIToken{
+		ID:        "abc",
+		Name:      "ci",
+		Kind:      authModels.APITokenService,
+		Hash:      "hash",
+		Scopes:    []authModels.RoleGrant{{Permission: "k8s:deployments:scale"}},
+		CreatedAt: time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Act
+	require.NoError(t, repository.Create(context.Background(), token))
+	reopened, err := NewFileAPITokenRepository(path)
+	require.NoError(t, err)
+	loaded, err := reopened.GetByHash(context.Background(), "hash")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "ci", loaded.Name)
+	assert.Equal(t, "k8s:deployments:scale", loaded.Scopes[0].Permission)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestFileAPITokenRepository_Delete_RemovesHashLookup(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "api-tokens.json")
+	repository, err := NewFileAPITokenRepository(path)
+	require.NoError(t, err)
+	require.NoError(t, repository.Create(context.Background(), &authModels.APIToken{
+		ID:        "abc",
+		Hash:      "hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	// Act
+	require.NoError(t, repository.Delete(context.Background(), "abc"))
+	reopened, err := NewFileAPITokenRepository(path)
+	require.NoError(t, err)
+	_, err = reopened.GetByHash(context.Background(), "hash")
+
+	// Assert
+	assert.ErrorIs(t, err, ports.ErrAPITokenNotFound)
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// FileSessionRepository implements SessionRepository on top of a JSON file, so sessions survive restarts.
+// Sessions are served from memory and the file is rewritten after each change.
+type FileSessionRepository struct {
+	*MemorySessionRepository
+
+	path    string
+	writeMu sync.Mutex
+}
+
+// NewFileSessionRepository creates a file session repository, loading the sessions already stored
+func NewFileSessionRepository(path string) (ports.SessionRepository, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path: %w", err)
+	}
+
+	repository := &FileSessionRepository{
+		MemorySessionRepository: newMemorySessionRepository(),
+		path:                    absPath,
+	}
+
+	data, err := os.ReadFile(absPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read session file: %w", err)
+	}
+	if len(data) > 0 {
+		var sessions []authModels.AuthSession
+		if err := json.Unmarshal(data, &sessions); err != nil {
+			return nil, fmt.Errorf("failed to parse session file: %w", err)
+		}
+		for i := range sessions {
+			repository.sessions[sessions[i].SessionID] = sessions[i]
+		}
+	}
+
+	return repository, nil
+}
+
+// Create creates a new session
+func (r *FileSessionRepository) Create(ctx context.Context, session *authModels.AuthSession) error {
+	if err := r.MemorySessionRepository.Create(ctx, session); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// Update updates a session
+func (r *FileSessionRepository) Update(ctx context.Context, session *authModels.AuthSession) error {
+	if err := r.MemorySessionRepository.Update(ctx, session); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// Delete deletes a session
+func (r *FileSessionRepository) Delete(ctx context.Context, sessionID string) error {
+	if err := r.MemorySessionRepository.Delete(ctx, sessionID); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// DeleteByUserID deletes all sessions for a user
+func (r *FileSessionRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	if err := r.MemorySessionRepository.DeleteByUserID(ctx, userID); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// DeleteExpired deletes all expired sessions
+func (r *FileSessionRepository) DeleteExpired(ctx context.Context) error {
+	if err := r.MemorySessionRepository.DeleteExpired(ctx); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// UpdateLastUsed updates session's last used time
+func (r *FileSessionRepository) UpdateLastUsed(ctx context.Context, sessionID string) error {
+	if err := r.MemorySessionRepository.UpdateLastUsed(ctx, sessionID); err != nil {
+		return err
+	}
+	return r.persist()
+}
+
+// persist rewrites the session file atomically; it holds provider tokens, so only the owner can read it
+func (r *FileSessionRepository) persist() error {
+	r.writeMu.Lock()
+	defer r.writeMu.Unlock()
+
+	data, err := json.Marshal(r.snapshot())
+	if err != nil {
+		return fmt.Errorf("failed to encode sessions: %w", err)
+	}
+	if err := writeFileAtomically(r.path, data); err != nil {
+		return fmt.Errorf("failed to write session file: %w", err)
+	}
+	return nil
+}
+
+// writeFileAtomically replaces a file through a temporary file readable by the owner only,
+// so readers never see a partial write
+func writeFileAtomically(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
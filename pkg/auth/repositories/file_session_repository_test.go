@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+func TestFileSessionRepository_Create_PersistsSessionsAcrossRestarts(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "data", "sessions.json")
+	repository, err := NewFileSessionRepository(path)
+	require.NoError(t, err)
+	session := &authModels.AuthSession{
+		SessionID: "abc",
+		UserID:    "github:1",
+		Username:  "octocat",
+		Token:     &authModels.Token{AccessToken: "gho_token", ExpiresAt: time.Now().Add(time.Hour)},
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Act
+	require.NoError(t, repository.Create(context.Background(), session))
+	reopened, err := NewFileSessionRepository(path)
+	require.NoError(t, err)
+	loaded, err := reopened.GetByID(context.Background(), "abc")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", loaded.Username)
+	assert.Equal(t, "gho_token", loaded.Token.AccessToken)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "the file holds provider tokens")
+}
+
+func TestFileSessionRepository_DeleteByUserID_RevokesSessionsOnDisk(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "sessions.json")
+	repository, err := NewFileSessionRepository(path)
+	require.NoError(t, err)
+	for _, sessionID := range []string{"laptop", "phone"} {
+		require.NoError(t, repository.Create(context.Background(), &authModels.AuthSession{
+			SessionID: sessionID,
+			UserID:    "github:1",
+			Token:     &authModels.Token{AccessToken: "gho_token"},
+			ExpiresAt: time.Now().Add(time.Hour),
+		}))
+	}
+
+	// Act
+	require.NoError(t, repository.DeleteByUserID(context.Background(), "github:1"))
+	reopened, err := NewFileSessionRepository(path)
+	require.NoError(t, err)
+	_, err = reopened.GetByID(context.Background(), "laptop")
+
+	// Assert
+	assert.True(t, errors.Is(err, ports.ErrSessionNotFound))
+}
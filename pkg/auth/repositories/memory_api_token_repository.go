@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// MemoryAPITokenRepository implements APITokenRepository in memory; tokens are lost on restart
+type MemoryAPITokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[string]authModels.APIToken
+	hashes map[string]string // secret hash to token ID
+}
+
+// NewMemoryAPITokenRepository creates a new in-memory API token repository
+func NewMemoryAPITokenRepository() ports.APITokenRepository {
+	return newMemoryAPITokenRepository()
+}
+
+func newMemoryAPITokenRepository() *MemoryAPITokenRepository {
+	return &MemoryAPITokenRepository{
+		tokens: make(map[string]authModels.APIToken),
+		hashes: make(map[string]string),
+	}
+}
+
+// Create stores a new token
+func (r *MemoryAPITokenRepository) Create(ctx context.Context, token *authModels.APIToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token.ID] = copyAPIToken(token)
+	r.hashes[token.Hash] = token.ID
+	return nil
+}
+
+// GetByID retrieves a token by ID
+func (r *MemoryAPITokenRepository) GetByID(ctx context.Context, id string) (*authModels.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	token, exists := r.tokens[id]
+	if !exists {
+		return nil, ports.ErrAPITokenNotFound
+	}
+	found := copyAPIToken(&token)
+	return &found, nil
+}
+
+// GetByHash retrieves a token by the hash of its secret
+func (r *MemoryAPITokenRepository) GetByHash(ctx context.Context, hash string) (*authModels.APIToken, error) {
+	r.mu.RLock()
+	id, exists := r.hashes[hash]
+	r.mu.RUnlock()
+
+	if !exists {
+		return nil, ports.ErrAPITokenNotFound
+	}
+	return r.GetByID(ctx, id)
+}
+
+// List retrieves all tokens
+func (r *MemoryAPITokenRepository) List(ctx context.Context) ([]*authModels.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tokens := make([]*authModels.APIToken, 0, len(r.tokens))
+	for _, token := range r.tokens {
+		found := copyAPIToken(&token)
+		tokens = append(tokens, &found)
+	}
+	return tokens, nil
+}
+
+// Update updates a token; its secret cannot change
+func (r *MemoryAPITokenRepository) Update(ctx context.Context, token *authModels.APIToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.tokens[token.ID]
+	if !exists {
+		return ports.ErrAPITokenNotFound
+	}
+	updated := copyAPIToken(token)
+	updated.Hash = existing.Hash
+	r.tokens[token.ID] = updated
+	return nil
+}
+
+// Delete deletes a token
+func (r *MemoryAPITokenRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	token, exists := r.tokens[id]
+	if !exists {
+		return ports.ErrAPITokenNotFound
+	}
+	delete(r.hashes, token.Hash)
+	delete(r.tokens, id)
+	return nil
+}
+
+// DeleteExpired deletes all expired tokens
+func (r *MemoryAPITokenRepository) DeleteExpired(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for id, token := range r.tokens {
+		if token.IsExpired(now) {
+			delete(r.hashes, token.Hash)
+			delete(r.tokens, id)
+		}
+	}
+	return nil
+}
+
+// snapshot returns a copy of all tokens
+func (r *MemoryAPITokenRepository) snapshot() []authModels.APIToken {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tokens := make([]authModels.APIToken, 0, len(r.tokens))
+	for _, token := range r.tokens {
+		tokens = append(tokens, copyAPIToken(&token))
+	}
+	return tokens
+}
+
+// copyAPIToken copies a token and its lists, so stored tokens cannot be changed by callers
+func copyAPIToken(token *authModels.APIToken) authModels.APIToken {
+	copied := *token
+	copied.Groups = append([]string(nil), token.Groups...)
+	copied.Scopes = append([]authModels.RoleGrant(nil), token.Scopes...)
+	return copied
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+	"github.com/dash-ops/dash-ops/pkg/auth/ports"
+)
+
+// MemorySessionRepository implements SessionRepository in memory; sessions are lost on restart
+type MemorySessionRepository struct {
+	mu       sync.RWMutex
+	sessions map[string]authModels.AuthSession
+}
+
+// NewMemorySessionRepository creates a new in-memory session repository
+func NewMemorySessionRepository() ports.SessionRepository {
+	return newMemorySessionRepository()
+}
+
+func newMemorySessionRepository() *MemorySessionRepository {
+	return &MemorySessionRepository{
+		sessions: make(map[string]authModels.AuthSession),
+	}
+}
+
+// Create creates a new session
+func (r *MemorySessionRepository) Create(ctx context.Context, session *authModels.AuthSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions[session.SessionID] = copySession(session)
+	return nil
+}
+
+// GetByID retrieves a session by session ID
+func (r *MemorySessionRepository) GetByID(ctx context.Context, sessionID string) (*authModels.AuthSession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	session, exists := r.sessions[sessionID]
+	if !exists {
+		return nil, ports.ErrSessionNotFound
+	}
+	found := copySession(&session)
+	return &found, nil
+}
+
+// GetByUserID retrieves all sessions for a user
+func (r *MemorySessionRepository) GetByUserID(ctx context.Context, userID string) ([]*authModels.AuthSession, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := []*authModels.AuthSession{}
+	for _, session := range r.sessions {
+		if session.UserID == userID {
+			found := copySession(&session)
+			sessions = append(sessions, &found)
+		}
+	}
+	return sessions, nil
+}
+
+// Update updates a session
+func (r *MemorySessionRepository) Update(ctx context.Context, session *authModels.AuthSession) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.sessions[session.SessionID]; !exists {
+		return ports.ErrSessionNotFound
+	}
+	r.sessions[session.SessionID] = copySession(session)
+	return nil
+}
+
+// Delete deletes a session
+func (r *MemorySessionRepository) Delete(ctx context.Context, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sessions, sessionID)
+	return nil
+}
+
+// DeleteByUserID deletes all sessions for a user
+func (r *MemorySessionRepository) DeleteByUserID(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for sessionID, session := range r.sessions {
+		if session.UserID == userID {
+			delete(r.sessions, sessionID)
+		}
+	}
+	return nil
+}
+
+// DeleteExpired deletes all expired sessions
+func (r *MemorySessionRepository) DeleteExpired(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for sessionID, session := range r.sessions {
+		if now.After(session.ExpiresAt) {
+			delete(r.sessions, sessionID)
+		}
+	}
+	return nil
+}
+
+// UpdateLastUsed updates session's last used time
+func (r *MemorySessionRepository) UpdateLastUsed(ctx context.Context, sessionID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	session, exists := r.sessions[sessionID]
+	if !exists {
+		return ports.ErrSessionNotFound
+	}
+	session.UpdateLastUsed()
+	r.sessions[sessionID] = session
+	return nil
+}
+
+// snapshot returns a copy of all sessions
+func (r *MemorySessionRepository) snapshot() []authModels.AuthSession {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]authModels.AuthSession, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		sessions = append(sessions, copySession(&session))
+	}
+	return sessions
+}
+
+// copySession copies a session and its token, so stored sessions cannot be changed by callers
+func copySession(session *authModels.AuthSession) authModels.AuthSession {
+	copied := *session
+	if session.Token != nil {
+		token := *session.Token
+		copied.Token = &token
+	}
+	return copied
+}
@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	authModels "github.com/dash-ops/dash-ops/pkg/auth/models"
+)
+
+// maxPendingLogins bounds the logins waiting for their callback
+const maxPendingLogins = 1000
+
+// PendingLoginsRepository keeps started logins in memory until their callback arrives or they expire
+type PendingLoginsRepository struct {
+	mu     sync.Mutex
+	logins map[string]authModels.PendingLogin
+	order  []string
+}
+
+// NewPendingLoginsRepository creates a new pending logins repository
+func NewPendingLoginsRepository() *PendingLoginsRepository {
+	return &PendingLoginsRepository{
+		logins: make(map[string]authModels.PendingLogin),
+	}
+}
+
+// SaveLogin stores a login, dropping expired logins and the oldest login when full
+func (r *PendingLoginsRepository) SaveLogin(login *authModels.PendingLogin) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	order := r.order[:0]
+	for _, state := range r.order {
+		existing, exists := r.logins[state]
+		if !exists {
+			continue
+		}
+		if existing.IsExpired(now) {
+			delete(r.logins, state)
+			continue
+		}
+		order = append(order, state)
+	}
+	r.order = order
+
+	if len(r.order) >= maxPendingLogins {
+		delete(r.logins, r.order[0])
+		r.order = r.order[1:]
+	}
+	r.order = append(r.order, login.State)
+	r.logins[login.State] = *login
+}
+
+// TakeLogin removes and returns the login of a state, so a callback can only complete it once
+func (r *PendingLoginsRepository) TakeLogin(state string) (*authModels.PendingLogin, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	login, exists := r.logins[state]
+	if !exists {
+		return nil, false
+	}
+	delete(r.logins, state)
+	return &login, true
+}
@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// maxCachedTokens bounds the tokens whose lookups are kept
+const maxCachedTokens = 10000
+
+// tokenCacheEntry represents a cached lookup result or rejection
+type tokenCacheEntry[V any] struct {
+	value     V
+	err       error
+	expiresAt time.Time
+}
+
+// TokenCache caches lookups made with a token, keyed by the token hash so raw tokens are not kept.
+// Concurrent lookups of the same token share one call to the provider.
+type TokenCache[V any] struct {
+	ttl         time.Duration
+	negativeTTL time.Duration
+	cacheError  func(error) bool
+
+	mu      sync.Mutex
+	entries map[string]tokenCacheEntry[V]
+	group   singleflight.Group
+}
+
+// NewTokenCache creates a token cache; errors accepted by cacheError, such as rejected tokens,
+// are cached for negativeTTL. A non-positive ttl disables caching but keeps de-duplication.
+func NewTokenCache[V any](ttl, negativeTTL time.Duration, cacheError func(error) bool) *TokenCache[V] {
+	return &TokenCache[V]{
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		cacheError:  cacheError,
+		entries:     make(map[string]tokenCacheEntry[V]),
+	}
+}
+
+// Get returns the cached result for a token, loading it when missing or expired
+func (c *TokenCache[V]) Get(token string, load func() (V, error)) (V, error) {
+	key := hashToken(token)
+	if entry, found := c.lookup(key); found {
+		return entry.value, entry.err
+	}
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		value, err := load()
+		c.store(key, value, err)
+		return value, err
+	})
+	value, _ := result.(V)
+	return value, err
+}
+
+// Invalidate drops the cached result of a token, e.g. when its session is logged out
+func (c *TokenCache[V]) Invalidate(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, hashToken(token))
+}
+
+// lookup returns an unexpired entry
+func (c *TokenCache[V]) lookup(key string) (tokenCacheEntry[V], bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists {
+		return entry, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return entry, false
+	}
+	return entry, true
+}
+
+// store caches a result; failures are only cached when they are known to repeat
+func (c *TokenCache[V]) store(key string, value V, err error) {
+	ttl := c.ttl
+	if err != nil {
+		if c.cacheError == nil || !c.cacheError(err) {
+			return
+		}
+		ttl = min(c.negativeTTL, c.ttl)
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxCachedTokens {
+		for existingKey, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, existingKey)
+			}
+		}
+		if len(c.entries) >= maxCachedTokens {
+			return
+		}
+	}
+	c.entries[key] = tokenCacheEntry[V]{value: value, err: err, expiresAt: now.Add(ttl)}
+}
+
+// hashToken hashes a token for use as cache key
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
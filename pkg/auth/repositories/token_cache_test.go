@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errRejected = errors.New("rejected")
+
+func TestTokenCache_Get_WithConcurrentLookups_LoadsOnce(t *testing.T) {
+	// Arrange
+	cache := NewTokenCache[string](time.Minute, time.Second, nil)
+	var loads int32
+	release := make(chan struct{})
+	load := func() (string, error) {
+		atomic.AddInt32(&loads, 1)
+		<-release
+		return "octocat", nil
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	results := make([]string, 10)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cache.Get("gho_token", load)
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	cached, _ := cache.Get("gho_token", load)
+
+	// Assert
+	assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	assert.Equal(t, "octocat", cached)
+	for _, result := range results {
+		assert.Equal(t, "octocat", result)
+	}
+}
+
+func TestTokenCache_Get_CachesOnlyRejections(t *testing.T) {
+	// Arrange
+	cache := NewTokenCache[string](time.Minute, time.Minute, func(err error) bool { return errors.Is(err, errRejected) })
+	rejectedLoads, failedLoads := 0, 0
+
+	// Act
+	for i := 0; i < 3; i++ {
+		cache.Get("revoked", func() (string, error) { rejectedLoads++; return "", errRejected })
+		cache.Get("timeout", func() (string, error) { failedLoads++; return "", errors.New("timeout") })
+	}
+
+	// Assert
+	assert.Equal(t, 1, rejectedLoads, "rejected tokens are negatively cached")
+	assert.Equal(t, 3, failedLoads, "transient failures are retried")
+}
+
+func TestTokenCache_Invalidate_DropsCachedResult(t *testing.T) {
+	// Arrange
+	cache := NewTokenCache[int](time.Minute, time.Second, nil)
+	loads := 0
+	load := func() (int, error) { loads++; return loads, nil }
+	cache.Get("gho_token", load)
+
+	// Act
+	cache.Invalidate("gho_token")
+	value, err := cache.Get("gho_token", load)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+}
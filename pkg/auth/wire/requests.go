@@ -7,6 +7,13 @@ type LoginRequest struct {
 	State       string `json:"state,omitempty"`
 }
 
+// PasswordLoginRequest represents a username and password login against an LDAP provider
+type PasswordLoginRequest struct {
+	Username    string `json:"username" validate:"required"`
+	Password    string `json:"password" validate:"required"`
+	RedirectURL string `json:"redirect_url,omitempty"`
+}
+
 // TokenExchangeRequest represents OAuth2 token exchange request
 type TokenExchangeRequest struct {
 	Code        string `json:"code" validate:"required"`
@@ -24,3 +31,25 @@ type LogoutRequest struct {
 	SessionID   string `json:"session_id,omitempty"`
 	AllSessions bool   `json:"all_sessions,omitempty"`
 }
+
+// APITokenScope represents a permission of an API token, optionally limited to a cluster, namespace, account or service
+type APITokenScope struct {
+	Permission string `json:"permission"`
+	Cluster    string `json:"cluster,omitempty"`
+	Namespace  string `json:"namespace,omitempty"`
+	Account    string `json:"account,omitempty"`
+	Service    string `json:"service,omitempty"`
+}
+
+// CreateAPITokenRequest represents a request to issue an API token
+type CreateAPITokenRequest struct {
+	Name          string          `json:"name" validate:"required"`
+	Kind          string          `json:"kind,omitempty"` // personal (default) or service
+	Scopes        []APITokenScope `json:"scopes" validate:"required"`
+	ExpiresInDays int             `json:"expires_in_days,omitempty"`
+}
+
+// UpdateAPITokenRequest represents a request to rename an API token
+type UpdateAPITokenRequest struct {
+	Name string `json:"name" validate:"required"`
+}
@@ -34,6 +34,16 @@ type UserResponse struct {
 	LastLogin time.Time `json:"last_login"`
 }
 
+// ProfileResponse represents the profile of an OpenID Connect user, with the
+// field names of the GitHub user the frontend already understands
+type ProfileResponse struct {
+	ID        string `json:"id"`
+	Login     string `json:"login"`
+	Name      string `json:"name,omitempty"`
+	Email     string `json:"email,omitempty"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
 // OrganizationResponse represents organization response
 type OrganizationResponse struct {
 	ID   string `json:"id"`
@@ -55,6 +65,7 @@ type TeamResponse struct {
 type SessionResponse struct {
 	SessionID string    `json:"session_id"`
 	UserID    string    `json:"user_id"`
+	Provider  string    `json:"provider,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 	ExpiresAt time.Time `json:"expires_at"`
 	LastUsed  time.Time `json:"last_used"`
@@ -70,10 +81,13 @@ type ProvidersResponse struct {
 // ProviderInfo represents provider information
 type ProviderInfo struct {
 	Name        string   `json:"name"`
+	Type        string   `json:"type"`
 	DisplayName string   `json:"display_name"`
 	Method      string   `json:"method"`
 	Enabled     bool     `json:"enabled"`
 	Scopes      []string `json:"scopes,omitempty"`
+	LoginURL    string   `json:"login_url"`
+	Default     bool     `json:"default"`
 }
 
 // LogoutResponse represents logout response
@@ -82,3 +96,23 @@ type LogoutResponse struct {
 	RedirectURL   string `json:"redirect_url,omitempty"`
 	SessionsEnded int    `json:"sessions_ended,omitempty"`
 }
+
+// APITokenResponse represents an API token without its secret
+type APITokenResponse struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Kind       string          `json:"kind"`
+	Hint       string          `json:"hint"`
+	Username   string          `json:"username"`
+	Scopes     []APITokenScope `json:"scopes"`
+	CreatedBy  string          `json:"created_by"`
+	CreatedAt  time.Time       `json:"created_at"`
+	ExpiresAt  time.Time       `json:"expires_at"`
+	LastUsedAt *time.Time      `json:"last_used_at,omitempty"`
+}
+
+// CreatedAPITokenResponse represents a newly issued API token; the secret is only ever returned here
+type CreatedAPITokenResponse struct {
+	APITokenResponse
+	Token string `json:"token"`
+}
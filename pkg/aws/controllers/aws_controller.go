@@ -68,7 +68,7 @@ func (ac *AWSController) ListInstances(ctx context.Context, accountKey, region s
 	}
 
 	// Check view permissions
-	if userContext != nil && !account.HasEC2ViewPermission(userContext.Groups) {
+	if userContext != nil && !account.IsAllowed(awsModels.RBACEC2View, userContext.Groups, userContext.Permissions) {
 		return nil, fmt.Errorf("user does not have permission to view instances in account %s", accountKey)
 	}
 
@@ -103,7 +103,7 @@ func (ac *AWSController) GetInstance(ctx context.Context, accountKey, region, in
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
 
-	if userContext != nil && !account.HasEC2ViewPermission(userContext.Groups) {
+	if userContext != nil && !account.IsAllowed(awsModels.RBACEC2View, userContext.Groups, userContext.Permissions) {
 		return nil, fmt.Errorf("user does not have permission to view instances in account %s", accountKey)
 	}
 
@@ -124,7 +124,7 @@ func (ac *AWSController) StartInstance(ctx context.Context, accountKey, region,
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
 
-	if userContext != nil && !account.HasEC2StartPermission(userContext.Groups) {
+	if userContext != nil && !account.IsAllowed(awsModels.RBACEC2Start, userContext.Groups, userContext.Permissions) {
 		return nil, fmt.Errorf("user does not have permission to start instances in account %s", accountKey)
 	}
 
@@ -156,7 +156,7 @@ func (ac *AWSController) StopInstance(ctx context.Context, accountKey, region, i
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
 
-	if userContext != nil && !account.HasEC2StopPermission(userContext.Groups) {
+	if userContext != nil && !account.IsAllowed(awsModels.RBACEC2Stop, userContext.Groups, userContext.Permissions) {
 		return nil, fmt.Errorf("user does not have permission to stop instances in account %s", accountKey)
 	}
 
@@ -196,11 +196,11 @@ func (ac *AWSController) BatchOperation(ctx context.Context, accountKey, region,
 	if userContext != nil {
 		switch operation {
 		case "start":
-			if !account.HasEC2StartPermission(userContext.Groups) {
+			if !account.IsAllowed(awsModels.RBACEC2Start, userContext.Groups, userContext.Permissions) {
 				return nil, fmt.Errorf("user does not have permission to start instances")
 			}
 		case "stop":
-			if !account.HasEC2StopPermission(userContext.Groups) {
+			if !account.IsAllowed(awsModels.RBACEC2Stop, userContext.Groups, userContext.Permissions) {
 				return nil, fmt.Errorf("user does not have permission to stop instances")
 			}
 		default:
@@ -237,7 +237,7 @@ func (ac *AWSController) GetAccountSummary(ctx context.Context, accountKey, regi
 	}
 
 	// Check permissions
-	if userContext != nil && !account.HasEC2ViewPermission(userContext.Groups) {
+	if userContext != nil && !account.IsAllowed(awsModels.RBACEC2View, userContext.Groups, userContext.Permissions) {
 		return nil, fmt.Errorf("user does not have permission to view account summary")
 	}
 
@@ -151,6 +151,10 @@ func (h *HTTPHandler) listInstancesHandler(w http.ResponseWriter, r *http.Reques
 	// Parse query parameters
 	filter := h.parseInstanceFilter(r)
 
+	if !h.authorize(w, r, accountKey, awsModels.RBACEC2View) {
+		return
+	}
+
 	// List instances
 	instanceList, err := h.instancesController.ListInstances(r.Context(), accountKey, region, filter)
 	if err != nil {
@@ -176,6 +180,10 @@ func (h *HTTPHandler) listInstancesByRegionHandler(w http.ResponseWriter, r *htt
 	// Parse query parameters
 	filter := h.parseInstanceFilter(r)
 
+	if !h.authorize(w, r, accountKey, awsModels.RBACEC2View) {
+		return
+	}
+
 	// List instances
 	instanceList, err := h.instancesController.ListInstances(r.Context(), accountKey, region, filter)
 	if err != nil {
@@ -204,6 +212,10 @@ func (h *HTTPHandler) startInstanceHandler(w http.ResponseWriter, r *http.Reques
 		region = "us-east-1"
 	}
 
+	if !h.authorize(w, r, accountKey, awsModels.RBACEC2Start) {
+		return
+	}
+
 	// Start instance
 	operation, err := h.instancesController.StartInstance(r.Context(), accountKey, region, instanceID)
 	if err != nil {
@@ -235,6 +247,10 @@ func (h *HTTPHandler) stopInstanceHandler(w http.ResponseWriter, r *http.Request
 		region = "us-east-1"
 	}
 
+	if !h.authorize(w, r, accountKey, awsModels.RBACEC2Stop) {
+		return
+	}
+
 	// Stop instance
 	operation, err := h.instancesController.StopInstance(r.Context(), accountKey, region, instanceID)
 	if err != nil {
@@ -267,6 +283,10 @@ func (h *HTTPHandler) batchOperationHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	if !h.authorize(w, r, accountKey, batchPermissions(req.Operation)...) {
+		return
+	}
+
 	// Execute batch operation
 	batchOp, err := h.instancesController.BatchOperation(r.Context(), accountKey, region, req.Operation, req.InstanceIDs)
 	if err != nil {
@@ -343,6 +363,10 @@ func (h *HTTPHandler) getInstanceHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	if !h.authorize(w, r, accountKey, awsModels.RBACEC2View) {
+		return
+	}
+
 	// Get instance
 	instance, err := h.instancesController.GetInstance(r.Context(), accountKey, region, instanceID)
 	if err != nil {
@@ -418,14 +442,51 @@ func (h *HTTPHandler) getInstanceCostEstimateHandler(w http.ResponseWriter, r *h
 	h.responseAdapter.WriteError(w, http.StatusNotImplemented, "Cost estimate functionality not implemented yet")
 }
 
-// getUserContext extracts user context from request
+// getUserContext extracts the authenticated user from the request, or nil when there is none
 func (h *HTTPHandler) getUserContext(r *http.Request) *awsPorts.UserContext {
-	// TODO: Implement OAuth2 integration to get user context
-	// For now, return a default user context for testing
+	userData, ok := commonsHttp.UserDataFromRequest(r)
+	if !ok {
+		return nil
+	}
 	return &awsPorts.UserContext{
-		Username: "test-user",
-		Name:     "Test User",
-		Email:    "test@example.com",
-		Groups:   []string{"admin"}, // Default to admin for testing
+		Username:    userData.Username,
+		Name:        userData.Username,
+		Email:       userData.Email,
+		Groups:      userData.Groups,
+		Permissions: userData.Permissions,
+	}
+}
+
+// authorize checks EC2 permissions of the authenticated user on an account and writes the error response when refused.
+// Requests without a user are let through, as there is no identity to check.
+func (h *HTTPHandler) authorize(w http.ResponseWriter, r *http.Request, accountKey string, rbacPermissions ...string) bool {
+	userContext := h.getUserContext(r)
+	if userContext == nil {
+		return true
+	}
+
+	account, err := h.accountsController.GetAccount(r.Context(), accountKey)
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusNotFound, "Account not found: "+err.Error())
+		return false
+	}
+	for _, rbacPermission := range rbacPermissions {
+		if !account.IsAllowed(rbacPermission, userContext.Groups, userContext.Permissions) {
+			h.responseAdapter.WriteError(w, http.StatusForbidden, "Permission denied: "+rbacPermission+" in account "+accountKey)
+			return false
+		}
+	}
+	return true
+}
+
+// batchPermissions returns the permissions a batch operation needs; a restart stops and starts instances
+func batchPermissions(operation string) []string {
+	switch operation {
+	case "start":
+		return []string{awsModels.RBACEC2Start}
+	case "stop":
+		return []string{awsModels.RBACEC2Stop}
+	default:
+		return []string{awsModels.RBACEC2Stop, awsModels.RBACEC2Start}
 	}
 }
@@ -4,6 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"time"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// Permissions of the AWS module in the central RBAC model, scoped by account key
+const (
+	RBACEC2Start = "aws:ec2:start"
+	RBACEC2Stop  = "aws:ec2:stop"
+	RBACEC2View  = "aws:ec2:view"
 )
 
 // AWSAccount represents an AWS account configuration
@@ -208,6 +217,25 @@ func (acc *AWSAccount) HasEC2ViewPermission(userGroups []string) bool {
 	return acc.hasPermission(acc.Permissions.EC2.View, userGroups)
 }
 
+// IsAllowed checks if a user may perform an EC2 operation, named by its RBAC permission.
+// Central roles decide when the user has them and the account's group lists otherwise.
+func (acc *AWSAccount) IsAllowed(rbacPermission string, userGroups []string, permissions *commonsModels.Permissions) bool {
+	if permissions != nil {
+		return permissions.Allows(rbacPermission, commonsModels.PermissionScope{Account: acc.Key})
+	}
+
+	switch rbacPermission {
+	case RBACEC2Start:
+		return acc.HasEC2StartPermission(userGroups)
+	case RBACEC2Stop:
+		return acc.HasEC2StopPermission(userGroups)
+	case RBACEC2View:
+		return acc.HasEC2ViewPermission(userGroups)
+	default:
+		return false
+	}
+}
+
 // hasPermission checks if user has any of the required permissions
 func (acc *AWSAccount) hasPermission(requiredPerms []string, userGroups []string) bool {
 	if len(requiredPerms) == 0 {
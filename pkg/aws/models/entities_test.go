@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 )
 
 func TestAWSAccount_Validate_WithValidAccount_ReturnsNoError(t *testing.T) {
@@ -538,3 +540,31 @@ func TestEC2Instance_GetCostEstimate_WithUnknownType_ReturnsZero(t *testing.T) {
 	// Assert
 	assert.Equal(t, 0.0, result)
 }
+
+func TestAWSAccount_IsAllowed_WithoutRBAC_UsesGroupLists(t *testing.T) {
+	// Arrange
+	account := AWSAccount{
+		Key:         "production",
+		Permissions: AccountPermissions{EC2: EC2Permissions{Stop: []string{"ec2-operators"}}},
+	}
+
+	// Act & Assert
+	assert.True(t, account.IsAllowed(RBACEC2Stop, []string{"ec2-operators"}, nil))
+	assert.False(t, account.IsAllowed(RBACEC2Stop, []string{"developers"}, nil))
+}
+
+func TestAWSAccount_IsAllowed_WithRBAC_UsesGrantsScopedToAccount(t *testing.T) {
+	// Arrange
+	account := AWSAccount{
+		Key:         "production",
+		Permissions: AccountPermissions{EC2: EC2Permissions{Stop: []string{"ec2-operators"}}},
+	}
+	permissions := &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{
+		{Permission: "aws:ec2:start", Scope: commonsModels.PermissionScope{Account: "production"}},
+		{Permission: "aws:ec2:stop", Scope: commonsModels.PermissionScope{Account: "staging"}},
+	}}
+
+	// Act & Assert
+	assert.True(t, account.IsAllowed(RBACEC2Start, nil, permissions))
+	assert.False(t, account.IsAllowed(RBACEC2Stop, []string{"ec2-operators"}, permissions))
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	awsModels "github.com/dash-ops/dash-ops/pkg/aws/models"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 )
 
 // AWSClientService defines the interface for AWS SDK operations
@@ -175,6 +176,9 @@ type UserContext struct {
 	Email    string   `json:"email"`
 	Groups   []string `json:"groups"`
 	IP       string   `json:"ip,omitempty"`
+
+	// Permissions of the user's central roles; nil when RBAC is not configured
+	Permissions *commonsModels.Permissions `json:"-"`
 }
 
 // CostOptimizationService defines the interface for cost optimization
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"net/http"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+)
+
+// UserDataFromRequest returns the authenticated user of a request, if any
+func UserDataFromRequest(r *http.Request) (*commonsModels.UserData, bool) {
+	userData, ok := r.Context().Value(commonsModels.UserDataKey).(*commonsModels.UserData)
+	return userData, ok && userData != nil
+}
+
+// RequirePermission returns a middleware answering 403 unless the central roles of the user grant a permission.
+// scopeOf builds the scope from the request, e.g. from route variables, and may be nil for unscoped permissions.
+// Requests pass through when RBAC is not configured, leaving the decision to the module's own group checks.
+func RequirePermission(permission string, scopeOf func(*http.Request) commonsModels.PermissionScope) func(http.Handler) http.Handler {
+	responseAdapter := NewResponseAdapter()
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userData, _ := UserDataFromRequest(r)
+			if userData.HasRBAC() {
+				var scope commonsModels.PermissionScope
+				if scopeOf != nil {
+					scope = scopeOf(r)
+				}
+				if !userData.Can(permission, scope) {
+					responseAdapter.WriteError(w, http.StatusForbidden, "Permission denied: "+permission)
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
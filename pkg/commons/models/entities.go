@@ -10,6 +10,22 @@ type UserData struct {
 	Email     string    `json:"email,omitempty"`
 	Token     string    `json:"-"` // Don't serialize token
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
+
+	// Permissions are resolved from central roles; nil when RBAC is not configured
+	Permissions *Permissions `json:"permissions,omitempty"`
+}
+
+// PermissionGrant represents a permission granted by a role, optionally limited to a scope
+type PermissionGrant struct {
+	Permission string          `json:"permission"`
+	Scope      PermissionScope `json:"scope"`
+	Role       string          `json:"role"`
+}
+
+// Permissions represents the effective permissions of a user under central RBAC
+type Permissions struct {
+	Roles  []string          `json:"roles"`
+	Grants []PermissionGrant `json:"grants"`
 }
 
 // HasGroup checks if user belongs to a specific group
@@ -42,3 +58,26 @@ func (u *UserData) IsValid() bool {
 	}
 	return true
 }
+
+// HasRBAC checks if access is decided by central roles instead of per-module group lists
+func (u *UserData) HasRBAC() bool {
+	return u != nil && u.Permissions != nil
+}
+
+// Can checks if the central roles of the user grant a permission in a scope
+func (u *UserData) Can(permission string, scope PermissionScope) bool {
+	return u.HasRBAC() && u.Permissions.Allows(permission, scope)
+}
+
+// Allows checks if any grant includes a permission in a scope
+func (p *Permissions) Allows(permission string, scope PermissionScope) bool {
+	if p == nil {
+		return false
+	}
+	for _, grant := range p.Grants {
+		if MatchPermission(grant.Permission, permission) && grant.Scope.Covers(scope) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,7 @@
 package commons
 
+import "strings"
+
 // ContextKey represents a type-safe context key
 type ContextKey string
 
@@ -13,3 +15,57 @@ const (
 func (c ContextKey) String() string {
 	return string(c)
 }
+
+// PermissionScope narrows a permission to a cluster, namespace, AWS account or catalog service.
+// Empty fields match any value; values ending in * match by prefix.
+type PermissionScope struct {
+	Cluster   string `yaml:"cluster" json:"cluster,omitempty"`
+	Namespace string `yaml:"namespace" json:"namespace,omitempty"`
+	Account   string `yaml:"account" json:"account,omitempty"`
+	Service   string `yaml:"service" json:"service,omitempty"`
+}
+
+// Covers checks if the scope includes a target scope.
+// A field restricted here but left empty by the target is not covered, so scoped grants never apply to unscoped checks.
+func (s PermissionScope) Covers(target PermissionScope) bool {
+	return scopeValueCovers(s.Cluster, target.Cluster) &&
+		scopeValueCovers(s.Namespace, target.Namespace) &&
+		scopeValueCovers(s.Account, target.Account) &&
+		scopeValueCovers(s.Service, target.Service)
+}
+
+// IsEmpty checks if the scope matches everything
+func (s PermissionScope) IsEmpty() bool {
+	return s == PermissionScope{}
+}
+
+// scopeValueCovers checks a single scope field
+func scopeValueCovers(granted, requested string) bool {
+	if granted == "" || granted == "*" {
+		return true
+	}
+	if requested == "" {
+		return false
+	}
+	if strings.HasSuffix(granted, "*") {
+		return strings.HasPrefix(requested, strings.TrimSuffix(granted, "*"))
+	}
+	return granted == requested
+}
+
+// MatchPermission checks if a permission pattern such as k8s:deployments:* includes a permission.
+// Segments are separated by colons, * matches one segment and a trailing * matches all remaining segments.
+func MatchPermission(pattern, permission string) bool {
+	patternSegments := strings.Split(pattern, ":")
+	segments := strings.Split(permission, ":")
+
+	for i, patternSegment := range patternSegments {
+		if patternSegment == "*" && i == len(patternSegments)-1 {
+			return len(segments) >= i+1
+		}
+		if i >= len(segments) || (patternSegment != "*" && patternSegment != segments[i]) {
+			return false
+		}
+	}
+	return len(segments) == len(patternSegments)
+}
@@ -41,7 +41,16 @@ func (ca *ConfigAdapter) ParseModuleConfig(fileConfig []byte) (*k8sModels.Module
 		return nil, err
 	}
 
+	// The dashboard origin is a top-level setting shared with the CORS configuration
+	var dashYaml struct {
+		Origin string `yaml:"origin"`
+	}
+	if err := yaml.Unmarshal(fileConfig, &dashYaml); err != nil {
+		return nil, fmt.Errorf("failed to parse origin: %w", err)
+	}
+
 	return &k8sModels.ModuleConfig{
 		Configs: configs,
+		Origin:  dashYaml.Origin,
 	}, nil
 }
@@ -0,0 +1,51 @@
+package http
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// DeploymentChangeFromRequest converts DeploymentChangeRequest to the DeploymentChange model
+func DeploymentChangeFromRequest(req *k8sWire.DeploymentChangeRequest) k8sModels.DeploymentChange {
+	containers := make([]k8sModels.ContainerChange, 0, len(req.Containers))
+	for _, container := range req.Containers {
+		containers = append(containers, k8sModels.ContainerChange{
+			Name:     container.Name,
+			Image:    container.Image,
+			Env:      container.Env,
+			Requests: container.Requests,
+			Limits:   container.Limits,
+		})
+	}
+	return k8sModels.DeploymentChange{
+		Name:       req.Name,
+		Containers: containers,
+	}
+}
+
+// ApplyPlanToResponse converts ApplyPlan model to ApplyPlanResponse
+func ApplyPlanToResponse(plan *k8sModels.ApplyPlan) k8sWire.ApplyPlanResponse {
+	changes := make([]k8sWire.ManifestChangeResponse, 0, len(plan.Changes))
+	for _, change := range plan.Changes {
+		changes = append(changes, k8sWire.ManifestChangeResponse{
+			Path:      change.Path,
+			Operation: string(change.Operation),
+			Before:    change.Before,
+			After:     change.After,
+		})
+	}
+
+	response := k8sWire.ApplyPlanResponse{
+		Token:      plan.Token,
+		Action:     string(plan.Action()),
+		APIVersion: plan.APIVersion,
+		Kind:       plan.Kind,
+		Namespace:  plan.Namespace,
+		Name:       plan.Name,
+		Changes:    changes,
+	}
+	if plan.Token != "" {
+		response.ExpiresAt = &plan.ExpiresAt
+	}
+	return response
+}
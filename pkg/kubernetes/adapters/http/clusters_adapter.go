@@ -2,6 +2,7 @@ package http
 
 import (
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
 	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
 )
 
@@ -9,11 +10,15 @@ import (
 func ClusterInfoToResponse(clusterInfo *k8sModels.ClusterInfo) k8sWire.ClusterInfoResponse {
 	return k8sWire.ClusterInfoResponse{
 		Cluster: k8sWire.ClusterResponse{
-			Name:    clusterInfo.Cluster.Name,
-			Context: clusterInfo.Cluster.Context,
-			Server:  clusterInfo.Cluster.Server,
-			Version: clusterInfo.Cluster.Version,
-			Status:  string(clusterInfo.Cluster.Status),
+			Name:          clusterInfo.Cluster.Name,
+			Context:       clusterInfo.Cluster.Context,
+			Server:        clusterInfo.Cluster.Server,
+			Version:       clusterInfo.Cluster.Version,
+			Status:        string(clusterInfo.Cluster.Status),
+			AuthMode:      string(clusterInfo.Cluster.AuthMode),
+			Impersonation: clusterInfo.Cluster.Impersonation,
+			Error:         clusterInfo.Cluster.Error,
+			Cache:         ClusterCacheToResponse(clusterInfo.Cluster.Cache),
 		},
 		Nodes:       NodesToResponse(clusterInfo.Nodes),
 		Namespaces:  NamespacesToResponse(clusterInfo.Namespaces),
@@ -31,12 +36,13 @@ func ClusterSummaryToResponse(summary *k8sModels.ClusterSummary) k8sWire.Cluster
 		TotalDeployments: summary.TotalDeployments,
 		TotalPods:        summary.TotalPods,
 		RunningPods:      summary.RunningPods,
+		Resources:        NodeResourcesToResponse(summary.Resources),
 	}
 }
 
-// ClusterHealthToResponse converts ClusterHealth model to ClusterHealthResponse
-func ClusterHealthToResponse(health *k8sModels.ClusterHealth) k8sWire.ClusterHealthResponse {
-	var nodes []k8sWire.NodeHealthResponse
+// ClusterHealthToResponse converts ClusterHealth to ClusterHealthResponse
+func ClusterHealthToResponse(health *k8sPorts.ClusterHealth) k8sWire.ClusterHealthResponse {
+	nodes := make([]k8sWire.NodeHealthResponse, 0, len(health.Nodes))
 	for _, node := range health.Nodes {
 		var conditions []k8sWire.NodeConditionResponse
 		for _, condition := range node.Conditions {
@@ -54,49 +60,130 @@ func ClusterHealthToResponse(health *k8sModels.ClusterHealth) k8sWire.ClusterHea
 			Status:     string(node.Status),
 			Conditions: conditions,
 			Resources: k8sWire.ResourceHealthResponse{
-				CPU: k8sWire.ResourceHealthDetailResponse{
-					Used:               node.Resources.CPU.Used,
-					Available:          node.Resources.CPU.Available,
-					Total:              node.Resources.CPU.Total,
-					UtilizationPercent: node.Resources.CPU.UtilizationPercent,
-					Status:             string(node.Resources.CPU.Status),
-				},
-				Memory: k8sWire.ResourceHealthDetailResponse{
-					Used:               node.Resources.Memory.Used,
-					Available:          node.Resources.Memory.Available,
-					Total:              node.Resources.Memory.Total,
-					UtilizationPercent: node.Resources.Memory.UtilizationPercent,
-					Status:             string(node.Resources.Memory.Status),
-				},
+				CPU:    resourceHealthDetailToResponse(node.Resources.CPU),
+				Memory: resourceHealthDetailToResponse(node.Resources.Memory),
+				Pods:   resourceHealthDetailToResponse(node.Resources.Pods),
 			},
 			LastUpdated: node.LastUpdated,
 		})
 	}
 
+	namespaces := make([]k8sWire.NamespaceHealthResponse, 0, len(health.Namespaces))
+	for _, namespace := range health.Namespaces {
+		namespaces = append(namespaces, namespaceHealthToResponse(namespace))
+	}
+
 	return k8sWire.ClusterHealthResponse{
 		Context:     health.Context,
 		Status:      string(health.Status),
 		Nodes:       nodes,
+		Namespaces:  namespaces,
 		Summary:     ClusterSummaryToResponse(&health.Summary),
 		LastUpdated: health.LastUpdated,
 	}
 }
 
+// namespaceHealthToResponse converts NamespaceHealth to NamespaceHealthResponse
+func namespaceHealthToResponse(namespace k8sPorts.NamespaceHealth) k8sWire.NamespaceHealthResponse {
+	deployments := make([]k8sWire.DeploymentHealthResponse, 0, len(namespace.Deployments))
+	for _, deployment := range namespace.Deployments {
+		var conditions []k8sWire.DeploymentConditionResponse
+		for _, condition := range deployment.Conditions {
+			conditions = append(conditions, k8sWire.DeploymentConditionResponse{
+				Type:           condition.Type,
+				Status:         condition.Status,
+				Reason:         condition.Reason,
+				Message:        condition.Message,
+				LastUpdateTime: condition.LastUpdateTime,
+			})
+		}
+
+		deployments = append(deployments, k8sWire.DeploymentHealthResponse{
+			Name:                deployment.Name,
+			Namespace:           deployment.Namespace,
+			Status:              deployment.Status,
+			Replicas:            DeploymentReplicasToResponse(deployment.Replicas),
+			Conditions:          conditions,
+			AvailabilityPercent: deployment.AvailabilityPercent,
+			LastUpdated:         deployment.LastUpdated,
+		})
+	}
+
+	pods := make([]k8sWire.PodHealthResponse, 0, len(namespace.Pods))
+	for _, pod := range namespace.Pods {
+		containers := make([]k8sWire.ContainerHealthResponse, 0, len(pod.Containers))
+		for _, container := range pod.Containers {
+			containers = append(containers, k8sWire.ContainerHealthResponse{
+				Name:         container.Name,
+				Ready:        container.Ready,
+				RestartCount: container.RestartCount,
+				State:        container.State,
+				LastUpdated:  container.LastUpdated,
+			})
+		}
+
+		pods = append(pods, k8sWire.PodHealthResponse{
+			Name:        pod.Name,
+			Namespace:   pod.Namespace,
+			Status:      string(pod.Status),
+			Phase:       pod.Phase,
+			Ready:       pod.Ready,
+			Restarts:    pod.Restarts,
+			Containers:  containers,
+			LastUpdated: pod.LastUpdated,
+		})
+	}
+
+	return k8sWire.NamespaceHealthResponse{
+		Name:        namespace.Name,
+		Status:      string(namespace.Status),
+		Deployments: deployments,
+		Pods:        pods,
+		LastUpdated: namespace.LastUpdated,
+	}
+}
+
+// resourceHealthDetailToResponse converts ResourceHealthDetail to ResourceHealthDetailResponse
+func resourceHealthDetailToResponse(detail k8sPorts.ResourceHealthDetail) k8sWire.ResourceHealthDetailResponse {
+	return k8sWire.ResourceHealthDetailResponse{
+		Used:               detail.Used,
+		Available:          detail.Available,
+		Total:              detail.Total,
+		UtilizationPercent: detail.UtilizationPercent,
+		Status:             detail.Status,
+	}
+}
+
 // ClustersToResponse converts Cluster slice to ClusterResponse slice
 func ClustersToResponse(clusters []k8sModels.Cluster) []k8sWire.ClusterResponse {
 	var response []k8sWire.ClusterResponse
 	for _, cluster := range clusters {
 		response = append(response, k8sWire.ClusterResponse{
-			Name:    cluster.Name,
-			Context: cluster.Context,
-			Server:  cluster.Server,
-			Version: cluster.Version,
-			Status:  string(cluster.Status),
+			Name:          cluster.Name,
+			Context:       cluster.Context,
+			Server:        cluster.Server,
+			Version:       cluster.Version,
+			Status:        string(cluster.Status),
+			AuthMode:      string(cluster.AuthMode),
+			Impersonation: cluster.Impersonation,
+			Error:         cluster.Error,
+			Cache:         ClusterCacheToResponse(cluster.Cache),
 		})
 	}
 	return response
 }
 
+// ClusterCacheToResponse converts ClusterCache model to ClusterCacheResponse
+func ClusterCacheToResponse(cache *k8sModels.ClusterCache) *k8sWire.ClusterCacheResponse {
+	if cache == nil {
+		return nil
+	}
+	return &k8sWire.ClusterCacheResponse{
+		Synced:   cache.Synced,
+		SyncedAt: cache.SyncedAt,
+	}
+}
+
 // ClusterListToResponse converts Cluster slice to ClusterListResponse
 func ClusterListToResponse(clusters []k8sModels.Cluster) k8sWire.ClusterListResponse {
 	return k8sWire.ClusterListResponse{
@@ -55,17 +55,13 @@ func DeploymentToResponse(deployment *k8sModels.Deployment) *k8sWire.DeploymentR
 			Failed:  deployment.PodInfo.Failed,
 			Total:   deployment.PodInfo.Total,
 		},
-		Replicas: k8sWire.DeploymentReplicasResponse{
-			Desired:   deployment.Replicas.Desired,
-			Current:   deployment.Replicas.Current,
-			Ready:     deployment.Replicas.Ready,
-			Available: deployment.Replicas.Available,
-		},
+		Replicas:            DeploymentReplicasToResponse(deployment.Replicas),
 		Age:                 deployment.Age,
 		CreatedAt:           deployment.CreatedAt,
 		Conditions:          conditions,
 		ServiceContext:      serviceContext,
 		AvailabilityPercent: deployment.GetAvailabilityPercentage(),
+		Autoscaler:          AutoscalerToResponse(deployment.Autoscaler),
 	}
 }
 
@@ -77,3 +73,91 @@ func DeploymentsToResponse(deployments []k8sModels.Deployment) []k8sWire.Deploym
 	}
 	return response
 }
+
+// DeploymentReplicasToResponse converts DeploymentReplicas model to DeploymentReplicasResponse
+func DeploymentReplicasToResponse(replicas k8sModels.DeploymentReplicas) k8sWire.DeploymentReplicasResponse {
+	return k8sWire.DeploymentReplicasResponse{
+		Desired:   replicas.Desired,
+		Current:   replicas.Current,
+		Ready:     replicas.Ready,
+		Available: replicas.Available,
+		Updated:   replicas.Updated,
+	}
+}
+
+// RolloutHistoryToResponse converts deployment revisions to RolloutHistoryResponse
+func RolloutHistoryToResponse(namespace, name string, revisions []k8sModels.DeploymentRevision) k8sWire.RolloutHistoryResponse {
+	response := k8sWire.RolloutHistoryResponse{
+		Name:      name,
+		Namespace: namespace,
+		Revisions: make([]k8sWire.DeploymentRevisionResponse, 0, len(revisions)),
+	}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, DeploymentRevisionToResponse(revision))
+	}
+	return response
+}
+
+// DeploymentRevisionToResponse converts DeploymentRevision model to DeploymentRevisionResponse
+func DeploymentRevisionToResponse(revision k8sModels.DeploymentRevision) k8sWire.DeploymentRevisionResponse {
+	return k8sWire.DeploymentRevisionResponse{
+		Revision:    revision.Revision,
+		ReplicaSet:  revision.ReplicaSet,
+		ChangeCause: revision.ChangeCause,
+		Images:      revision.Images,
+		Replicas:    revision.Replicas,
+		Ready:       revision.Ready,
+		CreatedAt:   revision.CreatedAt,
+		Current:     revision.Current,
+	}
+}
+
+// RolloutStatusToResponse converts RolloutStatus model to RolloutStatusResponse
+func RolloutStatusToResponse(status *k8sModels.RolloutStatus) k8sWire.RolloutStatusResponse {
+	return k8sWire.RolloutStatusResponse{
+		Name:      status.Name,
+		Namespace: status.Namespace,
+		State:     string(status.State),
+		Message:   status.Message,
+		Revision:  status.Revision,
+		Replicas:  DeploymentReplicasToResponse(status.Replicas),
+	}
+}
+
+// AutoscalerToResponse converts a HorizontalPodAutoscaler model to AutoscalerResponse, keeping nil as nil
+func AutoscalerToResponse(autoscaler *k8sModels.HorizontalPodAutoscaler) *k8sWire.AutoscalerResponse {
+	if autoscaler == nil {
+		return nil
+	}
+
+	metrics := make([]k8sWire.AutoscalerMetricResponse, 0, len(autoscaler.Metrics))
+	for _, metric := range autoscaler.Metrics {
+		metrics = append(metrics, k8sWire.AutoscalerMetricResponse{
+			Type:    metric.Type,
+			Name:    metric.Name,
+			Target:  metric.Target,
+			Current: metric.Current,
+		})
+	}
+
+	var conditions []k8sWire.AutoscalerConditionResponse
+	for _, condition := range autoscaler.Conditions {
+		conditions = append(conditions, k8sWire.AutoscalerConditionResponse{
+			Type:    condition.Type,
+			Status:  condition.Status,
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	return &k8sWire.AutoscalerResponse{
+		Name:            autoscaler.Name,
+		MinReplicas:     autoscaler.MinReplicas,
+		MaxReplicas:     autoscaler.MaxReplicas,
+		CurrentReplicas: autoscaler.CurrentReplicas,
+		DesiredReplicas: autoscaler.DesiredReplicas,
+		Metrics:         metrics,
+		Conditions:      conditions,
+		LastScaleTime:   autoscaler.LastScaleTime,
+	}
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// EventsToResponse converts Event slice to EventResponse slice
+func EventsToResponse(events []k8sPorts.Event) []k8sWire.EventResponse {
+	response := make([]k8sWire.EventResponse, 0, len(events))
+	for _, event := range events {
+		response = append(response, EventToResponse(event))
+	}
+	return response
+}
+
+// EventToResponse converts Event to EventResponse
+func EventToResponse(event k8sPorts.Event) k8sWire.EventResponse {
+	return k8sWire.EventResponse{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Object: k8sWire.EventObjectResponse{
+			Kind:      event.Object.Kind,
+			Name:      event.Object.Name,
+			Namespace: event.Object.Namespace,
+		},
+		Source:    event.Source.Component,
+		Count:     event.Count,
+		FirstTime: event.FirstTime,
+		LastTime:  event.LastTime,
+	}
+}
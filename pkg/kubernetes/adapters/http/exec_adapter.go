@@ -0,0 +1,178 @@
+package http
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+const (
+	// execPingInterval keeps idle exec connections open through proxies
+	execPingInterval = 30 * time.Second
+	// execWriteTimeout bounds a single write to the exec WebSocket
+	execWriteTimeout = 10 * time.Second
+)
+
+// ExecTerminal bridges an exec WebSocket to the streams of an exec session
+type ExecTerminal struct {
+	conn        *websocket.Conn
+	writeMu     sync.Mutex
+	stdinReader *io.PipeReader
+	stdinWriter *io.PipeWriter
+	resize      chan k8sModels.TerminalSize
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewExecTerminal creates a terminal over an upgraded WebSocket connection
+func NewExecTerminal(conn *websocket.Conn) *ExecTerminal {
+	stdinReader, stdinWriter := io.Pipe()
+	return &ExecTerminal{
+		conn:        conn,
+		stdinReader: stdinReader,
+		stdinWriter: stdinWriter,
+		resize:      make(chan k8sModels.TerminalSize, 1),
+		done:        make(chan struct{}),
+	}
+}
+
+// Streams returns the session streams backed by this terminal
+func (t *ExecTerminal) Streams() k8sModels.ExecStreams {
+	return k8sModels.ExecStreams{
+		Stdin:  t.stdinReader,
+		Stdout: &execOutputWriter{terminal: t, messageType: k8sWire.ExecMessageStdout},
+		Stderr: &execOutputWriter{terminal: t, messageType: k8sWire.ExecMessageStderr},
+		Resize: t.resize,
+	}
+}
+
+// ReadMessages forwards client messages to the session until the socket closes, then calls done
+func (t *ExecTerminal) ReadMessages(done func()) {
+	defer done()
+	defer close(t.resize)
+	defer t.stdinWriter.Close()
+
+	for {
+		var message k8sWire.ExecMessage
+		if err := t.conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		switch message.Type {
+		case k8sWire.ExecMessageStdin:
+			if _, err := t.stdinWriter.Write([]byte(message.Data)); err != nil {
+				return
+			}
+		case k8sWire.ExecMessageResize:
+			if message.Cols == 0 || message.Rows == 0 {
+				continue
+			}
+			// Only the latest size matters; drop one the session has not picked up yet
+			select {
+			case <-t.resize:
+			default:
+			}
+			t.resize <- k8sModels.TerminalSize{Width: message.Cols, Height: message.Rows}
+		}
+	}
+}
+
+// KeepAlive pings the client until the terminal is closed
+func (t *ExecTerminal) KeepAlive() {
+	ticker := time.NewTicker(execPingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.writeMu.Lock()
+			err := t.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(execWriteTimeout))
+			t.writeMu.Unlock()
+			if err != nil {
+				return
+			}
+		case <-t.done:
+			return
+		}
+	}
+}
+
+// Close sends the exit message for the finished session and closes the connection
+func (t *ExecTerminal) Close(session *k8sModels.ExecSession, err error) {
+	t.closeOnce.Do(func() {
+		close(t.done)
+		_ = t.stdinReader.Close()
+
+		exit := k8sWire.ExecMessage{Type: k8sWire.ExecMessageExit, Code: session.ExitCode}
+		if err != nil {
+			exit.Error = err.Error()
+		}
+		_ = t.writeMessage(exit)
+
+		t.writeMu.Lock()
+		_ = t.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(execWriteTimeout))
+		t.writeMu.Unlock()
+		_ = t.conn.Close()
+	})
+}
+
+// writeMessage writes a JSON message; gorilla connections allow only one writer at a time
+func (t *ExecTerminal) writeMessage(message k8sWire.ExecMessage) error {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
+
+	_ = t.conn.SetWriteDeadline(time.Now().Add(execWriteTimeout))
+	return t.conn.WriteJSON(message)
+}
+
+// execOutputWriter sends command output as exec messages of one type
+type execOutputWriter struct {
+	terminal    *ExecTerminal
+	messageType string
+}
+
+// Write sends p as a single output message
+func (w *execOutputWriter) Write(p []byte) (int, error) {
+	if err := w.terminal.writeMessage(k8sWire.ExecMessage{Type: w.messageType, Data: string(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// ExecSessionToResponse converts ExecSession model to ExecSessionResponse
+func ExecSessionToResponse(session *k8sModels.ExecSession) k8sWire.ExecSessionResponse {
+	return k8sWire.ExecSessionResponse{
+		ID:        session.ID,
+		Namespace: session.Namespace,
+		Pod:       session.Pod,
+		Container: session.Container,
+		Command:   session.Command,
+		TTY:       session.TTY,
+		User:      session.User,
+		StartedAt: session.StartedAt,
+		EndedAt:   session.EndedAt,
+		Duration:  session.Duration().Round(time.Second).String(),
+		ExitCode:  session.ExitCode,
+		Error:     session.Error,
+	}
+}
+
+// ExecSessionListToResponse converts exec sessions to ExecSessionListResponse
+func ExecSessionListToResponse(sessions []k8sModels.ExecSession, namespace string) k8sWire.ExecSessionListResponse {
+	responses := make([]k8sWire.ExecSessionResponse, 0, len(sessions))
+	for i := range sessions {
+		responses = append(responses, ExecSessionToResponse(&sessions[i]))
+	}
+
+	return k8sWire.ExecSessionListResponse{
+		Sessions:  responses,
+		Total:     len(responses),
+		Namespace: namespace,
+	}
+}
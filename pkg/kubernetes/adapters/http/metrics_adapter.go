@@ -0,0 +1,80 @@
+package http
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// PodMetricsToResponse converts PodMetrics to PodMetricsResponse
+func PodMetricsToResponse(metrics *k8sPorts.PodMetrics) *k8sWire.PodMetricsResponse {
+	containers := make([]k8sWire.ContainerMetricsResponse, 0, len(metrics.Containers))
+	for _, container := range metrics.Containers {
+		containers = append(containers, k8sWire.ContainerMetricsResponse{
+			Name:      container.Name,
+			Resources: containerResourcesToResponse(container.Resources),
+			Usage:     resourceListToResponse(container.Usage),
+		})
+	}
+
+	return &k8sWire.PodMetricsResponse{
+		PodName:     metrics.PodName,
+		Namespace:   metrics.Namespace,
+		Containers:  containers,
+		LastUpdated: metrics.LastUpdated,
+	}
+}
+
+// NamespaceMetricsToResponse converts NamespaceMetrics to NamespaceMetricsResponse
+func NamespaceMetricsToResponse(metrics *k8sPorts.NamespaceMetrics) *k8sWire.NamespaceMetricsResponse {
+	return &k8sWire.NamespaceMetricsResponse{
+		Namespace:   metrics.Namespace,
+		TotalPods:   metrics.TotalPods,
+		RunningPods: metrics.RunningPods,
+		Usage:       resourceListToResponse(metrics.Resources),
+		LastUpdated: metrics.LastUpdated,
+	}
+}
+
+// ResourceUsageToResponse converts ResourceUsage model to ResourceUsageResponse
+func ResourceUsageToResponse(usage *k8sModels.ResourceUsage) *k8sWire.ResourceUsageResponse {
+	if usage == nil {
+		return nil
+	}
+
+	return &k8sWire.ResourceUsageResponse{
+		Requests:             resourceListToResponse(usage.Requests),
+		Limits:               resourceListToResponse(usage.Limits),
+		Usage:                optionalResourceListToResponse(usage.Usage),
+		CPURequestPercent:    usage.CPURequestPercent,
+		CPULimitPercent:      usage.CPULimitPercent,
+		MemoryRequestPercent: usage.MemoryRequestPercent,
+		MemoryLimitPercent:   usage.MemoryLimitPercent,
+	}
+}
+
+// containerResourcesToResponse converts ContainerResources model to ContainerResourcesResponse
+func containerResourcesToResponse(resources k8sModels.ContainerResources) k8sWire.ContainerResourcesResponse {
+	return k8sWire.ContainerResourcesResponse{
+		Requests: resourceListToResponse(resources.Requests),
+		Limits:   resourceListToResponse(resources.Limits),
+	}
+}
+
+// resourceListToResponse converts ResourceList model to ResourceListResponse
+func resourceListToResponse(list k8sModels.ResourceList) k8sWire.ResourceListResponse {
+	return k8sWire.ResourceListResponse{
+		CPU:    list.CPU,
+		Memory: list.Memory,
+		Pods:   list.Pods,
+	}
+}
+
+// optionalResourceListToResponse converts an optional ResourceList, keeping nil as nil
+func optionalResourceListToResponse(list *k8sModels.ResourceList) *k8sWire.ResourceListResponse {
+	if list == nil {
+		return nil
+	}
+	response := resourceListToResponse(*list)
+	return &response
+}
@@ -8,25 +8,93 @@ import (
 // NamespacesToResponse converts Namespace models to NamespaceResponse slice
 func NamespacesToResponse(namespaces []k8sModels.Namespace) []k8sWire.NamespaceResponse {
 	var response []k8sWire.NamespaceResponse
-	for _, namespace := range namespaces {
-		response = append(response, k8sWire.NamespaceResponse{
-			Name:      namespace.Name,
-			Status:    string(namespace.Status),
-			Labels:    namespace.Labels,
-			Age:       namespace.Age,
-			CreatedAt: namespace.CreatedAt,
-		})
+	for i := range namespaces {
+		response = append(response, *NamespaceToResponse(&namespaces[i]))
 	}
 	return response
 }
 
 // NamespaceToResponse converts Namespace model to NamespaceResponse
 func NamespaceToResponse(namespace *k8sModels.Namespace) *k8sWire.NamespaceResponse {
-	return &k8sWire.NamespaceResponse{
-		Name:      namespace.Name,
-		Status:    string(namespace.Status),
-		Labels:    namespace.Labels,
-		Age:       namespace.Age,
-		CreatedAt: namespace.CreatedAt,
+	response := &k8sWire.NamespaceResponse{
+		Name:           namespace.Name,
+		Status:         string(namespace.Status),
+		Labels:         namespace.Labels,
+		Age:            namespace.Age,
+		CreatedAt:      namespace.CreatedAt,
+		ResourceQuotas: resourceQuotasToResponse(namespace.ResourceQuotas),
+	}
+	for _, limitRange := range namespace.LimitRanges {
+		response.LimitRanges = append(response.LimitRanges, limitRangeToResponse(limitRange))
+	}
+	return response
+}
+
+// QuotaRankingToResponse converts namespace quota usages to QuotaRankingResponse
+func QuotaRankingToResponse(ranking []k8sModels.NamespaceQuotaUsage) k8sWire.QuotaRankingResponse {
+	response := k8sWire.QuotaRankingResponse{
+		Namespaces: make([]k8sWire.NamespaceQuotaUsageResponse, 0, len(ranking)),
+		Total:      len(ranking),
+	}
+	for _, usage := range ranking {
+		switch usage.Status {
+		case k8sModels.ResourceStatusCritical:
+			response.Critical++
+		case k8sModels.ResourceStatusWarning:
+			response.Warning++
+		}
+		response.Namespaces = append(response.Namespaces, k8sWire.NamespaceQuotaUsageResponse{
+			Namespace:          usage.Namespace,
+			Quota:              usage.Quota,
+			Resource:           usage.Resource,
+			UtilizationPercent: usage.UtilizationPercent,
+			Status:             string(usage.Status),
+			Quotas:             resourceQuotasToResponse(usage.Quotas),
+		})
+	}
+	return response
+}
+
+// resourceQuotasToResponse converts ResourceQuota models to ResourceQuotaResponse slice
+func resourceQuotasToResponse(quotas []k8sModels.ResourceQuota) []k8sWire.ResourceQuotaResponse {
+	var response []k8sWire.ResourceQuotaResponse
+	for _, quota := range quotas {
+		resources := make([]k8sWire.QuotaResourceResponse, 0, len(quota.Resources))
+		for _, resource := range quota.Resources {
+			resources = append(resources, k8sWire.QuotaResourceResponse{
+				Name:               resource.Name,
+				Hard:               resource.Hard,
+				Used:               resource.Used,
+				UtilizationPercent: resource.UtilizationPercent,
+				Status:             string(resource.Status),
+			})
+		}
+		response = append(response, k8sWire.ResourceQuotaResponse{
+			Name:      quota.Name,
+			Namespace: quota.Namespace,
+			Scopes:    quota.Scopes,
+			Resources: resources,
+		})
+	}
+	return response
+}
+
+// limitRangeToResponse converts LimitRange model to LimitRangeResponse
+func limitRangeToResponse(limitRange k8sModels.LimitRange) k8sWire.LimitRangeResponse {
+	limits := make([]k8sWire.LimitRangeItemResponse, 0, len(limitRange.Limits))
+	for _, item := range limitRange.Limits {
+		limits = append(limits, k8sWire.LimitRangeItemResponse{
+			Type:                 item.Type,
+			Max:                  item.Max,
+			Min:                  item.Min,
+			Default:              item.Default,
+			DefaultRequest:       item.DefaultRequest,
+			MaxLimitRequestRatio: item.MaxLimitRequestRatio,
+		})
+	}
+	return k8sWire.LimitRangeResponse{
+		Name:      limitRange.Name,
+		Namespace: limitRange.Namespace,
+		Limits:    limits,
 	}
 }
@@ -0,0 +1,159 @@
+package http
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// ServiceListToResponse converts services to ServiceListResponse
+func ServiceListToResponse(services []k8sModels.Service, namespace string) k8sWire.ServiceListResponse {
+	response := k8sWire.ServiceListResponse{
+		Services:  make([]k8sWire.ServiceResponse, 0, len(services)),
+		Total:     len(services),
+		Namespace: namespace,
+	}
+	for i := range services {
+		response.Services = append(response.Services, ServiceToResponse(&services[i]))
+	}
+	return response
+}
+
+// ServiceToResponse converts Service model to ServiceResponse
+func ServiceToResponse(service *k8sModels.Service) k8sWire.ServiceResponse {
+	addresses := make([]k8sWire.EndpointAddressResponse, 0, len(service.Endpoints.Addresses))
+	for _, address := range service.Endpoints.Addresses {
+		addresses = append(addresses, k8sWire.EndpointAddressResponse{
+			IP:       address.IP,
+			PodName:  address.PodName,
+			NodeName: address.NodeName,
+			Ready:    address.Ready,
+		})
+	}
+
+	return k8sWire.ServiceResponse{
+		Name:        service.Name,
+		Namespace:   service.Namespace,
+		Type:        service.Type,
+		ClusterIP:   service.ClusterIP,
+		ExternalIPs: service.ExternalIPs,
+		Ports:       servicePortsToResponse(service.Ports),
+		Selector:    service.Selector,
+		Endpoints: k8sWire.ServiceEndpointsResponse{
+			Ready:     service.Endpoints.Ready,
+			NotReady:  service.Endpoints.NotReady,
+			Addresses: addresses,
+		},
+		Healthy:   service.IsHealthy(),
+		Age:       service.Age,
+		CreatedAt: service.CreatedAt,
+	}
+}
+
+// ServiceReferencesToResponse converts services to the references embedded in a deployment
+func ServiceReferencesToResponse(services []k8sModels.Service) []k8sWire.ServiceReferenceResponse {
+	references := make([]k8sWire.ServiceReferenceResponse, 0, len(services))
+	for _, service := range services {
+		references = append(references, k8sWire.ServiceReferenceResponse{
+			Name:           service.Name,
+			Type:           service.Type,
+			ClusterIP:      service.ClusterIP,
+			Ports:          servicePortsToResponse(service.Ports),
+			ReadyEndpoints: service.Endpoints.Ready,
+		})
+	}
+	return references
+}
+
+// IngressListToResponse converts ingresses to IngressListResponse
+func IngressListToResponse(ingresses []k8sModels.Ingress, namespace string) k8sWire.IngressListResponse {
+	response := k8sWire.IngressListResponse{
+		Ingresses: make([]k8sWire.IngressResponse, 0, len(ingresses)),
+		Total:     len(ingresses),
+		Namespace: namespace,
+	}
+	for i := range ingresses {
+		response.Ingresses = append(response.Ingresses, IngressToResponse(&ingresses[i]))
+	}
+	return response
+}
+
+// IngressToResponse converts Ingress model to IngressResponse
+func IngressToResponse(ingress *k8sModels.Ingress) k8sWire.IngressResponse {
+	rules := make([]k8sWire.IngressRuleResponse, 0, len(ingress.Rules))
+	for _, rule := range ingress.Rules {
+		paths := make([]k8sWire.IngressPathResponse, 0, len(rule.Paths))
+		for _, path := range rule.Paths {
+			paths = append(paths, k8sWire.IngressPathResponse{
+				Path:        path.Path,
+				PathType:    path.PathType,
+				ServiceName: path.ServiceName,
+				ServicePort: path.ServicePort,
+			})
+		}
+		rules = append(rules, k8sWire.IngressRuleResponse{
+			Host:  rule.Host,
+			Paths: paths,
+		})
+	}
+
+	var tls []k8sWire.IngressTLSResponse
+	for _, entry := range ingress.TLS {
+		tls = append(tls, k8sWire.IngressTLSResponse{
+			Hosts:      entry.Hosts,
+			SecretName: entry.SecretName,
+		})
+	}
+
+	return k8sWire.IngressResponse{
+		Name:         ingress.Name,
+		Namespace:    ingress.Namespace,
+		ClassName:    ingress.ClassName,
+		Rules:        rules,
+		TLS:          tls,
+		LoadBalancer: ingress.LoadBalancer,
+		Age:          ingress.Age,
+		CreatedAt:    ingress.CreatedAt,
+	}
+}
+
+// ConfigMapListToResponse converts config maps to ConfigMapListResponse
+func ConfigMapListToResponse(configMaps []k8sModels.ConfigMap, namespace string) k8sWire.ConfigMapListResponse {
+	response := k8sWire.ConfigMapListResponse{
+		ConfigMaps: make([]k8sWire.ConfigMapResponse, 0, len(configMaps)),
+		Total:      len(configMaps),
+		Namespace:  namespace,
+	}
+	for i := range configMaps {
+		response.ConfigMaps = append(response.ConfigMaps, ConfigMapToResponse(&configMaps[i]))
+	}
+	return response
+}
+
+// ConfigMapToResponse converts ConfigMap model to ConfigMapResponse
+func ConfigMapToResponse(configMap *k8sModels.ConfigMap) k8sWire.ConfigMapResponse {
+	return k8sWire.ConfigMapResponse{
+		Name:       configMap.Name,
+		Namespace:  configMap.Namespace,
+		Keys:       configMap.Keys,
+		BinaryKeys: configMap.BinaryKeys,
+		Data:       configMap.Data,
+		Immutable:  configMap.Immutable,
+		Age:        configMap.Age,
+		CreatedAt:  configMap.CreatedAt,
+	}
+}
+
+// servicePortsToResponse converts service ports to responses
+func servicePortsToResponse(ports []k8sModels.ServicePort) []k8sWire.ServicePortResponse {
+	response := make([]k8sWire.ServicePortResponse, 0, len(ports))
+	for _, port := range ports {
+		response = append(response, k8sWire.ServicePortResponse{
+			Name:       port.Name,
+			Protocol:   port.Protocol,
+			Port:       port.Port,
+			TargetPort: port.TargetPort,
+			NodePort:   port.NodePort,
+		})
+	}
+	return response
+}
@@ -28,31 +28,75 @@ func NodeToResponse(node *k8sModels.Node) *k8sWire.NodeResponse {
 	}
 
 	return &k8sWire.NodeResponse{
-		Name:       node.Name,
-		Status:     string(node.Status),
-		Roles:      node.Roles,
-		Age:        node.Age,
-		Version:    node.Version,
-		InternalIP: node.InternalIP,
-		ExternalIP: node.ExternalIP,
-		Conditions: conditions,
-		Resources: k8sWire.NodeResourcesResponse{
-			Capacity: k8sWire.ResourceListResponse{
-				CPU:    node.Resources.Capacity.CPU,
-				Memory: node.Resources.Capacity.Memory,
-				Pods:   node.Resources.Capacity.Pods,
-			},
-			Allocatable: k8sWire.ResourceListResponse{
-				CPU:    node.Resources.Allocatable.CPU,
-				Memory: node.Resources.Allocatable.Memory,
-				Pods:   node.Resources.Allocatable.Pods,
-			},
-			Used: k8sWire.ResourceListResponse{
-				CPU:    node.Resources.Used.CPU,
-				Memory: node.Resources.Used.Memory,
-				Pods:   node.Resources.Used.Pods,
-			},
+		Name:          node.Name,
+		Status:        string(node.Status),
+		Roles:         node.Roles,
+		Age:           node.Age,
+		Version:       node.Version,
+		Unschedulable: node.Unschedulable,
+		InternalIP:    node.InternalIP,
+		ExternalIP:    node.ExternalIP,
+		Conditions:    conditions,
+		Resources:     NodeResourcesToResponse(node.Resources),
+		CreatedAt:     node.CreatedAt,
+	}
+}
+
+// NodeResourcesToResponse converts NodeResources model to NodeResourcesResponse
+func NodeResourcesToResponse(resources k8sModels.NodeResources) k8sWire.NodeResourcesResponse {
+	return k8sWire.NodeResourcesResponse{
+		Capacity:    resourceListToResponse(resources.Capacity),
+		Allocatable: resourceListToResponse(resources.Allocatable),
+		Used:        resourceListToResponse(resources.Used),
+		Limits:      resourceListToResponse(resources.Limits),
+		Usage:       optionalResourceListToResponse(resources.Usage),
+	}
+}
+
+// DrainOperationToResponse converts a DrainOperation model to DrainOperationResponse
+func DrainOperationToResponse(operation *k8sModels.DrainOperation) k8sWire.DrainOperationResponse {
+	pods := make([]k8sWire.DrainPodResponse, 0, len(operation.Pods))
+	for _, pod := range operation.Pods {
+		pods = append(pods, k8sWire.DrainPodResponse{
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+			Status:    string(pod.Status),
+			Reason:    pod.Reason,
+			Attempts:  pod.Attempts,
+		})
+	}
+
+	progress := operation.Progress()
+	return k8sWire.DrainOperationResponse{
+		ID:                 operation.ID,
+		Node:               operation.Node,
+		User:               operation.User,
+		Status:             string(operation.Status),
+		Message:            operation.Message,
+		GracePeriodSeconds: operation.Options.GracePeriodSeconds,
+		TimeoutSeconds:     int64(operation.Options.Timeout().Seconds()),
+		Progress: k8sWire.DrainProgressResponse{
+			Total:    progress.Total,
+			Evicted:  progress.Evicted,
+			Skipped:  progress.Skipped,
+			Failed:   progress.Failed,
+			Inflight: progress.Inflight,
 		},
-		CreatedAt: node.CreatedAt,
+		Pods:        pods,
+		StartedAt:   operation.StartedAt,
+		CompletedAt: operation.CompletedAt,
+	}
+}
+
+// DrainOperationListToResponse converts drain operations of a node to DrainOperationListResponse
+func DrainOperationListToResponse(operations []k8sModels.DrainOperation, nodeName string) k8sWire.DrainOperationListResponse {
+	drains := make([]k8sWire.DrainOperationResponse, 0, len(operations))
+	for i := range operations {
+		drains = append(drains, DrainOperationToResponse(&operations[i]))
+	}
+	return k8sWire.DrainOperationListResponse{
+		Drains: drains,
+		Total:  len(drains),
+		Node:   nodeName,
 	}
 }
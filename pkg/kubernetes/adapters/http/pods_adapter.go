@@ -30,6 +30,8 @@ func PodToResponse(pod *k8sModels.Pod) *k8sWire.PodResponse {
 			Ready:        container.Ready,
 			RestartCount: container.RestartCount,
 			State:        containerStateToResponse(container.State),
+			Resources:    containerResourcesToResponse(container.Resources),
+			Usage:        optionalResourceListToResponse(container.Usage),
 		})
 	}
 
@@ -57,6 +59,7 @@ func PodToResponse(pod *k8sModels.Pod) *k8sWire.PodResponse {
 		Containers: containers,
 		Conditions: conditions,
 		CreatedAt:  pod.CreatedAt,
+		Resources:  ResourceUsageToResponse(pod.Resources),
 	}
 }
 
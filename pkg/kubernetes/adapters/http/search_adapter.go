@@ -0,0 +1,60 @@
+package http
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// WorkloadSearchResultToResponse converts WorkloadSearchResult model to WorkloadSearchResponse
+func WorkloadSearchResultToResponse(result *k8sModels.WorkloadSearchResult) k8sWire.WorkloadSearchResponse {
+	clusters := make([]k8sWire.ClusterSearchResultResponse, 0, len(result.Clusters))
+	for _, cluster := range result.Clusters {
+		namespaces := make([]k8sWire.NamespaceSearchResultResponse, 0, len(cluster.Namespaces))
+		for _, namespace := range cluster.Namespaces {
+			namespaces = append(namespaces, k8sWire.NamespaceSearchResultResponse{
+				Namespace:   namespace.Namespace,
+				Deployments: workloadMatchesToResponse(namespace.Deployments),
+				Pods:        workloadMatchesToResponse(namespace.Pods),
+			})
+		}
+		clusters = append(clusters, k8sWire.ClusterSearchResultResponse{
+			Name:       cluster.Name,
+			Context:    cluster.Context,
+			Truncated:  cluster.Truncated,
+			Namespaces: namespaces,
+		})
+	}
+
+	failed := make([]k8sWire.ClusterSearchFailureResponse, 0, len(result.Failed))
+	for _, failure := range result.Failed {
+		failed = append(failed, k8sWire.ClusterSearchFailureResponse{
+			Name:    failure.Name,
+			Context: failure.Context,
+			Error:   failure.Error,
+		})
+	}
+
+	return k8sWire.WorkloadSearchResponse{
+		Query:    result.Query,
+		Total:    result.Total(),
+		Clusters: clusters,
+		Failed:   failed,
+	}
+}
+
+// workloadMatchesToResponse converts WorkloadMatch models to WorkloadMatchResponse slice
+func workloadMatchesToResponse(matches []k8sModels.WorkloadMatch) []k8sWire.WorkloadMatchResponse {
+	response := make([]k8sWire.WorkloadMatchResponse, 0, len(matches))
+	for _, match := range matches {
+		response = append(response, k8sWire.WorkloadMatchResponse{
+			Kind:      match.Kind,
+			Name:      match.Name,
+			Namespace: match.Namespace,
+			MatchedOn: string(match.MatchedOn),
+			Images:    match.Images,
+			Status:    match.Status,
+			Node:      match.Node,
+		})
+	}
+	return response
+}
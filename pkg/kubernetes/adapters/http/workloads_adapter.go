@@ -0,0 +1,137 @@
+package http
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
+)
+
+// StatefulSetListToResponse converts stateful sets to StatefulSetListResponse
+func StatefulSetListToResponse(statefulSets []k8sModels.StatefulSet, namespace string) k8sWire.StatefulSetListResponse {
+	response := k8sWire.StatefulSetListResponse{
+		StatefulSets: make([]k8sWire.StatefulSetResponse, 0, len(statefulSets)),
+		Total:        len(statefulSets),
+		Namespace:    namespace,
+	}
+	for i := range statefulSets {
+		response.StatefulSets = append(response.StatefulSets, StatefulSetToResponse(&statefulSets[i]))
+	}
+	return response
+}
+
+// StatefulSetToResponse converts StatefulSet model to StatefulSetResponse
+func StatefulSetToResponse(statefulSet *k8sModels.StatefulSet) k8sWire.StatefulSetResponse {
+	return k8sWire.StatefulSetResponse{
+		Name:           statefulSet.Name,
+		Namespace:      statefulSet.Namespace,
+		Replicas:       DeploymentReplicasToResponse(statefulSet.Replicas),
+		ServiceName:    statefulSet.ServiceName,
+		UpdateStrategy: statefulSet.UpdateStrategy,
+		Images:         statefulSet.Images,
+		Age:            statefulSet.Age,
+		CreatedAt:      statefulSet.CreatedAt,
+		HealthStatus:   statefulSet.HealthStatus,
+	}
+}
+
+// DaemonSetListToResponse converts daemon sets to DaemonSetListResponse
+func DaemonSetListToResponse(daemonSets []k8sModels.DaemonSet, namespace string) k8sWire.DaemonSetListResponse {
+	response := k8sWire.DaemonSetListResponse{
+		DaemonSets: make([]k8sWire.DaemonSetResponse, 0, len(daemonSets)),
+		Total:      len(daemonSets),
+		Namespace:  namespace,
+	}
+	for i := range daemonSets {
+		response.DaemonSets = append(response.DaemonSets, DaemonSetToResponse(&daemonSets[i]))
+	}
+	return response
+}
+
+// DaemonSetToResponse converts DaemonSet model to DaemonSetResponse
+func DaemonSetToResponse(daemonSet *k8sModels.DaemonSet) k8sWire.DaemonSetResponse {
+	return k8sWire.DaemonSetResponse{
+		Name:      daemonSet.Name,
+		Namespace: daemonSet.Namespace,
+		Scheduling: k8sWire.DaemonSetSchedulingResponse{
+			Desired:      daemonSet.Scheduling.Desired,
+			Current:      daemonSet.Scheduling.Current,
+			Ready:        daemonSet.Scheduling.Ready,
+			Available:    daemonSet.Scheduling.Available,
+			Updated:      daemonSet.Scheduling.Updated,
+			Misscheduled: daemonSet.Scheduling.Misscheduled,
+		},
+		NodeSelector:   daemonSet.NodeSelector,
+		UpdateStrategy: daemonSet.UpdateStrategy,
+		Images:         daemonSet.Images,
+		Age:            daemonSet.Age,
+		CreatedAt:      daemonSet.CreatedAt,
+		HealthStatus:   daemonSet.HealthStatus,
+	}
+}
+
+// JobListToResponse converts jobs to JobListResponse
+func JobListToResponse(jobs []k8sModels.Job, namespace string) k8sWire.JobListResponse {
+	response := k8sWire.JobListResponse{
+		Jobs:      make([]k8sWire.JobResponse, 0, len(jobs)),
+		Total:     len(jobs),
+		Namespace: namespace,
+	}
+	for i := range jobs {
+		response.Jobs = append(response.Jobs, JobToResponse(&jobs[i]))
+	}
+	return response
+}
+
+// JobToResponse converts Job model to JobResponse
+func JobToResponse(job *k8sModels.Job) k8sWire.JobResponse {
+	return k8sWire.JobResponse{
+		Name:           job.Name,
+		Namespace:      job.Namespace,
+		Status:         string(job.Status),
+		Completions:    job.Completions,
+		Parallelism:    job.Parallelism,
+		Active:         job.Active,
+		Succeeded:      job.Succeeded,
+		Failed:         job.Failed,
+		StartTime:      job.StartTime,
+		CompletionTime: job.CompletionTime,
+		Duration:       job.Duration,
+		CronJob:        job.CronJob,
+		Images:         job.Images,
+		Age:            job.Age,
+		CreatedAt:      job.CreatedAt,
+		HealthStatus:   job.HealthStatus,
+	}
+}
+
+// CronJobListToResponse converts cron jobs to CronJobListResponse
+func CronJobListToResponse(cronJobs []k8sModels.CronJob, namespace string) k8sWire.CronJobListResponse {
+	response := k8sWire.CronJobListResponse{
+		CronJobs:  make([]k8sWire.CronJobResponse, 0, len(cronJobs)),
+		Total:     len(cronJobs),
+		Namespace: namespace,
+	}
+	for i := range cronJobs {
+		response.CronJobs = append(response.CronJobs, CronJobToResponse(&cronJobs[i]))
+	}
+	return response
+}
+
+// CronJobToResponse converts CronJob model to CronJobResponse
+func CronJobToResponse(cronJob *k8sModels.CronJob) k8sWire.CronJobResponse {
+	return k8sWire.CronJobResponse{
+		Name:               cronJob.Name,
+		Namespace:          cronJob.Namespace,
+		Schedule:           cronJob.Schedule,
+		TimeZone:           cronJob.TimeZone,
+		Suspended:          cronJob.Suspended,
+		ConcurrencyPolicy:  cronJob.ConcurrencyPolicy,
+		ActiveJobs:         cronJob.ActiveJobs,
+		LastScheduleTime:   cronJob.LastScheduleTime,
+		LastSuccessfulTime: cronJob.LastSuccessfulTime,
+		LastJobStatus:      string(cronJob.LastJobStatus),
+		Images:             cronJob.Images,
+		Age:                cronJob.Age,
+		CreatedAt:          cronJob.CreatedAt,
+		HealthStatus:       cronJob.HealthStatus,
+	}
+}
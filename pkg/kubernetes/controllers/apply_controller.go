@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+var (
+	// ErrApplyPlanNotFound is returned when a confirmation token is unknown, expired or already used
+	ErrApplyPlanNotFound = errors.New("apply plan not found")
+	// ErrApplyPlanStale is returned when the target object changed after the preview
+	ErrApplyPlanStale = errors.New("apply plan is stale")
+)
+
+// ApplyController previews changes with server-side dry runs and applies them once confirmed
+type ApplyController struct {
+	repository *repositories.ApplyRepository
+	plansRepo  *repositories.ApplyPlansRepository
+	planner    *k8sLogic.ManifestPlanner
+}
+
+// NewApplyController creates a new apply controller
+func NewApplyController(repository *repositories.ApplyRepository, plansRepo *repositories.ApplyPlansRepository, planner *k8sLogic.ManifestPlanner) *ApplyController {
+	return &ApplyController{
+		repository: repository,
+		plansRepo:  plansRepo,
+		planner:    planner,
+	}
+}
+
+// PreviewManifest dry-runs a manifest against a namespace and returns its plan
+func (c *ApplyController) PreviewManifest(ctx context.Context, context, namespace, userName, manifest string) (*k8sModels.ApplyPlan, error) {
+	if context == "" || namespace == "" {
+		return nil, fmt.Errorf("context and namespace are required")
+	}
+
+	object, err := c.planner.ParseManifest(manifest, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	target := unstructured.Unstructured{Object: object}
+	namespaced, err := c.repository.IsNamespaced(context, target.GetAPIVersion(), target.GetKind())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", k8sLogic.ErrInvalidManifest, err.Error())
+	}
+	if !namespaced {
+		return nil, fmt.Errorf("%w: %s is cluster scoped", k8sLogic.ErrInvalidManifest, target.GetKind())
+	}
+
+	return c.preview(ctx, context, namespace, userName, object, true)
+}
+
+// PreviewDeploymentChange dry-runs image, env or resources changes to an existing deployment
+func (c *ApplyController) PreviewDeploymentChange(ctx context.Context, context, namespace, userName string, change k8sModels.DeploymentChange) (*k8sModels.ApplyPlan, error) {
+	if context == "" || namespace == "" {
+		return nil, fmt.Errorf("context and namespace are required")
+	}
+
+	object, err := c.planner.BuildDeploymentApply(namespace, change)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.preview(ctx, context, namespace, userName, object, false)
+}
+
+// Apply applies the plan of a confirmation token issued to the same user.
+// The plan is refused when the target object changed since the preview, so the user confirms what is applied.
+func (c *ApplyController) Apply(ctx context.Context, context, namespace, userName, token string) (*k8sModels.ApplyPlan, error) {
+	if token == "" {
+		return nil, fmt.Errorf("%w: token is required", ErrApplyPlanNotFound)
+	}
+
+	plan, found := c.plansRepo.TakePlan(context, token)
+	if !found || plan.Namespace != namespace || plan.User != userName || plan.IsExpired(time.Now()) {
+		return nil, fmt.Errorf("%w: preview the change again", ErrApplyPlanNotFound)
+	}
+
+	live, err := c.repository.GetLive(ctx, context, plan.Object)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get %s: %w", plan.ResourcePath(), err)
+	}
+	if liveResourceVersion(live) != plan.LiveResourceVersion {
+		return nil, fmt.Errorf("%w: %s changed since the preview", ErrApplyPlanStale, plan.ResourcePath())
+	}
+
+	if _, err := c.repository.Apply(ctx, context, plan.Object, false); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// preview runs the dry run, diffs it against the live object and stores a plan when something changes
+func (c *ApplyController) preview(ctx context.Context, context, namespace, userName string, object map[string]interface{}, allowCreate bool) (*k8sModels.ApplyPlan, error) {
+	target := unstructured.Unstructured{Object: object}
+
+	live, err := c.repository.GetLive(ctx, context, object)
+	if err != nil {
+		if !apierrors.IsNotFound(err) || !allowCreate {
+			return nil, fmt.Errorf("failed to get %s %s/%s: %w", target.GetKind(), namespace, target.GetName(), err)
+		}
+		live = nil
+	}
+
+	result, err := c.repository.Apply(ctx, context, object, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plan := &k8sModels.ApplyPlan{
+		Context:             context,
+		Namespace:           namespace,
+		APIVersion:          target.GetAPIVersion(),
+		Kind:                target.GetKind(),
+		Name:                target.GetName(),
+		User:                userName,
+		Object:              object,
+		Changes:             c.planner.Diff(live, result),
+		CreatedAt:           now,
+		ExpiresAt:           now.Add(k8sModels.ApplyPlanTTL),
+		LiveResourceVersion: liveResourceVersion(live),
+	}
+
+	// Nothing to confirm when the apply would not change the object
+	if plan.Action() == k8sModels.ApplyActionNone {
+		return plan, nil
+	}
+
+	token, err := newApplyToken()
+	if err != nil {
+		return nil, err
+	}
+	plan.Token = token
+	c.plansRepo.SavePlan(plan)
+	return plan, nil
+}
+
+// liveResourceVersion returns the resource version of a live object, or empty when it does not exist
+func liveResourceVersion(live map[string]interface{}) string {
+	if live == nil {
+		return ""
+	}
+	return (&unstructured.Unstructured{Object: live}).GetResourceVersion()
+}
+
+// newApplyToken generates an unguessable confirmation token
+func newApplyToken() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
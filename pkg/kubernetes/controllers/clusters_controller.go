@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// ClustersController handles clusters business logic orchestration
+type ClustersController struct {
+	repository         *repositories.ClustersRepository
+	deploymentsRepo    *repositories.DeploymentsRepository
+	podsRepo           *repositories.PodsRepository
+	healthCalculator   *k8sLogic.HealthCalculator
+	resourceCalculator *k8sLogic.ResourceCalculator
+}
+
+// NewClustersController creates a new clusters controller
+func NewClustersController(
+	repository *repositories.ClustersRepository,
+	deploymentsRepo *repositories.DeploymentsRepository,
+	podsRepo *repositories.PodsRepository,
+	healthCalculator *k8sLogic.HealthCalculator,
+) *ClustersController {
+	return &ClustersController{
+		repository:         repository,
+		deploymentsRepo:    deploymentsRepo,
+		podsRepo:           podsRepo,
+		healthCalculator:   healthCalculator,
+		resourceCalculator: k8sLogic.NewResourceCalculator(),
+	}
+}
+
+// ListClusters lists all configured clusters with their connectivity status
+func (c *ClustersController) ListClusters(ctx context.Context) ([]k8sModels.Cluster, error) {
+	clusters, err := c.repository.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	return clusters, nil
+}
+
+// GetCluster gets a specific cluster with business logic validation
+func (c *ClustersController) GetCluster(ctx context.Context, context string) (*k8sModels.Cluster, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	cluster, err := c.repository.GetCluster(ctx, context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	return cluster, nil
+}
+
+// GetClusterInfo gets comprehensive cluster information with workload counts and resource totals
+func (c *ClustersController) GetClusterInfo(ctx context.Context, context string) (*k8sModels.ClusterInfo, error) {
+	clusterInfo, _, _, err := c.collectClusterState(ctx, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterInfo, nil
+}
+
+// GetClusterHealth gets detailed cluster health with per-node and per-namespace health
+func (c *ClustersController) GetClusterHealth(ctx context.Context, context string) (*k8sPorts.ClusterHealth, error) {
+	clusterInfo, deployments, pods, err := c.collectClusterState(ctx, context)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.healthCalculator.BuildClusterHealth(clusterInfo, deployments, pods), nil
+}
+
+// collectClusterState gathers cluster info, deployments and pods and fills the cluster summary
+func (c *ClustersController) collectClusterState(ctx context.Context, context string) (*k8sModels.ClusterInfo, []k8sModels.Deployment, []k8sModels.Pod, error) {
+	if context == "" {
+		return nil, nil, nil, fmt.Errorf("context is required")
+	}
+
+	clusterInfo, err := c.repository.GetClusterInfo(ctx, context)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get cluster info: %w", err)
+	}
+	if !clusterInfo.Cluster.IsConnected() {
+		return clusterInfo, nil, nil, nil
+	}
+
+	deploymentList, err := c.deploymentsRepo.ListDeployments(ctx, context, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+
+	podList, err := c.podsRepo.ListPods(ctx, context, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	clusterInfo.CalculateSummary()
+	clusterInfo.Summary.TotalDeployments = deploymentList.Total
+	clusterInfo.Summary.TotalPods = podList.Total
+	for i := range podList.Pods {
+		if podList.Pods[i].IsRunning() {
+			clusterInfo.Summary.RunningPods++
+		}
+	}
+	clusterInfo.Summary.Resources = c.resourceCalculator.SumNodeResources(clusterInfo.Nodes)
+
+	return clusterInfo, deploymentList.Deployments, podList.Pods, nil
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// ConfigMapsController handles config maps business logic orchestration
+type ConfigMapsController struct {
+	repository *repositories.ConfigMapsRepository
+}
+
+// NewConfigMapsController creates a new config maps controller
+func NewConfigMapsController(repository *repositories.ConfigMapsRepository) *ConfigMapsController {
+	return &ConfigMapsController{
+		repository: repository,
+	}
+}
+
+// GetConfigMap gets a specific config map with its keys only
+func (c *ConfigMapsController) GetConfigMap(ctx context.Context, context, namespace, name string) (*k8sModels.ConfigMap, error) {
+	return c.getConfigMap(ctx, context, namespace, name, false)
+}
+
+// GetConfigMapValues gets a specific config map including its values.
+// Callers must check the config map values permission first.
+func (c *ConfigMapsController) GetConfigMapValues(ctx context.Context, context, namespace, name string) (*k8sModels.ConfigMap, error) {
+	return c.getConfigMap(ctx, context, namespace, name, true)
+}
+
+// ListConfigMaps lists config maps with their keys only
+func (c *ConfigMapsController) ListConfigMaps(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.ConfigMap, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	configMaps, err := c.repository.ListConfigMaps(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config maps: %w", err)
+	}
+	return configMaps, nil
+}
+
+// getConfigMap gets a config map, optionally with values
+func (c *ConfigMapsController) getConfigMap(ctx context.Context, context, namespace, name string, withValues bool) (*k8sModels.ConfigMap, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	configMap, err := c.repository.GetConfigMap(ctx, context, namespace, name, withValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config map: %w", err)
+	}
+	return configMap, nil
+}
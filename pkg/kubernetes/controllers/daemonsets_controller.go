@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// DaemonSetsController handles daemon sets business logic orchestration
+type DaemonSetsController struct {
+	repository       *repositories.DaemonSetsRepository
+	healthCalculator *k8sLogic.HealthCalculator
+}
+
+// NewDaemonSetsController creates a new daemon sets controller
+func NewDaemonSetsController(repository *repositories.DaemonSetsRepository, healthCalculator *k8sLogic.HealthCalculator) *DaemonSetsController {
+	return &DaemonSetsController{
+		repository:       repository,
+		healthCalculator: healthCalculator,
+	}
+}
+
+// GetDaemonSet gets a specific daemon set with its health
+func (c *DaemonSetsController) GetDaemonSet(ctx context.Context, context, namespace, name string) (*k8sModels.DaemonSet, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	daemonSet, err := c.repository.GetDaemonSet(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemon set: %w", err)
+	}
+
+	daemonSet.HealthStatus = string(c.healthCalculator.CalculateDaemonSetHealth(daemonSet))
+	return daemonSet, nil
+}
+
+// ListDaemonSets lists daemon sets with their health
+func (c *DaemonSetsController) ListDaemonSets(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.DaemonSet, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	daemonSets, err := c.repository.ListDaemonSets(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemon sets: %w", err)
+	}
+
+	for i := range daemonSets {
+		daemonSets[i].HealthStatus = string(c.healthCalculator.CalculateDaemonSetHealth(&daemonSets[i]))
+	}
+	return daemonSets, nil
+}
+
+// RestartDaemonSet restarts a daemon set
+func (c *DaemonSetsController) RestartDaemonSet(ctx context.Context, context, namespace, name string) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+
+	if err := c.repository.RestartDaemonSet(ctx, context, namespace, name); err != nil {
+		return fmt.Errorf("failed to restart daemon set: %w", err)
+	}
+
+	return nil
+}
@@ -2,23 +2,40 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 )
 
+// maxReplicas is a reasonable upper limit for manual scaling
+const maxReplicas = 1000
+
+// ErrAutoscalerNotFound is returned when a deployment is not managed by an autoscaler
+var ErrAutoscalerNotFound = errors.New("autoscaler not found")
+
+// deploymentKind is the scale target kind autoscalers use for deployments
+const deploymentKind = "Deployment"
+
 // DeploymentsController handles deployments business logic orchestration
 type DeploymentsController struct {
 	repository             *repositories.DeploymentsRepository
+	autoscalersRepo        *repositories.AutoscalersRepository
 	serviceContextResolver k8sPorts.ServiceContextResolver
+	rolloutAnalyzer        *k8sLogic.RolloutAnalyzer
+	scalingPolicy          *k8sLogic.ScalingPolicy
 }
 
 // NewDeploymentsController creates a new deployments controller
-func NewDeploymentsController(repository *repositories.DeploymentsRepository) *DeploymentsController {
+func NewDeploymentsController(repository *repositories.DeploymentsRepository, autoscalersRepo *repositories.AutoscalersRepository) *DeploymentsController {
 	return &DeploymentsController{
-		repository: repository,
+		repository:      repository,
+		autoscalersRepo: autoscalersRepo,
+		rolloutAnalyzer: k8sLogic.NewRolloutAnalyzer(),
+		scalingPolicy:   k8sLogic.NewScalingPolicy(),
 	}
 }
 
@@ -52,6 +69,11 @@ func (c *DeploymentsController) GetDeployment(ctx context.Context, context, name
 		}
 	}
 
+	// The autoscaler is best effort and must not hide the deployment itself
+	if autoscaler, err := c.autoscalersRepo.FindForWorkload(ctx, context, namespace, deploymentKind, deploymentName); err == nil {
+		deployment.Autoscaler = autoscaler
+	}
+
 	return deployment, nil
 }
 
@@ -80,39 +102,88 @@ func (c *DeploymentsController) ListDeployments(ctx context.Context, context str
 	return deploymentList, nil
 }
 
-// ScaleDeployment scales a deployment with business logic validation
-func (c *DeploymentsController) ScaleDeployment(ctx context.Context, context, namespace, deploymentName string, replicas int32) error {
+// ScaleDeployment scales a deployment with business logic validation.
+// Deployments managed by an autoscaler are only scaled when forced; the returned warning explains the override.
+func (c *DeploymentsController) ScaleDeployment(ctx context.Context, context, namespace, deploymentName string, replicas int32, force bool) (string, error) {
 	if context == "" {
-		return fmt.Errorf("context is required")
+		return "", fmt.Errorf("context is required")
 	}
 	if namespace == "" {
-		return fmt.Errorf("namespace is required")
+		return "", fmt.Errorf("namespace is required")
 	}
 	if deploymentName == "" {
-		return fmt.Errorf("deployment name is required")
+		return "", fmt.Errorf("deployment name is required")
 	}
 	if replicas < 0 {
-		return fmt.Errorf("replicas must be non-negative")
+		return "", fmt.Errorf("replicas must be non-negative")
 	}
 
 	// Business logic: validate scaling limits
-	const maxReplicas = 1000 // Reasonable upper limit
 	if replicas > maxReplicas {
-		return fmt.Errorf("replicas cannot exceed %d", maxReplicas)
+		return "", fmt.Errorf("replicas cannot exceed %d", maxReplicas)
 	}
 
 	// Verify deployment exists before scaling
 	_, err := c.repository.GetDeployment(ctx, context, namespace, deploymentName)
 	if err != nil {
-		return fmt.Errorf("deployment not found: %w", err)
+		return "", fmt.Errorf("deployment not found: %w", err)
+	}
+
+	autoscaler, err := c.autoscalersRepo.FindForWorkload(ctx, context, namespace, deploymentKind, deploymentName)
+	if err != nil {
+		return "", fmt.Errorf("failed to check autoscaler: %w", err)
+	}
+	warning, err := c.scalingPolicy.CheckManualScale(autoscaler, replicas, force)
+	if err != nil {
+		return "", err
 	}
 
 	err = c.repository.ScaleDeployment(ctx, context, namespace, deploymentName, replicas)
 	if err != nil {
-		return fmt.Errorf("failed to scale deployment: %w", err)
+		return "", fmt.Errorf("failed to scale deployment: %w", err)
 	}
 
-	return nil
+	return warning, nil
+}
+
+// GetAutoscaler gets the autoscaler managing a deployment
+func (c *DeploymentsController) GetAutoscaler(ctx context.Context, context, namespace, deploymentName string) (*k8sModels.HorizontalPodAutoscaler, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if deploymentName == "" {
+		return nil, fmt.Errorf("deployment name is required")
+	}
+
+	autoscaler, err := c.autoscalersRepo.FindForWorkload(ctx, context, namespace, deploymentKind, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get autoscaler: %w", err)
+	}
+	if autoscaler == nil {
+		return nil, fmt.Errorf("%w: deployment %s/%s has no autoscaler", ErrAutoscalerNotFound, namespace, deploymentName)
+	}
+	return autoscaler, nil
+}
+
+// SetAutoscalerBounds adjusts the min and max replicas of the autoscaler managing a deployment
+func (c *DeploymentsController) SetAutoscalerBounds(ctx context.Context, context, namespace, deploymentName string, floor, ceiling int32) (*k8sModels.HorizontalPodAutoscaler, error) {
+	if err := c.scalingPolicy.ValidateBounds(floor, ceiling, maxReplicas); err != nil {
+		return nil, err
+	}
+
+	autoscaler, err := c.GetAutoscaler(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := c.autoscalersRepo.SetReplicaBounds(ctx, context, namespace, autoscaler.Name, floor, ceiling)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update autoscaler: %w", err)
+	}
+	return updated, nil
 }
 
 // RestartDeployment restarts a deployment with business logic validation
@@ -141,6 +212,69 @@ func (c *DeploymentsController) RestartDeployment(ctx context.Context, context,
 	return nil
 }
 
+// GetRolloutHistory gets the revisions of a deployment, newest first
+func (c *DeploymentsController) GetRolloutHistory(ctx context.Context, context, namespace, deploymentName string) ([]k8sModels.DeploymentRevision, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if deploymentName == "" {
+		return nil, fmt.Errorf("deployment name is required")
+	}
+
+	revisions, err := c.repository.GetRolloutHistory(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rollout history: %w", err)
+	}
+
+	c.rolloutAnalyzer.SortRevisions(revisions)
+	return revisions, nil
+}
+
+// GetRolloutStatus gets whether the latest rollout of a deployment is progressing, complete or stalled
+func (c *DeploymentsController) GetRolloutStatus(ctx context.Context, context, namespace, deploymentName string) (*k8sModels.RolloutStatus, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if deploymentName == "" {
+		return nil, fmt.Errorf("deployment name is required")
+	}
+
+	deployment, err := c.repository.GetDeployment(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("deployment not found: %w", err)
+	}
+
+	status := c.rolloutAnalyzer.CalculateStatus(deployment)
+	return &status, nil
+}
+
+// RollbackDeployment rolls a deployment back to a revision (0 means the previous one)
+// and returns the revision that was restored
+func (c *DeploymentsController) RollbackDeployment(ctx context.Context, context, namespace, deploymentName string, revision int64) (*k8sModels.DeploymentRevision, error) {
+	revisions, err := c.GetRolloutHistory(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.rolloutAnalyzer.ResolveRollbackTarget(revisions, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repository.RollbackDeployment(ctx, context, namespace, deploymentName, target.ReplicaSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back deployment: %w", err)
+	}
+
+	return target, nil
+}
+
 // GetDeploymentsSummary provides a summary of deployments in a namespace or cluster
 func (c *DeploymentsController) GetDeploymentsSummary(ctx context.Context, context string, namespace string) (*DeploymentsSummary, error) {
 	if context == "" {
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// recentWarningsLimit limits the warning events embedded in resource details
+const recentWarningsLimit = 10
+
+// EventsController handles events business logic orchestration
+type EventsController struct {
+	repository     *repositories.EventsRepository
+	eventProcessor *k8sLogic.EventProcessor
+}
+
+// NewEventsController creates a new events controller
+func NewEventsController(repository *repositories.EventsRepository) *EventsController {
+	return &EventsController{
+		repository:     repository,
+		eventProcessor: k8sLogic.NewEventProcessor(),
+	}
+}
+
+// GetNamespaceEvents gets events in a namespace, most recent first, optionally filtered by type
+func (c *EventsController) GetNamespaceEvents(ctx context.Context, context, namespace, eventType string) ([]k8sPorts.Event, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	events, err := c.repository.GetNamespaceEvents(ctx, context, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace events: %w", err)
+	}
+
+	events = c.eventProcessor.FilterByType(events, eventType)
+	c.eventProcessor.SortByLastSeen(events)
+	return events, nil
+}
+
+// GetResourceEvents gets events for a specific resource, most recent first
+func (c *EventsController) GetResourceEvents(ctx context.Context, context, namespace, resourceType, resourceName, eventType string) ([]k8sPorts.Event, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if resourceName == "" {
+		return nil, fmt.Errorf("resource name is required")
+	}
+
+	kind, err := c.eventProcessor.ResolveKind(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.repository.GetEvents(ctx, context, namespace, kind, resourceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource events: %w", err)
+	}
+
+	events = c.eventProcessor.FilterByType(events, eventType)
+	c.eventProcessor.SortByLastSeen(events)
+	return events, nil
+}
+
+// GetDeploymentWarnings gets recent warning events for a deployment, its replica sets and pods
+func (c *EventsController) GetDeploymentWarnings(ctx context.Context, context, namespace, deploymentName string) ([]k8sPorts.Event, error) {
+	objects, err := c.repository.GetDeploymentObjects(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment warnings: %w", err)
+	}
+	events, err := c.repository.GetWarningEvents(ctx, context, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment warnings: %w", err)
+	}
+
+	events = c.eventProcessor.FilterDeploymentEvents(events, objects)
+	return c.eventProcessor.RecentWarnings(events, recentWarningsLimit), nil
+}
+
+// GetPodWarnings gets recent warning events for a pod
+func (c *EventsController) GetPodWarnings(ctx context.Context, context, namespace, podName string) ([]k8sPorts.Event, error) {
+	events, err := c.repository.GetEvents(ctx, context, namespace, "Pod", podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod warnings: %w", err)
+	}
+
+	return c.eventProcessor.RecentWarnings(events, recentWarningsLimit), nil
+}
+
+// WatchEvents watches new events in a namespace until ctx is cancelled
+func (c *EventsController) WatchEvents(ctx context.Context, context, namespace string) (<-chan k8sPorts.Event, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	events, err := c.repository.WatchEvents(ctx, context, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// ExecController handles interactive pod exec sessions
+type ExecController struct {
+	podsRepo     *repositories.PodsRepository
+	sessionsRepo *repositories.ExecSessionsRepository
+}
+
+// NewExecController creates a new exec controller
+func NewExecController(podsRepo *repositories.PodsRepository, sessionsRepo *repositories.ExecSessionsRepository) *ExecController {
+	return &ExecController{
+		podsRepo:     podsRepo,
+		sessionsRepo: sessionsRepo,
+	}
+}
+
+// Exec runs an exec session until the command exits or ctx is cancelled, then records it
+func (c *ExecController) Exec(ctx context.Context, session *k8sModels.ExecSession, streams k8sModels.ExecStreams) error {
+	if session.Context == "" {
+		return fmt.Errorf("context is required")
+	}
+	if len(session.Command) == 0 {
+		session.Command = k8sModels.DefaultExecCommand
+	}
+
+	id, err := newOperationID()
+	if err != nil {
+		return err
+	}
+	session.ID = id
+	session.StartedAt = time.Now()
+
+	err = c.podsRepo.ExecPod(ctx, session, streams)
+	session.Finish(err)
+	c.sessionsRepo.RecordSession(session)
+
+	if err != nil {
+		return fmt.Errorf("failed to exec into pod: %w", err)
+	}
+	return nil
+}
+
+// ListSessions lists recorded exec sessions of a namespace, newest first
+func (c *ExecController) ListSessions(context, namespace string) ([]k8sModels.ExecSession, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	return c.sessionsRepo.ListSessions(context, namespace), nil
+}
+
+// newOperationID generates a random identifier for an exec session or a tracked operation
+func newOperationID() (string, error) {
+	bytes := make([]byte, 8)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("failed to generate id: %w", err)
+	}
+	return hex.EncodeToString(bytes), nil
+}
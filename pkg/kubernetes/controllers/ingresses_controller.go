@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// IngressesController handles ingresses business logic orchestration
+type IngressesController struct {
+	repository *repositories.IngressesRepository
+}
+
+// NewIngressesController creates a new ingresses controller
+func NewIngressesController(repository *repositories.IngressesRepository) *IngressesController {
+	return &IngressesController{
+		repository: repository,
+	}
+}
+
+// GetIngress gets a specific ingress
+func (c *IngressesController) GetIngress(ctx context.Context, context, namespace, name string) (*k8sModels.Ingress, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	ingress, err := c.repository.GetIngress(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingress: %w", err)
+	}
+	return ingress, nil
+}
+
+// ListIngresses lists ingresses
+func (c *IngressesController) ListIngresses(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Ingress, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	ingresses, err := c.repository.ListIngresses(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+	return ingresses, nil
+}
@@ -0,0 +1,121 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// JobsController handles jobs and cron jobs business logic orchestration
+type JobsController struct {
+	jobsRepository     *repositories.JobsRepository
+	cronJobsRepository *repositories.CronJobsRepository
+	healthCalculator   *k8sLogic.HealthCalculator
+}
+
+// NewJobsController creates a new jobs controller
+func NewJobsController(jobsRepository *repositories.JobsRepository, cronJobsRepository *repositories.CronJobsRepository, healthCalculator *k8sLogic.HealthCalculator) *JobsController {
+	return &JobsController{
+		jobsRepository:     jobsRepository,
+		cronJobsRepository: cronJobsRepository,
+		healthCalculator:   healthCalculator,
+	}
+}
+
+// GetJob gets a specific job with its health
+func (c *JobsController) GetJob(ctx context.Context, context, namespace, name string) (*k8sModels.Job, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	job, err := c.jobsRepository.GetJob(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	job.HealthStatus = string(c.healthCalculator.CalculateJobHealth(job))
+	return job, nil
+}
+
+// ListJobs lists jobs with their health
+func (c *JobsController) ListJobs(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Job, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	jobs, err := c.jobsRepository.ListJobs(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	for i := range jobs {
+		jobs[i].HealthStatus = string(c.healthCalculator.CalculateJobHealth(&jobs[i]))
+	}
+	return jobs, nil
+}
+
+// GetCronJob gets a specific cron job with its health
+func (c *JobsController) GetCronJob(ctx context.Context, context, namespace, name string) (*k8sModels.CronJob, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	cronJob, err := c.cronJobsRepository.GetCronJob(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron job: %w", err)
+	}
+
+	cronJob.HealthStatus = string(c.healthCalculator.CalculateCronJobHealth(cronJob))
+	return cronJob, nil
+}
+
+// ListCronJobs lists cron jobs with their health
+func (c *JobsController) ListCronJobs(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.CronJob, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	cronJobs, err := c.cronJobsRepository.ListCronJobs(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+
+	for i := range cronJobs {
+		cronJobs[i].HealthStatus = string(c.healthCalculator.CalculateCronJobHealth(&cronJobs[i]))
+	}
+	return cronJobs, nil
+}
+
+// SuspendCronJob suspends or resumes a cron job
+func (c *JobsController) SuspendCronJob(ctx context.Context, context, namespace, name string, suspend bool) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+
+	if err := c.cronJobsRepository.SetSuspend(ctx, context, namespace, name, suspend); err != nil {
+		if suspend {
+			return fmt.Errorf("failed to suspend cron job: %w", err)
+		}
+		return fmt.Errorf("failed to resume cron job: %w", err)
+	}
+
+	return nil
+}
+
+// TriggerCronJob runs a cron job immediately and returns the created job
+func (c *JobsController) TriggerCronJob(ctx context.Context, context, namespace, name string) (*k8sModels.Job, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	job, err := c.cronJobsRepository.TriggerCronJob(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger cron job: %w", err)
+	}
+
+	job.HealthStatus = string(c.healthCalculator.CalculateJobHealth(job))
+	return job, nil
+}
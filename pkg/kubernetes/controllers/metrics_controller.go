@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// MetricsController handles resource metrics business logic orchestration
+type MetricsController struct {
+	repository *repositories.MetricsRepository
+}
+
+// NewMetricsController creates a new metrics controller
+func NewMetricsController(repository *repositories.MetricsRepository) *MetricsController {
+	return &MetricsController{
+		repository: repository,
+	}
+}
+
+// IsMetricsServerAvailable checks if metrics-server is available in a cluster
+func (c *MetricsController) IsMetricsServerAvailable(ctx context.Context, context string) (bool, error) {
+	if context == "" {
+		return false, fmt.Errorf("context is required")
+	}
+
+	return c.repository.IsMetricsServerAvailable(ctx, context)
+}
+
+// GetNodeMetrics gets live node usage with capacity and allocatable resources
+func (c *MetricsController) GetNodeMetrics(ctx context.Context, context, nodeName string) (*k8sModels.NodeResources, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if nodeName == "" {
+		return nil, fmt.Errorf("node name is required")
+	}
+
+	metrics, err := c.repository.GetNodeMetrics(ctx, context, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
+// GetPodMetrics gets live container usage of a pod
+func (c *MetricsController) GetPodMetrics(ctx context.Context, context, namespace, podName string) (*k8sPorts.PodMetrics, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if podName == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+
+	metrics, err := c.repository.GetPodMetrics(ctx, context, namespace, podName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod metrics: %w", err)
+	}
+
+	return metrics, nil
+}
+
+// GetNamespaceMetrics gets aggregated live usage of a namespace
+func (c *MetricsController) GetNamespaceMetrics(ctx context.Context, context, namespace string) (*k8sPorts.NamespaceMetrics, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	metrics, err := c.repository.GetNamespaceMetrics(ctx, context, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace metrics: %w", err)
+	}
+
+	return metrics, nil
+}
@@ -4,19 +4,24 @@ import (
 	"context"
 	"fmt"
 
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 )
 
 // NamespacesController handles namespaces business logic orchestration
 type NamespacesController struct {
-	repository *repositories.NamespacesRepository
+	repository         *repositories.NamespacesRepository
+	quotasRepo         *repositories.QuotasRepository
+	resourceCalculator *k8sLogic.ResourceCalculator
 }
 
 // NewNamespacesController creates a new namespaces controller
-func NewNamespacesController(repository *repositories.NamespacesRepository) *NamespacesController {
+func NewNamespacesController(repository *repositories.NamespacesRepository, quotasRepo *repositories.QuotasRepository) *NamespacesController {
 	return &NamespacesController{
-		repository: repository,
+		repository:         repository,
+		quotasRepo:         quotasRepo,
+		resourceCalculator: k8sLogic.NewResourceCalculator(),
 	}
 }
 
@@ -34,7 +39,10 @@ func (c *NamespacesController) GetNamespace(ctx context.Context, context, namesp
 		return nil, fmt.Errorf("failed to get namespace: %w", err)
 	}
 
-	return namespace, nil
+	enriched := []k8sModels.Namespace{*namespace}
+	c.enrichWithQuotas(ctx, context, namespaceName, enriched)
+
+	return &enriched[0], nil
 }
 
 // ListNamespaces lists all namespaces with business logic processing
@@ -50,10 +58,55 @@ func (c *NamespacesController) ListNamespaces(ctx context.Context, context strin
 
 	// Apply business logic: sort namespaces by name for consistent ordering
 	namespaces = c.sortNamespacesByName(namespaces)
+	c.enrichWithQuotas(ctx, context, "", namespaces)
 
 	return namespaces, nil
 }
 
+// GetQuotaRanking ranks the namespaces of a cluster by their most utilized resource quota
+func (c *NamespacesController) GetQuotaRanking(ctx context.Context, context string) ([]k8sModels.NamespaceQuotaUsage, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	quotas, err := c.quotasRepo.ListResourceQuotas(ctx, context, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range quotas {
+		c.resourceCalculator.CalculateQuotaUtilization(&quotas[i])
+	}
+
+	return c.resourceCalculator.RankNamespaceQuotas(quotas), nil
+}
+
+// enrichWithQuotas attaches resource quotas and limit ranges to namespaces; an empty scope lists them cluster wide.
+// Quotas are best effort, so a user without access to them still sees the namespaces.
+func (c *NamespacesController) enrichWithQuotas(ctx context.Context, context, scope string, namespaces []k8sModels.Namespace) {
+	quotas, err := c.quotasRepo.ListResourceQuotas(ctx, context, scope)
+	if err == nil {
+		quotasByNamespace := make(map[string][]k8sModels.ResourceQuota)
+		for i := range quotas {
+			c.resourceCalculator.CalculateQuotaUtilization(&quotas[i])
+			quotasByNamespace[quotas[i].Namespace] = append(quotasByNamespace[quotas[i].Namespace], quotas[i])
+		}
+		for i := range namespaces {
+			namespaces[i].ResourceQuotas = quotasByNamespace[namespaces[i].Name]
+		}
+	}
+
+	limitRanges, err := c.quotasRepo.ListLimitRanges(ctx, context, scope)
+	if err == nil {
+		limitRangesByNamespace := make(map[string][]k8sModels.LimitRange)
+		for _, limitRange := range limitRanges {
+			limitRangesByNamespace[limitRange.Namespace] = append(limitRangesByNamespace[limitRange.Namespace], limitRange)
+		}
+		for i := range namespaces {
+			namespaces[i].LimitRanges = limitRangesByNamespace[namespaces[i].Name]
+		}
+	}
+}
+
 // CreateNamespace creates a new namespace with business logic validation
 func (c *NamespacesController) CreateNamespace(ctx context.Context, context, name string) (*k8sModels.Namespace, error) {
 	if context == "" {
@@ -2,24 +2,60 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"sync"
+	"time"
 
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 )
 
+// drainPollInterval is how often a drain retries blocked evictions and checks evicted pods
+const drainPollInterval = 2 * time.Second
+
+// ErrDrainInProgress is returned when a node is already being drained
+var ErrDrainInProgress = errors.New("drain already in progress")
+
+// ErrDrainsStopped is returned when a drain is started after the controller was closed
+var ErrDrainsStopped = errors.New("drains are stopped")
+
 // NodesController handles nodes business logic orchestration
 type NodesController struct {
-	repository *repositories.NodesRepository
+	repository   *repositories.NodesRepository
+	drainsRepo   *repositories.DrainOperationsRepository
+	drainPlanner *k8sLogic.DrainPlanner
+
+	// baseCtx bounds the background drains and is cancelled by Close
+	baseCtx context.Context
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+	drains  sync.WaitGroup
 }
 
 // NewNodesController creates a new nodes controller
-func NewNodesController(repository *repositories.NodesRepository) *NodesController {
+func NewNodesController(repository *repositories.NodesRepository, drainsRepo *repositories.DrainOperationsRepository, drainPlanner *k8sLogic.DrainPlanner) *NodesController {
+	baseCtx, cancel := context.WithCancel(context.Background())
 	return &NodesController{
-		repository: repository,
+		repository:   repository,
+		drainsRepo:   drainsRepo,
+		drainPlanner: drainPlanner,
+		baseCtx:      baseCtx,
+		cancel:       cancel,
 	}
 }
 
+// Close cancels the running drains and waits until they have stored their final state
+func (c *NodesController) Close() {
+	c.mu.Lock()
+	c.cancel()
+	c.mu.Unlock()
+	c.drains.Wait()
+}
+
 // GetNode gets a specific node with business logic validation
 func (c *NodesController) GetNode(ctx context.Context, context, nodeName string) (*k8sModels.Node, error) {
 	if context == "" {
@@ -113,6 +149,198 @@ func (c *NodesController) GetNodesSummary(ctx context.Context, context string) (
 	return summary, nil
 }
 
+// CordonNode marks a node unschedulable
+func (c *NodesController) CordonNode(ctx context.Context, context, nodeName string) error {
+	return c.setUnschedulable(ctx, context, nodeName, true)
+}
+
+// UncordonNode marks a node schedulable again
+func (c *NodesController) UncordonNode(ctx context.Context, context, nodeName string) error {
+	return c.setUnschedulable(ctx, context, nodeName, false)
+}
+
+// setUnschedulable validates the request and updates the node
+func (c *NodesController) setUnschedulable(ctx context.Context, context, nodeName string, unschedulable bool) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+	if nodeName == "" {
+		return fmt.Errorf("node name is required")
+	}
+
+	return c.repository.SetUnschedulable(ctx, context, nodeName, unschedulable)
+}
+
+// StartDrain cordons a node and starts evicting its pods in the background.
+// The returned operation can be polled with GetDrain until it finishes.
+func (c *NodesController) StartDrain(ctx context.Context, context, nodeName, user string, options k8sModels.DrainOptions) (*k8sModels.DrainOperation, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if nodeName == "" {
+		return nil, fmt.Errorf("node name is required")
+	}
+	if options.GracePeriodSeconds != nil && *options.GracePeriodSeconds < 0 {
+		return nil, fmt.Errorf("%w: grace period must be non-negative", k8sLogic.ErrInvalidDrainOptions)
+	}
+	if options.TimeoutSeconds < 0 || options.Timeout() > k8sModels.MaxDrainTimeout {
+		return nil, fmt.Errorf("%w: timeout must be between 0 and %d seconds", k8sLogic.ErrInvalidDrainOptions, int64(k8sModels.MaxDrainTimeout.Seconds()))
+	}
+	if c.baseCtx.Err() != nil {
+		return nil, ErrDrainsStopped
+	}
+
+	// Same as kubectl drain: the node stays cordoned even when the drain cannot start
+	if err := c.repository.SetUnschedulable(ctx, context, nodeName, true); err != nil {
+		return nil, fmt.Errorf("failed to cordon node: %w", err)
+	}
+
+	candidates, err := c.repository.ListDrainCandidates(ctx, context, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.drainPlanner.PlanDrain(candidates, options)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := newOperationID()
+	if err != nil {
+		return nil, err
+	}
+	operation := &k8sModels.DrainOperation{
+		ID:        id,
+		Context:   context,
+		Node:      nodeName,
+		User:      user,
+		Status:    k8sModels.DrainStatusRunning,
+		Options:   options,
+		Pods:      pods,
+		StartedAt: time.Now(),
+	}
+	if !c.drainsRepo.CreateOperation(operation) {
+		return nil, fmt.Errorf("%w on node %s", ErrDrainInProgress, nodeName)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.baseCtx.Err() != nil {
+		operation.Complete(k8sModels.DrainStatusCancelled, "drain cancelled because DashOps is shutting down")
+		c.drainsRepo.SaveOperation(operation)
+		return nil, ErrDrainsStopped
+	}
+
+	snapshot := operation.Copy()
+	c.drains.Add(1)
+	go func() {
+		defer c.drains.Done()
+		c.runDrain(ctx, operation)
+	}()
+
+	return &snapshot, nil
+}
+
+// GetDrain gets a tracked drain operation
+func (c *NodesController) GetDrain(context, nodeName, id string) (*k8sModels.DrainOperation, error) {
+	operation, exists := c.drainsRepo.GetOperation(context, id)
+	if !exists || operation.Node != nodeName {
+		return nil, fmt.Errorf("drain operation %s not found", id)
+	}
+	return operation, nil
+}
+
+// ListDrains lists tracked drain operations of a node, newest first
+func (c *NodesController) ListDrains(context, nodeName string) ([]k8sModels.DrainOperation, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if nodeName == "" {
+		return nil, fmt.Errorf("node name is required")
+	}
+	return c.drainsRepo.ListOperations(context, nodeName), nil
+}
+
+// runDrain evicts the pods of a drain until all are gone, one fails for good, the timeout expires or the controller is closed.
+// It outlives the request that started it, so it only keeps the request's values, such as the user to impersonate.
+func (c *NodesController) runDrain(requestCtx context.Context, operation *k8sModels.DrainOperation) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(requestCtx), operation.Options.Timeout())
+	defer cancel()
+	stop := context.AfterFunc(c.baseCtx, cancel)
+	defer stop()
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	c.advanceDrain(ctx, operation)
+	for operation.HasPendingPods() {
+		c.drainsRepo.SaveOperation(operation)
+
+		select {
+		case <-ctx.Done():
+			if c.baseCtx.Err() != nil {
+				operation.Complete(k8sModels.DrainStatusCancelled, "drain cancelled because DashOps is shutting down")
+			} else {
+				operation.Complete(k8sModels.DrainStatusTimedOut, fmt.Sprintf("drain did not finish within %s", operation.Options.Timeout()))
+			}
+			c.finishDrain(operation)
+			return
+		case <-ticker.C:
+		}
+		c.advanceDrain(ctx, operation)
+	}
+
+	if progress := operation.Progress(); progress.Failed > 0 {
+		operation.Complete(k8sModels.DrainStatusFailed, fmt.Sprintf("%d pod(s) could not be evicted", progress.Failed))
+	} else {
+		operation.Complete(k8sModels.DrainStatusSucceeded, "")
+	}
+	c.finishDrain(operation)
+}
+
+// finishDrain stores the final state of a drain and logs it for auditing
+func (c *NodesController) finishDrain(operation *k8sModels.DrainOperation) {
+	c.drainsRepo.SaveOperation(operation)
+	log.Printf("k8s drain %s: user=%q cluster=%s node=%s status=%s message=%q",
+		operation.ID, operation.User, operation.Context, operation.Node, operation.Status, operation.Message)
+}
+
+// advanceDrain requests evictions for waiting pods and checks whether evicted pods are gone
+func (c *NodesController) advanceDrain(ctx context.Context, operation *k8sModels.DrainOperation) {
+	for i := range operation.Pods {
+		pod := &operation.Pods[i]
+
+		switch pod.Status {
+		case k8sModels.DrainPodPending, k8sModels.DrainPodBlocked:
+			pod.Attempts++
+			err := c.repository.EvictPod(ctx, operation.Context, pod.Namespace, pod.Name, operation.Options.GracePeriodSeconds)
+			switch {
+			case err == nil:
+				pod.Status = k8sModels.DrainPodEvicting
+				pod.Reason = ""
+			case errors.Is(err, kubernetes.ErrEvictionBlocked):
+				// Disruption budgets free up as replacement pods become ready, so keep retrying
+				pod.Status = k8sModels.DrainPodBlocked
+				pod.Reason = err.Error()
+			case ctx.Err() != nil:
+				return
+			default:
+				pod.Status = k8sModels.DrainPodFailed
+				pod.Reason = err.Error()
+			}
+		case k8sModels.DrainPodEvicting:
+			gone, err := c.repository.IsPodGone(ctx, operation.Context, pod.Namespace, pod.Name, pod.UID)
+			if err != nil {
+				pod.Reason = err.Error()
+				continue
+			}
+			if gone {
+				pod.Status = k8sModels.DrainPodEvicted
+				pod.Reason = ""
+			}
+		}
+	}
+}
+
 // sortNodesByName sorts nodes by name for consistent ordering
 func (c *NodesController) sortNodesByName(nodes []k8sModels.Node) []k8sModels.Node {
 	// Simple bubble sort for small lists (nodes are typically < 100)
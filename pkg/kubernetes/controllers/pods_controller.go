@@ -1,22 +1,36 @@
 package controllers
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 )
 
+const (
+	// maxTailLines limits how many lines can be requested from a container
+	maxTailLines = 10000
+	// maxLogLineBytes limits the size of a single streamed log line
+	maxLogLineBytes = 1024 * 1024
+)
+
 // PodsController handles pods business logic orchestration
 type PodsController struct {
-	repository *repositories.PodsRepository
+	repository         *repositories.PodsRepository
+	logProcessor       *k8sLogic.LogProcessor
+	resourceCalculator *k8sLogic.ResourceCalculator
 }
 
 // NewPodsController creates a new pods controller
 func NewPodsController(repository *repositories.PodsRepository) *PodsController {
 	return &PodsController{
-		repository: repository,
+		repository:         repository,
+		logProcessor:       k8sLogic.NewLogProcessor(),
+		resourceCalculator: k8sLogic.NewResourceCalculator(),
 	}
 }
 
@@ -37,6 +51,7 @@ func (c *PodsController) GetPod(ctx context.Context, context, namespace, podName
 		return nil, fmt.Errorf("failed to get pod: %w", err)
 	}
 
+	pod.Resources = c.resourceCalculator.CalculatePodResourceUsage(pod)
 	return pod, nil
 }
 
@@ -51,6 +66,11 @@ func (c *PodsController) ListPods(ctx context.Context, context string, filter *k
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
 
+	// Business logic: compare usage with requests and limits
+	for i := range podList.Pods {
+		podList.Pods[i].Resources = c.resourceCalculator.CalculatePodResourceUsage(&podList.Pods[i])
+	}
+
 	return podList, nil
 }
 
@@ -111,7 +131,6 @@ func (c *PodsController) GetPodLogs(ctx context.Context, context string, filter
 	}
 
 	// Business logic: limit maximum tail lines
-	const maxTailLines = 10000
 	if filter.TailLines > maxTailLines {
 		filter.TailLines = maxTailLines
 	}
@@ -130,6 +149,88 @@ func (c *PodsController) GetPodLogs(ctx context.Context, context string, filter
 	return logs, nil
 }
 
+// OpenPodLogStream validates the filter, resolves the container and opens its log stream.
+// The caller must close the returned stream.
+func (c *PodsController) OpenPodLogStream(ctx context.Context, context string, filter *k8sModels.LogFilter) (io.ReadCloser, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+	if filter == nil {
+		return nil, fmt.Errorf("log filter is required")
+	}
+	if filter.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if filter.PodName == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+
+	// Business logic: validate log filter parameters
+	if filter.TailLines < 0 {
+		return nil, fmt.Errorf("tail lines must be non-negative")
+	}
+	if filter.SinceSeconds < 0 {
+		return nil, fmt.Errorf("since seconds must be non-negative")
+	}
+	if filter.TailLines > maxTailLines {
+		filter.TailLines = maxTailLines
+	}
+
+	pod, err := c.repository.GetPod(ctx, context, filter.Namespace, filter.PodName)
+	if err != nil {
+		return nil, fmt.Errorf("pod not found: %w", err)
+	}
+
+	containerName, err := c.logProcessor.ResolveContainer(pod, filter.ContainerName)
+	if err != nil {
+		return nil, err
+	}
+	filter.ContainerName = containerName
+
+	stream, err := c.repository.StreamPodLogs(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log stream: %w", err)
+	}
+
+	return stream, nil
+}
+
+// ReadPodLogStream parses lines from a log stream and passes them to emit until the stream ends,
+// emit fails or ctx is cancelled. The stream is closed when ctx is cancelled so blocked reads return.
+func (c *PodsController) ReadPodLogStream(ctx context.Context, stream io.ReadCloser, filter *k8sModels.LogFilter, emit func(k8sModels.ContainerLog) error) error {
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			stream.Close()
+		case <-done:
+		}
+	}()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineBytes)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		if err := emit(c.logProcessor.ParseLine(line, filter)); err != nil {
+			return err
+		}
+	}
+
+	// A cancelled context means the client went away, which is not a stream failure
+	if ctx.Err() != nil {
+		return nil
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read log stream: %w", err)
+	}
+
+	return nil
+}
+
 // GetPodsSummary provides a summary of pods in a namespace or cluster
 func (c *PodsController) GetPodsSummary(ctx context.Context, context string, namespace string) (*PodsSummary, error) {
 	if context == "" {
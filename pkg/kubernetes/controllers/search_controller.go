@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// searchClusterTimeout bounds how long a single cluster may take to answer a search
+const searchClusterTimeout = 10 * time.Second
+
+// maxSearchMatchesPerCluster bounds the matches returned for a single cluster
+const maxSearchMatchesPerCluster = 200
+
+// NamespaceFilter reports whether a namespace of a cluster may be shown to the user
+type NamespaceFilter func(context, namespace string) bool
+
+// SearchController searches workloads across every configured cluster
+type SearchController struct {
+	clustersRepo    *repositories.ClustersRepository
+	deploymentsRepo *repositories.DeploymentsRepository
+	podsRepo        *repositories.PodsRepository
+	matcher         *k8sLogic.WorkloadMatcher
+}
+
+// NewSearchController creates a new search controller
+func NewSearchController(
+	clustersRepo *repositories.ClustersRepository,
+	deploymentsRepo *repositories.DeploymentsRepository,
+	podsRepo *repositories.PodsRepository,
+	matcher *k8sLogic.WorkloadMatcher,
+) *SearchController {
+	return &SearchController{
+		clustersRepo:    clustersRepo,
+		deploymentsRepo: deploymentsRepo,
+		podsRepo:        podsRepo,
+		matcher:         matcher,
+	}
+}
+
+// SearchWorkloads matches deployments and pods by name, label selector or image in every cluster concurrently.
+// A cluster that fails or times out is reported as failed without failing the whole search.
+func (c *SearchController) SearchWorkloads(ctx context.Context, rawQuery string, allowed NamespaceFilter) (*k8sModels.WorkloadSearchResult, error) {
+	query, err := c.matcher.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := c.clustersRepo.ListConfiguredClusters()
+	results := make([]*k8sModels.ClusterSearchResult, len(clusters))
+	failures := make([]error, len(clusters))
+
+	var wg sync.WaitGroup
+	for i, cluster := range clusters {
+		wg.Add(1)
+		go func(i int, cluster k8sModels.Cluster) {
+			defer wg.Done()
+			results[i], failures[i] = c.searchCluster(ctx, cluster, query, allowed)
+		}(i, cluster)
+	}
+	wg.Wait()
+
+	result := &k8sModels.WorkloadSearchResult{
+		Query:    query.String(),
+		Clusters: make([]k8sModels.ClusterSearchResult, 0, len(clusters)),
+		Failed:   make([]k8sModels.ClusterSearchFailure, 0),
+	}
+	for i, cluster := range clusters {
+		if failures[i] != nil {
+			result.Failed = append(result.Failed, k8sModels.ClusterSearchFailure{
+				Name:    cluster.Name,
+				Context: cluster.Context,
+				Error:   failures[i].Error(),
+			})
+			continue
+		}
+		result.Clusters = append(result.Clusters, *results[i])
+	}
+
+	return result, nil
+}
+
+// searchCluster searches the deployments and pods of a single cluster within the per-cluster timeout
+func (c *SearchController) searchCluster(ctx context.Context, cluster k8sModels.Cluster, query *k8sLogic.WorkloadQuery, allowed NamespaceFilter) (*k8sModels.ClusterSearchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, searchClusterTimeout)
+	defer cancel()
+
+	deploymentList, err := c.deploymentsRepo.ListDeployments(ctx, cluster.Context, nil)
+	if err == nil {
+		err = ctx.Err() // Cross-namespace listing skips namespaces that fail, so check for a timeout explicitly
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search deployments: %w", err)
+	}
+
+	podList, err := c.podsRepo.ListPods(ctx, cluster.Context, nil)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to search pods: %w", err)
+	}
+
+	result := &k8sModels.ClusterSearchResult{
+		Name:    cluster.Name,
+		Context: cluster.Context,
+	}
+	matches := 0
+
+	deployments := make([]k8sModels.WorkloadMatch, 0)
+	for i := range deploymentList.Deployments {
+		deployment := &deploymentList.Deployments[i]
+		if !allowed(cluster.Context, deployment.Namespace) {
+			continue
+		}
+		field, matched := c.matcher.MatchDeployment(query, deployment)
+		if !matched {
+			continue
+		}
+		if matches == maxSearchMatchesPerCluster {
+			result.Truncated = true
+			break
+		}
+		matches++
+		deployments = append(deployments, k8sModels.WorkloadMatch{
+			Kind:      deploymentKind,
+			Name:      deployment.Name,
+			Namespace: deployment.Namespace,
+			MatchedOn: field,
+			Images:    deployment.Images,
+			Status:    fmt.Sprintf("%d/%d ready", deployment.Replicas.Ready, deployment.Replicas.Desired),
+		})
+	}
+
+	pods := make([]k8sModels.WorkloadMatch, 0)
+	for i := range podList.Pods {
+		pod := &podList.Pods[i]
+		if result.Truncated || !allowed(cluster.Context, pod.Namespace) {
+			continue
+		}
+		field, matched := c.matcher.MatchPod(query, pod)
+		if !matched {
+			continue
+		}
+		if matches == maxSearchMatchesPerCluster {
+			result.Truncated = true
+			break
+		}
+		matches++
+
+		images := make([]string, 0, len(pod.Containers))
+		for _, container := range pod.Containers {
+			images = append(images, container.Image)
+		}
+		pods = append(pods, k8sModels.WorkloadMatch{
+			Kind:      "Pod",
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			MatchedOn: field,
+			Images:    images,
+			Status:    string(pod.Status),
+			Node:      pod.Node,
+		})
+	}
+
+	result.Namespaces = c.matcher.GroupByNamespace(deployments, pods)
+	return result, nil
+}
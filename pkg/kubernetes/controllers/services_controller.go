@@ -0,0 +1,64 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// ServicesController handles services business logic orchestration
+type ServicesController struct {
+	repository     *repositories.ServicesRepository
+	serviceMatcher *k8sLogic.ServiceMatcher
+}
+
+// NewServicesController creates a new services controller
+func NewServicesController(repository *repositories.ServicesRepository) *ServicesController {
+	return &ServicesController{
+		repository:     repository,
+		serviceMatcher: k8sLogic.NewServiceMatcher(),
+	}
+}
+
+// GetService gets a specific service with endpoint readiness
+func (c *ServicesController) GetService(ctx context.Context, context, namespace, name string) (*k8sModels.Service, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	service, err := c.repository.GetService(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service: %w", err)
+	}
+	return service, nil
+}
+
+// ListServices lists services with endpoint readiness
+func (c *ServicesController) ListServices(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Service, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	services, err := c.repository.ListServices(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+	return services, nil
+}
+
+// GetDeploymentServices gets the services in the deployment's namespace that select its pods
+func (c *ServicesController) GetDeploymentServices(ctx context.Context, context string, deployment *k8sModels.Deployment) ([]k8sModels.Service, error) {
+	if len(deployment.PodLabels) == 0 {
+		return []k8sModels.Service{}, nil
+	}
+
+	services, err := c.ListServices(ctx, context, &k8sModels.WorkloadFilter{Namespace: deployment.Namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.serviceMatcher.FindSelectingServices(services, deployment.PodLabels), nil
+}
@@ -0,0 +1,93 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
+)
+
+// StatefulSetsController handles stateful sets business logic orchestration
+type StatefulSetsController struct {
+	repository       *repositories.StatefulSetsRepository
+	healthCalculator *k8sLogic.HealthCalculator
+}
+
+// NewStatefulSetsController creates a new stateful sets controller
+func NewStatefulSetsController(repository *repositories.StatefulSetsRepository, healthCalculator *k8sLogic.HealthCalculator) *StatefulSetsController {
+	return &StatefulSetsController{
+		repository:       repository,
+		healthCalculator: healthCalculator,
+	}
+}
+
+// GetStatefulSet gets a specific stateful set with its health
+func (c *StatefulSetsController) GetStatefulSet(ctx context.Context, context, namespace, name string) (*k8sModels.StatefulSet, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	statefulSet, err := c.repository.GetStatefulSet(ctx, context, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stateful set: %w", err)
+	}
+
+	statefulSet.HealthStatus = string(c.healthCalculator.CalculateStatefulSetHealth(statefulSet))
+	return statefulSet, nil
+}
+
+// ListStatefulSets lists stateful sets with their health
+func (c *StatefulSetsController) ListStatefulSets(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.StatefulSet, error) {
+	if context == "" {
+		return nil, fmt.Errorf("context is required")
+	}
+
+	statefulSets, err := c.repository.ListStatefulSets(ctx, context, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stateful sets: %w", err)
+	}
+
+	for i := range statefulSets {
+		statefulSets[i].HealthStatus = string(c.healthCalculator.CalculateStatefulSetHealth(&statefulSets[i]))
+	}
+	return statefulSets, nil
+}
+
+// ScaleStatefulSet scales a stateful set with business logic validation
+func (c *StatefulSetsController) ScaleStatefulSet(ctx context.Context, context, namespace, name string, replicas int32) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+	if replicas < 0 {
+		return fmt.Errorf("replicas must be non-negative")
+	}
+	if replicas > maxReplicas {
+		return fmt.Errorf("replicas cannot exceed %d", maxReplicas)
+	}
+
+	// Verify stateful set exists before scaling
+	if _, err := c.repository.GetStatefulSet(ctx, context, namespace, name); err != nil {
+		return fmt.Errorf("stateful set not found: %w", err)
+	}
+
+	if err := c.repository.ScaleStatefulSet(ctx, context, namespace, name, replicas); err != nil {
+		return fmt.Errorf("failed to scale stateful set: %w", err)
+	}
+
+	return nil
+}
+
+// RestartStatefulSet restarts a stateful set
+func (c *StatefulSetsController) RestartStatefulSet(ctx context.Context, context, namespace, name string) error {
+	if context == "" {
+		return fmt.Errorf("context is required")
+	}
+
+	if err := c.repository.RestartStatefulSet(ctx, context, namespace, name); err != nil {
+		return fmt.Errorf("failed to restart stateful set: %w", err)
+	}
+
+	return nil
+}
@@ -1,60 +1,146 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	commonsHttp "github.com/dash-ops/dash-ops/pkg/commons/adapters/http"
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 	k8sAdapters "github.com/dash-ops/dash-ops/pkg/kubernetes/adapters/http"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/controllers"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 	k8sWire "github.com/dash-ops/dash-ops/pkg/kubernetes/wire"
 )
 
+// eventStreamHeartbeat is how often idle Server-Sent Events streams send a keepalive
+const eventStreamHeartbeat = 30 * time.Second
+
+// execSubprotocol is the WebSocket subprotocol spoken by the pod exec endpoint
+const execSubprotocol = "dash-ops.exec.v1"
+
 // HTTPHandler handles HTTP requests for Kubernetes module
 type HTTPHandler struct {
-	nodesController       *controllers.NodesController
-	deploymentsController *controllers.DeploymentsController
-	podsController        *controllers.PodsController
-	namespacesController  *controllers.NamespacesController
-	k8sClient             *kubernetes.KubernetesClient
-	responseAdapter       *commonsHttp.ResponseAdapter
-	requestAdapter        *commonsHttp.RequestAdapter
+	clustersController     *controllers.ClustersController
+	nodesController        *controllers.NodesController
+	deploymentsController  *controllers.DeploymentsController
+	podsController         *controllers.PodsController
+	namespacesController   *controllers.NamespacesController
+	eventsController       *controllers.EventsController
+	metricsController      *controllers.MetricsController
+	statefulSetsController *controllers.StatefulSetsController
+	daemonSetsController   *controllers.DaemonSetsController
+	jobsController         *controllers.JobsController
+	servicesController     *controllers.ServicesController
+	ingressesController    *controllers.IngressesController
+	configMapsController   *controllers.ConfigMapsController
+	execController         *controllers.ExecController
+	applyController        *controllers.ApplyController
+	searchController       *controllers.SearchController
+	execUpgrader           websocket.Upgrader
+	allowedOrigin          string
+	registry               *kubernetes.ClientRegistry
+	permissionChecker      *k8sLogic.PermissionChecker
+	responseAdapter        *commonsHttp.ResponseAdapter
+	requestAdapter         *commonsHttp.RequestAdapter
 }
 
 // NewHTTPHandler creates a new HTTP handler
 func NewHTTPHandler(
-	k8sClient *kubernetes.KubernetesClient,
+	registry *kubernetes.ClientRegistry,
+	healthCalculator *k8sLogic.HealthCalculator,
+	permissionChecker *k8sLogic.PermissionChecker,
 	responseAdapter *commonsHttp.ResponseAdapter,
 	requestAdapter *commonsHttp.RequestAdapter,
 ) *HTTPHandler {
-	// Initialize repositories with the provided client
-	nodesRepo := repositories.NewNodesRepository(k8sClient)
-	deploymentsRepo := repositories.NewDeploymentsRepository(k8sClient)
-	podsRepo := repositories.NewPodsRepository(k8sClient)
-	namespacesRepo := repositories.NewNamespacesRepository(k8sClient)
+	// Initialize repositories with the cluster client registry
+	clustersRepo := repositories.NewClustersRepository(registry)
+	nodesRepo := repositories.NewNodesRepository(registry)
+	deploymentsRepo := repositories.NewDeploymentsRepository(registry)
+	autoscalersRepo := repositories.NewAutoscalersRepository(registry)
+	podsRepo := repositories.NewPodsRepository(registry)
+	namespacesRepo := repositories.NewNamespacesRepository(registry)
+	quotasRepo := repositories.NewQuotasRepository(registry)
+	eventsRepo := repositories.NewEventsRepository(registry)
+	metricsRepo := repositories.NewMetricsRepository(registry)
+	statefulSetsRepo := repositories.NewStatefulSetsRepository(registry)
+	daemonSetsRepo := repositories.NewDaemonSetsRepository(registry)
+	jobsRepo := repositories.NewJobsRepository(registry)
+	cronJobsRepo := repositories.NewCronJobsRepository(registry)
+	servicesRepo := repositories.NewServicesRepository(registry)
+	ingressesRepo := repositories.NewIngressesRepository(registry)
+	configMapsRepo := repositories.NewConfigMapsRepository(registry)
+	execSessionsRepo := repositories.NewExecSessionsRepository()
+	drainsRepo := repositories.NewDrainOperationsRepository()
+	applyRepo := repositories.NewApplyRepository(registry)
+	applyPlansRepo := repositories.NewApplyPlansRepository()
 
 	// Initialize controllers with repositories
-	nodesController := controllers.NewNodesController(nodesRepo)
-	deploymentsController := controllers.NewDeploymentsController(deploymentsRepo)
+	clustersController := controllers.NewClustersController(clustersRepo, deploymentsRepo, podsRepo, healthCalculator)
+	nodesController := controllers.NewNodesController(nodesRepo, drainsRepo, k8sLogic.NewDrainPlanner())
+	deploymentsController := controllers.NewDeploymentsController(deploymentsRepo, autoscalersRepo)
 	podsController := controllers.NewPodsController(podsRepo)
-	namespacesController := controllers.NewNamespacesController(namespacesRepo)
-
-	return &HTTPHandler{
-		nodesController:       nodesController,
-		deploymentsController: deploymentsController,
-		podsController:        podsController,
-		namespacesController:  namespacesController,
-		k8sClient:             k8sClient,
-		responseAdapter:       responseAdapter,
-		requestAdapter:        requestAdapter,
+	namespacesController := controllers.NewNamespacesController(namespacesRepo, quotasRepo)
+	eventsController := controllers.NewEventsController(eventsRepo)
+	metricsController := controllers.NewMetricsController(metricsRepo)
+	statefulSetsController := controllers.NewStatefulSetsController(statefulSetsRepo, healthCalculator)
+	daemonSetsController := controllers.NewDaemonSetsController(daemonSetsRepo, healthCalculator)
+	jobsController := controllers.NewJobsController(jobsRepo, cronJobsRepo, healthCalculator)
+	servicesController := controllers.NewServicesController(servicesRepo)
+	ingressesController := controllers.NewIngressesController(ingressesRepo)
+	configMapsController := controllers.NewConfigMapsController(configMapsRepo)
+	execController := controllers.NewExecController(podsRepo, execSessionsRepo)
+	searchController := controllers.NewSearchController(clustersRepo, deploymentsRepo, podsRepo, k8sLogic.NewWorkloadMatcher())
+	applyController := controllers.NewApplyController(applyRepo, applyPlansRepo, k8sLogic.NewManifestPlanner())
+
+	h := &HTTPHandler{
+		clustersController:     clustersController,
+		nodesController:        nodesController,
+		deploymentsController:  deploymentsController,
+		podsController:         podsController,
+		namespacesController:   namespacesController,
+		eventsController:       eventsController,
+		metricsController:      metricsController,
+		statefulSetsController: statefulSetsController,
+		daemonSetsController:   daemonSetsController,
+		jobsController:         jobsController,
+		servicesController:     servicesController,
+		ingressesController:    ingressesController,
+		configMapsController:   configMapsController,
+		execController:         execController,
+		applyController:        applyController,
+		searchController:       searchController,
+		registry:               registry,
+		permissionChecker:      permissionChecker,
+		responseAdapter:        responseAdapter,
+		requestAdapter:         requestAdapter,
+	}
+	h.execUpgrader = websocket.Upgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		Subprotocols:    []string{execSubprotocol},
+		CheckOrigin:     h.checkExecOrigin,
 	}
+	return h
+}
+
+// SetAllowedOrigin sets the browser origin allowed to open exec WebSockets besides the API host
+func (h *HTTPHandler) SetAllowedOrigin(origin string) {
+	h.allowedOrigin = origin
 }
 
 // SetServiceContextResolver sets the service context resolver for the deployments controller
@@ -64,22 +150,42 @@ func (h *HTTPHandler) SetServiceContextResolver(resolver k8sPorts.ServiceContext
 	}
 }
 
+// Close stops background work started by requests, such as running node drains
+func (h *HTTPHandler) Close() {
+	if h.nodesController != nil {
+		h.nodesController.Close()
+	}
+}
+
 // RegisterRoutes registers all Kubernetes routes
 func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
+	router.Use(h.clusterContextMiddleware)
+	router.Use(h.identityMiddleware)
+
 	// Cluster operations
 	router.HandleFunc("/clusters", h.listClustersHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}", h.getClusterInfoHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/health", h.getClusterHealthHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/permissions", h.getPermissionsHandler).Methods("GET")
+
+	// Cross-cluster operations
+	router.HandleFunc("/search", h.searchWorkloadsHandler).Methods("GET")
 
 	// Node operations
 	router.HandleFunc("/clusters/{context}/nodes", h.listNodesHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/nodes/{name}", h.getNodeHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/cordon", h.cordonNodeHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/uncordon", h.uncordonNodeHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/drain", h.drainNodeHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/drains", h.listDrainsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/drains/{id}", h.getDrainHandler).Methods("GET")
 
 	// Namespace operations
 	router.HandleFunc("/clusters/{context}/namespaces", h.listNamespacesHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/namespaces", h.createNamespaceHandler).Methods("POST")
 	router.HandleFunc("/clusters/{context}/namespaces/{name}", h.getNamespaceHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/namespaces/{name}", h.deleteNamespaceHandler).Methods("DELETE")
+	router.HandleFunc("/clusters/{context}/quotas", h.getQuotaRankingHandler).Methods("GET")
 
 	// Deployment operations
 	router.HandleFunc("/clusters/{context}/deployments", h.listDeploymentsHandler).Methods("GET")
@@ -87,6 +193,57 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}", h.getDeploymentHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/scale", h.scaleDeploymentHandler).Methods("PUT")
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/restart", h.restartDeploymentHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/rollout/history", h.getRolloutHistoryHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/rollout/status", h.getRolloutStatusHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/rollback", h.rollbackDeploymentHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/autoscaler", h.getAutoscalerHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/deployments/{name}/autoscaler", h.setAutoscalerBoundsHandler).Methods("PUT")
+
+	// Apply operations
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/apply/preview", h.previewApplyHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/apply", h.applyHandler).Methods("POST")
+
+	// StatefulSet operations
+	router.HandleFunc("/clusters/{context}/statefulsets", h.listStatefulSetsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/statefulsets", h.listStatefulSetsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/statefulsets/{name}", h.getStatefulSetHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/statefulsets/{name}/scale", h.scaleStatefulSetHandler).Methods("PUT")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/statefulsets/{name}/restart", h.restartStatefulSetHandler).Methods("POST")
+
+	// DaemonSet operations
+	router.HandleFunc("/clusters/{context}/daemonsets", h.listDaemonSetsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/daemonsets", h.listDaemonSetsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/daemonsets/{name}", h.getDaemonSetHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/daemonsets/{name}/restart", h.restartDaemonSetHandler).Methods("POST")
+
+	// Job operations
+	router.HandleFunc("/clusters/{context}/jobs", h.listJobsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/jobs", h.listJobsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/jobs/{name}", h.getJobHandler).Methods("GET")
+
+	// CronJob operations
+	router.HandleFunc("/clusters/{context}/cronjobs", h.listCronJobsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/cronjobs", h.listCronJobsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/cronjobs/{name}", h.getCronJobHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/cronjobs/{name}/suspend", h.suspendCronJobHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/cronjobs/{name}/resume", h.resumeCronJobHandler).Methods("POST")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/cronjobs/{name}/trigger", h.triggerCronJobHandler).Methods("POST")
+
+	// Service operations
+	router.HandleFunc("/clusters/{context}/services", h.listServicesHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/services", h.listServicesHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/services/{name}", h.getServiceHandler).Methods("GET")
+
+	// Ingress operations
+	router.HandleFunc("/clusters/{context}/ingresses", h.listIngressesHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/ingresses", h.listIngressesHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/ingresses/{name}", h.getIngressHandler).Methods("GET")
+
+	// ConfigMap operations
+	router.HandleFunc("/clusters/{context}/configmaps", h.listConfigMapsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/configmaps", h.listConfigMapsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/configmaps/{name}", h.getConfigMapHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/configmaps/{name}/data", h.getConfigMapDataHandler).Methods("GET")
 
 	// Pod operations
 	router.HandleFunc("/clusters/{context}/pods", h.listPodsHandler).Methods("GET")
@@ -94,6 +251,145 @@ func (h *HTTPHandler) RegisterRoutes(router *mux.Router) {
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}", h.getPodHandler).Methods("GET")
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}", h.deletePodHandler).Methods("DELETE")
 	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}/logs", h.getPodLogsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}/logs/stream", h.streamPodLogsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}/exec", h.execPodHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/exec/sessions", h.listExecSessionsHandler).Methods("GET")
+
+	// Event operations
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/events", h.listNamespaceEventsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/events/watch", h.watchEventsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/{resourceType}/{name}/events", h.listResourceEventsHandler).Methods("GET")
+
+	// Metrics operations
+	router.HandleFunc("/clusters/{context}/metrics", h.getMetricsAvailabilityHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/nodes/{name}/metrics", h.getNodeMetricsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/metrics", h.getNamespaceMetricsHandler).Methods("GET")
+	router.HandleFunc("/clusters/{context}/namespaces/{namespace}/pods/{name}/metrics", h.getPodMetricsHandler).Methods("GET")
+}
+
+// clusterContextMiddleware rejects requests for contexts that are not configured
+func (h *HTTPHandler) clusterContextMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if context, ok := mux.Vars(r)["context"]; ok && !h.registry.HasContext(context) {
+			h.responseAdapter.WriteError(w, http.StatusNotFound, "Cluster not found: "+context)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// identityMiddleware attaches the authenticated user to the request context,
+// so clusters that enable impersonation make their API calls on the user's behalf
+func (h *HTTPHandler) identityMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user := h.userName(r); user != "" {
+			identity := kubernetes.Identity{User: user, Groups: h.userGroups(r)}
+			r = r.WithContext(kubernetes.WithIdentity(r.Context(), identity))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// userData returns the authenticated user, or nil when the request carries none
+func (h *HTTPHandler) userData(r *http.Request) *commonsModels.UserData {
+	userData, _ := r.Context().Value(commonsModels.UserDataKey).(*commonsModels.UserData)
+	return userData
+}
+
+// userName returns the name of the authenticated user for auditing, if any
+func (h *HTTPHandler) userName(r *http.Request) string {
+	userData := h.userData(r)
+	if userData == nil {
+		return ""
+	}
+	if userData.Username != "" {
+		return userData.Username
+	}
+	return userData.Email
+}
+
+// userGroups returns the groups of the authenticated user, if any
+func (h *HTTPHandler) userGroups(r *http.Request) []string {
+	if userData := h.userData(r); userData != nil {
+		return userData.Groups
+	}
+	return nil
+}
+
+// writePermissionError writes a 403 for permission errors and reports whether it did
+func (h *HTTPHandler) writePermissionError(w http.ResponseWriter, err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, k8sLogic.ErrPermissionDenied) {
+		h.responseAdapter.WriteError(w, http.StatusForbidden, "Forbidden: "+err.Error())
+		return true
+	}
+	h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to check permissions: "+err.Error())
+	return true
+}
+
+// writeClusterError writes a failed cluster call.
+// RBAC denials of impersonated users are passed through as 403s with the API server's explanation.
+func (h *HTTPHandler) writeClusterError(w http.ResponseWriter, status int, message string, err error) {
+	var apiStatus apierrors.APIStatus
+	if apierrors.IsForbidden(err) && errors.As(err, &apiStatus) {
+		h.responseAdapter.WriteError(w, http.StatusForbidden, "Forbidden by cluster RBAC: "+apiStatus.Status().Message)
+		return
+	}
+	if errors.Is(err, kubernetes.ErrIdentityRequired) {
+		h.responseAdapter.WriteError(w, http.StatusUnauthorized, message+": "+err.Error())
+		return
+	}
+	h.responseAdapter.WriteError(w, status, message+": "+err.Error())
+}
+
+// getPermissionsHandler handles GET /clusters/{context}/permissions
+func (h *HTTPHandler) getPermissionsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	permission := h.permissionChecker.GetPermission(context)
+
+	// Return permissions in format expected by frontend
+	response := map[string]interface{}{
+		"deployments": map[string]interface{}{
+			"namespaces": permission.Deployments.Namespaces,
+			"restart":    permission.Deployments.Restart,
+			"scale":      permission.Deployments.Scale,
+		},
+		"configmaps": map[string]interface{}{
+			"values": permission.ConfigMaps.Values,
+		},
+		"exec": permission.Exec,
+		"nodes": map[string]interface{}{
+			"maintenance": permission.Nodes.Maintenance,
+		},
+		"apply": permission.Apply,
+	}
+
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// searchWorkloadsHandler handles GET /search?q=
+// Every cluster is searched concurrently; clusters that fail are listed next to the partial results.
+func (h *HTTPHandler) searchWorkloadsHandler(w http.ResponseWriter, r *http.Request) {
+	result, err := h.searchController.SearchWorkloads(r.Context(), r.URL.Query().Get("q"), h.permissionChecker.IsNamespaceAllowed)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, k8sLogic.ErrInvalidSearchQuery) {
+			status = http.StatusBadRequest
+		}
+		h.writeClusterError(w, status, "Failed to search workloads", err)
+		return
+	}
+
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.WorkloadSearchResultToResponse(result))
 }
 
 // getClusterInfoHandler handles GET /clusters/{context}
@@ -106,16 +402,11 @@ func (h *HTTPHandler) getClusterInfoHandler(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	// TODO: Implement cluster info aggregation from multiple controllers
-	// For now, return a basic cluster info structure
-	clusterInfo := &k8sModels.ClusterInfo{
-		Cluster: k8sModels.Cluster{
-			Name:    context,
-			Context: context,
-			Status:  k8sModels.ClusterStatusConnected,
-		},
+	clusterInfo, err := h.clustersController.GetClusterInfo(r.Context(), context)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to get cluster info", err)
+		return
 	}
-	// no error path for the stubbed response above
 
 	response := k8sAdapters.ClusterInfoToResponse(clusterInfo)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
@@ -133,7 +424,7 @@ func (h *HTTPHandler) listNodesHandler(w http.ResponseWriter, r *http.Request) {
 
 	nodes, err := h.nodesController.ListNodes(r.Context(), context)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list nodes: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list nodes", err)
 		return
 	}
 
@@ -159,7 +450,7 @@ func (h *HTTPHandler) getNodeHandler(w http.ResponseWriter, r *http.Request) {
 
 	node, err := h.nodesController.GetNode(r.Context(), context, nodeName)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusNotFound, "Node not found: "+err.Error())
+		h.writeClusterError(w, http.StatusNotFound, "Node not found", err)
 		return
 	}
 
@@ -167,131 +458,139 @@ func (h *HTTPHandler) getNodeHandler(w http.ResponseWriter, r *http.Request) {
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// listNamespacesHandler handles GET /clusters/{context}/namespaces
-func (h *HTTPHandler) listNamespacesHandler(w http.ResponseWriter, r *http.Request) {
+// cordonNodeHandler handles POST /clusters/{context}/nodes/{name}/cordon
+func (h *HTTPHandler) cordonNodeHandler(w http.ResponseWriter, r *http.Request) {
+	h.setNodeSchedulable(w, r, false)
+}
+
+// uncordonNodeHandler handles POST /clusters/{context}/nodes/{name}/uncordon
+func (h *HTTPHandler) uncordonNodeHandler(w http.ResponseWriter, r *http.Request) {
+	h.setNodeSchedulable(w, r, true)
+}
+
+// setNodeSchedulable cordons or uncordons the node addressed by the request
+func (h *HTTPHandler) setNodeSchedulable(w http.ResponseWriter, r *http.Request, schedulable bool) {
 	vars := mux.Vars(r)
 	context := vars["context"]
+	nodeName := vars["name"]
 
-	if context == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+	if context == "" || nodeName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and node name are required")
 		return
 	}
 
-	namespaces, err := h.namespacesController.ListNamespaces(r.Context(), context)
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACNodesMaintain, context, "")) {
+		return
+	}
+
+	var err error
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Node uncordoned",
+		Operation: "uncordon",
+		Resource:  nodeName,
+	}
+	if schedulable {
+		err = h.nodesController.UncordonNode(r.Context(), context, nodeName)
+	} else {
+		err = h.nodesController.CordonNode(r.Context(), context, nodeName)
+		response.Message = "Node cordoned"
+		response.Operation = "cordon"
+	}
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list namespaces: "+err.Error())
+		h.writeClusterError(w, nodeErrorStatus(err), "Failed to update node", err)
 		return
 	}
 
-	response := k8sAdapters.NamespacesToResponse(namespaces)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// scaleDeploymentHandler handles PUT /clusters/{context}/namespaces/{namespace}/deployments/{name}/scale
-func (h *HTTPHandler) scaleDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+// drainNodeHandler handles POST /clusters/{context}/nodes/{name}/drain
+// The node is cordoned and its pods are evicted in the background; poll the returned drain for progress.
+func (h *HTTPHandler) drainNodeHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
-	namespace := vars["namespace"]
-	deploymentName := vars["name"]
+	nodeName := vars["name"]
 
-	if context == "" || namespace == "" || deploymentName == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+	if context == "" || nodeName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and node name are required")
 		return
 	}
 
-	var req k8sWire.ScaleDeploymentRequest
-	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACNodesMaintain, context, "")) {
 		return
 	}
 
-	err := h.deploymentsController.ScaleDeployment(r.Context(), context, namespace, deploymentName, req.Replicas)
+	var req k8sWire.DrainNodeRequest
+	if r.ContentLength != 0 {
+		if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+			h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+			return
+		}
+	}
+
+	options := k8sModels.DrainOptions{
+		GracePeriodSeconds: req.GracePeriodSeconds,
+		TimeoutSeconds:     req.TimeoutSeconds,
+		DeleteEmptyDirData: req.DeleteEmptyDirData,
+		Force:              req.Force,
+	}
+	operation, err := h.nodesController.StartDrain(r.Context(), context, nodeName, h.userName(r), options)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to scale deployment: "+err.Error())
+		h.writeClusterError(w, nodeErrorStatus(err), "Failed to drain node", err)
 		return
 	}
 
-	response := k8sWire.OperationResponse{
-		Success:   true,
-		Message:   "Deployment scaled successfully",
-		Operation: "scale",
-		Resource:  namespace + "/" + deploymentName,
-	}
-	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+	h.responseAdapter.WriteJSON(w, http.StatusAccepted, k8sAdapters.DrainOperationToResponse(operation))
 }
 
-// restartDeploymentHandler handles POST /clusters/{context}/namespaces/{namespace}/deployments/{name}/restart
-func (h *HTTPHandler) restartDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+// listDrainsHandler handles GET /clusters/{context}/nodes/{name}/drains
+func (h *HTTPHandler) listDrainsHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
-	namespace := vars["namespace"]
-	deploymentName := vars["name"]
-
-	if context == "" || namespace == "" || deploymentName == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
-		return
-	}
+	nodeName := vars["name"]
 
-	err := h.deploymentsController.RestartDeployment(r.Context(), context, namespace, deploymentName)
+	drains, err := h.nodesController.ListDrains(context, nodeName)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to restart deployment: "+err.Error())
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	response := k8sWire.OperationResponse{
-		Success:   true,
-		Message:   "Deployment restart initiated",
-		Operation: "restart",
-		Resource:  namespace + "/" + deploymentName,
-	}
+	response := k8sAdapters.DrainOperationListToResponse(drains, nodeName)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// parseDeploymentFilter parses query parameters into DeploymentFilter
-func (h *HTTPHandler) parseDeploymentFilter(r *http.Request) *k8sModels.DeploymentFilter {
-	query := r.URL.Query()
-
-	filter := &k8sModels.DeploymentFilter{
-		Namespace:     query.Get("namespace"),
-		LabelSelector: query.Get("label_selector"),
-		ServiceName:   query.Get("service_name"),
-		Status:        query.Get("status"),
-	}
+// getDrainHandler handles GET /clusters/{context}/nodes/{name}/drains/{id}
+func (h *HTTPHandler) getDrainHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
 
-	if limit := query.Get("limit"); limit != "" {
-		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
-			filter.Limit = l
-		}
+	drain, err := h.nodesController.GetDrain(vars["context"], vars["name"], vars["id"])
+	if err != nil {
+		h.responseAdapter.WriteError(w, http.StatusNotFound, err.Error())
+		return
 	}
 
-	return filter
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.DrainOperationToResponse(drain))
 }
 
-// parsePodFilter parses query parameters into PodFilter
-func (h *HTTPHandler) parsePodFilter(r *http.Request) *k8sModels.PodFilter {
-	query := r.URL.Query()
-
-	filter := &k8sModels.PodFilter{
-		Namespace:     query.Get("namespace"),
-		LabelSelector: query.Get("label_selector"),
-		FieldSelector: query.Get("field_selector"),
-		PodName:       query.Get("pod_name"),
-		ContainerName: query.Get("container_name"),
-		Status:        query.Get("status"),
-		Node:          query.Get("node"),
-	}
-
-	if limit := query.Get("limit"); limit != "" {
-		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
-			filter.Limit = l
-		}
+// nodeErrorStatus maps a node maintenance failure to an HTTP status
+func nodeErrorStatus(err error) int {
+	switch {
+	case apierrors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, k8sLogic.ErrInvalidDrainOptions):
+		return http.StatusBadRequest
+	case errors.Is(err, k8sLogic.ErrDrainBlocked), errors.Is(err, controllers.ErrDrainInProgress):
+		return http.StatusConflict
+	case errors.Is(err, controllers.ErrDrainsStopped):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
 	}
-
-	return filter
 }
 
-// getClusterHealthHandler handles GET /clusters/{context}/health
-func (h *HTTPHandler) getClusterHealthHandler(w http.ResponseWriter, r *http.Request) {
+// listNamespacesHandler handles GET /clusters/{context}/namespaces
+func (h *HTTPHandler) listNamespacesHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 
@@ -300,21 +599,20 @@ func (h *HTTPHandler) getClusterHealthHandler(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// TODO: Implement cluster health aggregation from multiple controllers
-	// For now, return a basic health structure
-	health := &k8sModels.ClusterHealth{
-		Context:     context,
-		Status:      k8sModels.HealthStatus("healthy"),
-		LastUpdated: time.Now(),
+	namespaces, err := h.namespacesController.ListNamespaces(r.Context(), context)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list namespaces", err)
+		return
 	}
-	// no error path for the stubbed response above
+	namespaces = h.permissionChecker.FilterNamespaces(context, namespaces)
 
-	response := k8sAdapters.ClusterHealthToResponse(health)
+	response := k8sAdapters.NamespacesToResponse(namespaces)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// createNamespaceHandler handles POST /clusters/{context}/namespaces
-func (h *HTTPHandler) createNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+// getQuotaRankingHandler handles GET /clusters/{context}/quotas
+// Namespaces are ranked by their most utilized resource quota, most utilized first.
+func (h *HTTPHandler) getQuotaRankingHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 
@@ -323,304 +621,1597 @@ func (h *HTTPHandler) createNamespaceHandler(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	var req k8sWire.CreateNamespaceRequest
-	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+	ranking, err := h.namespacesController.GetQuotaRanking(r.Context(), context)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to rank namespace quotas", err)
 		return
 	}
 
-	namespace, err := h.namespacesController.CreateNamespace(r.Context(), context, req.Name)
-	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to create namespace: "+err.Error())
-		return
+	allowed := make([]k8sModels.NamespaceQuotaUsage, 0, len(ranking))
+	for _, usage := range ranking {
+		if h.permissionChecker.IsNamespaceAllowed(context, usage.Namespace) {
+			allowed = append(allowed, usage)
+		}
 	}
 
-	response := k8sAdapters.NamespaceToResponse(namespace)
-	h.responseAdapter.WriteCreated(w, "/clusters/"+context+"/namespaces/"+namespace.Name, response)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.QuotaRankingToResponse(allowed))
 }
 
-// getNamespaceHandler handles GET /clusters/{context}/namespaces/{name}
-func (h *HTTPHandler) getNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+// scaleDeploymentHandler handles PUT /clusters/{context}/namespaces/{namespace}/deployments/{name}/scale
+func (h *HTTPHandler) scaleDeploymentHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
-	name := vars["name"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
 
-	if context == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace name are required")
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
 		return
 	}
 
-	namespace, err := h.namespacesController.GetNamespace(r.Context(), context, name)
-	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusNotFound, "Namespace not found: "+err.Error())
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsScale, context, namespace)) {
 		return
 	}
 
-	response := k8sAdapters.NamespaceToResponse(namespace)
-	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
-}
-
-// deleteNamespaceHandler handles DELETE /clusters/{context}/namespaces/{name}
-func (h *HTTPHandler) deleteNamespaceHandler(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	context := vars["context"]
-	name := vars["name"]
-
-	if context == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace name are required")
+	var req k8sWire.ScaleDeploymentRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
 		return
 	}
 
-	err := h.namespacesController.DeleteNamespace(r.Context(), context, name)
+	warning, err := h.deploymentsController.ScaleDeployment(r.Context(), context, namespace, deploymentName, req.Replicas, req.Force)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to delete namespace: "+err.Error())
+		status := http.StatusInternalServerError
+		if errors.Is(err, k8sLogic.ErrManagedByAutoscaler) {
+			status = http.StatusConflict
+		}
+		h.writeClusterError(w, status, "Failed to scale deployment", err)
 		return
 	}
 
 	response := k8sWire.OperationResponse{
 		Success:   true,
-		Message:   "Namespace deleted successfully",
-		Operation: "delete",
-		Resource:  name,
+		Message:   "Deployment scaled successfully",
+		Operation: "scale",
+		Resource:  namespace + "/" + deploymentName,
+		Warning:   warning,
 	}
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// listDeploymentsHandler handles GET /clusters/{context}/deployments
-func (h *HTTPHandler) listDeploymentsHandler(w http.ResponseWriter, r *http.Request) {
+// getAutoscalerHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments/{name}/autoscaler
+func (h *HTTPHandler) getAutoscalerHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
 
-	if context == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
 		return
 	}
 
-	filter := h.parseDeploymentFilter(r)
-	deployments, err := h.deploymentsController.ListDeployments(r.Context(), context, filter)
+	autoscaler, err := h.deploymentsController.GetAutoscaler(r.Context(), context, namespace, deploymentName)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list deployments: "+err.Error())
+		h.writeClusterError(w, autoscalerErrorStatus(err), "Failed to get autoscaler", err)
 		return
 	}
 
-	response := k8sAdapters.DeploymentsToResponse(deployments.Deployments)
-	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.AutoscalerToResponse(autoscaler))
 }
 
-// listDeploymentsByNamespaceHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments
-func (h *HTTPHandler) listDeploymentsByNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+// setAutoscalerBoundsHandler handles PUT /clusters/{context}/namespaces/{namespace}/deployments/{name}/autoscaler
+func (h *HTTPHandler) setAutoscalerBoundsHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
+	deploymentName := vars["name"]
 
-	if context == "" || namespace == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
 		return
 	}
 
-	filter := h.parseDeploymentFilter(r)
-	filter.Namespace = namespace
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsScale, context, namespace)) {
+		return
+	}
 
-	deployments, err := h.deploymentsController.ListDeployments(r.Context(), context, filter)
+	var req k8sWire.SetAutoscalerBoundsRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	autoscaler, err := h.deploymentsController.SetAutoscalerBounds(r.Context(), context, namespace, deploymentName, req.MinReplicas, req.MaxReplicas)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list deployments: "+err.Error())
+		h.writeClusterError(w, autoscalerErrorStatus(err), "Failed to update autoscaler", err)
 		return
 	}
 
-	response := k8sAdapters.DeploymentsToResponse(deployments.Deployments)
-	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.AutoscalerToResponse(autoscaler))
 }
 
-// getDeploymentHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments/{name}
-func (h *HTTPHandler) getDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+// autoscalerErrorStatus maps an autoscaler failure to an HTTP status
+func autoscalerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, controllers.ErrAutoscalerNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, k8sLogic.ErrInvalidAutoscalerBounds):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// previewApplyHandler handles POST /clusters/{context}/namespaces/{namespace}/apply/preview
+// The change is dry-run on the server and diffed against the live object; the returned token confirms it.
+func (h *HTTPHandler) previewApplyHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
-	name := vars["name"]
 
-	if context == "" || namespace == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
 		return
 	}
 
-	deployment, err := h.deploymentsController.GetDeployment(r.Context(), context, namespace, name)
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACResourcesApply, context, namespace)) {
+		return
+	}
+
+	var req k8sWire.ApplyPreviewRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+	if (req.Manifest == "") == (req.Deployment == nil) {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Exactly one of manifest or deployment is required")
+		return
+	}
+
+	var plan *k8sModels.ApplyPlan
+	var err error
+	if req.Deployment != nil {
+		change := k8sAdapters.DeploymentChangeFromRequest(req.Deployment)
+		plan, err = h.applyController.PreviewDeploymentChange(r.Context(), context, namespace, h.userName(r), change)
+	} else {
+		plan, err = h.applyController.PreviewManifest(r.Context(), context, namespace, h.userName(r), req.Manifest)
+	}
+	if err != nil {
+		h.writeClusterError(w, applyErrorStatus(err), "Failed to preview change", err)
+		return
+	}
+
+	h.responseAdapter.WriteJSON(w, http.StatusOK, k8sAdapters.ApplyPlanToResponse(plan))
+}
+
+// applyHandler handles POST /clusters/{context}/namespaces/{namespace}/apply
+func (h *HTTPHandler) applyHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACResourcesApply, context, namespace)) {
+		return
+	}
+
+	var req k8sWire.ApplyConfirmRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	plan, err := h.applyController.Apply(r.Context(), context, namespace, h.userName(r), req.Token)
+	if err != nil {
+		h.writeClusterError(w, applyErrorStatus(err), "Failed to apply change", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Change applied successfully",
+		Operation: "apply",
+		Resource:  plan.ResourcePath(),
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// applyErrorStatus maps a preview or apply failure to an HTTP status
+func applyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, k8sLogic.ErrInvalidManifest), errors.Is(err, k8sLogic.ErrInvalidDeploymentChange):
+		return http.StatusBadRequest
+	case errors.Is(err, controllers.ErrApplyPlanNotFound), apierrors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, controllers.ErrApplyPlanStale), apierrors.IsConflict(err):
+		return http.StatusConflict
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		return http.StatusUnprocessableEntity
+	case apierrors.IsForbidden(err):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// restartDeploymentHandler handles POST /clusters/{context}/namespaces/{namespace}/deployments/{name}/restart
+func (h *HTTPHandler) restartDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
+
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsRestart, context, namespace)) {
+		return
+	}
+
+	err := h.deploymentsController.RestartDeployment(r.Context(), context, namespace, deploymentName)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to restart deployment", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Deployment restart initiated",
+		Operation: "restart",
+		Resource:  namespace + "/" + deploymentName,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getRolloutHistoryHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments/{name}/rollout/history
+func (h *HTTPHandler) getRolloutHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
+
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+		return
+	}
+
+	revisions, err := h.deploymentsController.GetRolloutHistory(r.Context(), context, namespace, deploymentName)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to get rollout history", err)
+		return
+	}
+
+	response := k8sAdapters.RolloutHistoryToResponse(namespace, deploymentName, revisions)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getRolloutStatusHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments/{name}/rollout/status
+func (h *HTTPHandler) getRolloutStatusHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
+
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+		return
+	}
+
+	status, err := h.deploymentsController.GetRolloutStatus(r.Context(), context, namespace, deploymentName)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Failed to get rollout status", err)
+		return
+	}
+
+	response := k8sAdapters.RolloutStatusToResponse(status)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// rollbackDeploymentHandler handles POST /clusters/{context}/namespaces/{namespace}/deployments/{name}/rollback
+func (h *HTTPHandler) rollbackDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	deploymentName := vars["name"]
+
+	if context == "" || namespace == "" || deploymentName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsRollback, context, namespace)) {
+		return
+	}
+
+	var req k8sWire.RollbackDeploymentRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	target, err := h.deploymentsController.RollbackDeployment(r.Context(), context, namespace, deploymentName, req.Revision)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, k8sLogic.ErrInvalidRollback) {
+			status = http.StatusBadRequest
+		}
+		h.writeClusterError(w, status, "Failed to roll back deployment", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Deployment rolled back to revision %d", target.Revision),
+		Operation: "rollback",
+		Resource:  namespace + "/" + deploymentName,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// parseDeploymentFilter parses query parameters into DeploymentFilter
+func (h *HTTPHandler) parseDeploymentFilter(r *http.Request) *k8sModels.DeploymentFilter {
+	query := r.URL.Query()
+
+	filter := &k8sModels.DeploymentFilter{
+		Namespace:     query.Get("namespace"),
+		LabelSelector: query.Get("label_selector"),
+		ServiceName:   query.Get("service_name"),
+		Status:        query.Get("status"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+			filter.Limit = l
+		}
+	}
+
+	return filter
+}
+
+// parsePodFilter parses query parameters into PodFilter
+func (h *HTTPHandler) parsePodFilter(r *http.Request) *k8sModels.PodFilter {
+	query := r.URL.Query()
+
+	filter := &k8sModels.PodFilter{
+		Namespace:     query.Get("namespace"),
+		LabelSelector: query.Get("label_selector"),
+		FieldSelector: query.Get("field_selector"),
+		PodName:       query.Get("pod_name"),
+		ContainerName: query.Get("container_name"),
+		Status:        query.Get("status"),
+		Node:          query.Get("node"),
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		if l, err := strconv.Atoi(limit); err == nil && l > 0 {
+			filter.Limit = l
+		}
+	}
+
+	return filter
+}
+
+// getClusterHealthHandler handles GET /clusters/{context}/health
+func (h *HTTPHandler) getClusterHealthHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	health, err := h.clustersController.GetClusterHealth(r.Context(), context)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to get cluster health", err)
+		return
+	}
+
+	response := k8sAdapters.ClusterHealthToResponse(health)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// createNamespaceHandler handles POST /clusters/{context}/namespaces
+func (h *HTTPHandler) createNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	var req k8sWire.CreateNamespaceRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACNamespacesManage, context, req.Name)) {
+		return
+	}
+
+	namespace, err := h.namespacesController.CreateNamespace(r.Context(), context, req.Name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to create namespace", err)
+		return
+	}
+
+	response := k8sAdapters.NamespaceToResponse(namespace)
+	h.responseAdapter.WriteCreated(w, "/clusters/"+context+"/namespaces/"+namespace.Name, response)
+}
+
+// getNamespaceHandler handles GET /clusters/{context}/namespaces/{name}
+func (h *HTTPHandler) getNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	name := vars["name"]
+
+	if context == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace name are required")
+		return
+	}
+
+	namespace, err := h.namespacesController.GetNamespace(r.Context(), context, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Namespace not found", err)
+		return
+	}
+
+	response := k8sAdapters.NamespaceToResponse(namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// deleteNamespaceHandler handles DELETE /clusters/{context}/namespaces/{name}
+func (h *HTTPHandler) deleteNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	name := vars["name"]
+
+	if context == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACNamespacesManage, context, name)) {
+		return
+	}
+
+	err := h.namespacesController.DeleteNamespace(r.Context(), context, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to delete namespace", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Namespace deleted successfully",
+		Operation: "delete",
+		Resource:  name,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listDeploymentsHandler handles GET /clusters/{context}/deployments
+func (h *HTTPHandler) listDeploymentsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseDeploymentFilter(r)
+	deployments, err := h.deploymentsController.ListDeployments(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list deployments", err)
+		return
+	}
+
+	response := k8sAdapters.DeploymentsToResponse(deployments.Deployments)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listDeploymentsByNamespaceHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments
+func (h *HTTPHandler) listDeploymentsByNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	filter := h.parseDeploymentFilter(r)
+	filter.Namespace = namespace
+
+	deployments, err := h.deploymentsController.ListDeployments(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list deployments", err)
+		return
+	}
+
+	response := k8sAdapters.DeploymentsToResponse(deployments.Deployments)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getDeploymentHandler handles GET /clusters/{context}/namespaces/{namespace}/deployments/{name}
+func (h *HTTPHandler) getDeploymentHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and deployment name are required")
+		return
+	}
+
+	deployment, err := h.deploymentsController.GetDeployment(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Deployment not found", err)
+		return
+	}
+
+	response := k8sAdapters.DeploymentToResponse(deployment)
+
+	// Recent warnings are best effort and must not hide the deployment itself
+	if warnings, err := h.eventsController.GetDeploymentWarnings(r.Context(), context, namespace, name); err == nil {
+		response.RecentEvents = k8sAdapters.EventsToResponse(warnings)
+	}
+	if services, err := h.servicesController.GetDeploymentServices(r.Context(), context, deployment); err == nil {
+		response.Services = k8sAdapters.ServiceReferencesToResponse(services)
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listPodsHandler handles GET /clusters/{context}/pods
+func (h *HTTPHandler) listPodsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parsePodFilter(r)
+	pods, err := h.podsController.ListPods(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list pods", err)
+		return
+	}
+
+	response := k8sAdapters.PodsToResponse(pods.Pods)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listPodsByNamespaceHandler handles GET /clusters/{context}/namespaces/{namespace}/pods
+func (h *HTTPHandler) listPodsByNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	filter := h.parsePodFilter(r)
+	filter.Namespace = namespace
+
+	pods, err := h.podsController.ListPods(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list pods", err)
+		return
+	}
+
+	response := k8sAdapters.PodsToResponse(pods.Pods)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getPodHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}
+func (h *HTTPHandler) getPodHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	pod, err := h.podsController.GetPod(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Pod not found", err)
+		return
+	}
+
+	response := k8sAdapters.PodToResponse(pod)
+
+	// Recent warnings are best effort and must not hide the pod itself
+	if warnings, err := h.eventsController.GetPodWarnings(r.Context(), context, namespace, name); err == nil {
+		response.RecentEvents = k8sAdapters.EventsToResponse(warnings)
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// deletePodHandler handles DELETE /clusters/{context}/namespaces/{namespace}/pods/{name}
+func (h *HTTPHandler) deletePodHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACPodsDelete, context, namespace)) {
+		return
+	}
+
+	err := h.podsController.DeletePod(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to delete pod", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Pod deleted successfully",
+		Operation: "delete",
+		Resource:  namespace + "/" + name,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getPodLogsHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}/logs
+func (h *HTTPHandler) getPodLogsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	query := r.URL.Query()
+	container := query.Get("container")
+	tailLines := query.Get("tailLines")
+
+	lines := int64(100) // default
+	if tailLines != "" {
+		if l, err := strconv.ParseInt(tailLines, 10, 64); err == nil && l > 0 {
+			lines = l
+		}
+	}
+
+	logFilter := &k8sModels.LogFilter{
+		PodName:       name,
+		Namespace:     namespace,
+		ContainerName: container,
+		TailLines:     lines,
+	}
+
+	logs, err := h.podsController.GetPodLogs(r.Context(), context, logFilter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to get pod logs", err)
+		return
+	}
+
+	// Convert logs to response format
+	logResponses := make([]k8sWire.ContainerLogResponse, len(logs))
+	for i, log := range logs {
+		logResponses[i] = k8sWire.ContainerLogResponse{
+			Timestamp: log.Timestamp,
+			Message:   log.Message,
+			Level:     log.Level,
+		}
+	}
+
+	response := k8sWire.PodLogsResponse{
+		PodName:       name,
+		Namespace:     namespace,
+		ContainerName: container,
+		Logs:          logResponses,
+		TotalLines:    len(logResponses),
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// streamPodLogsHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}/logs/stream
+// Logs are sent as Server-Sent Events until the container stops or the client disconnects.
+func (h *HTTPHandler) streamPodLogsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	query := r.URL.Query()
+	logFilter := &k8sModels.LogFilter{
+		PodName:       name,
+		Namespace:     namespace,
+		ContainerName: query.Get("container"),
+		Follow:        query.Get("follow") != "false", // Follow by default
+		Previous:      query.Get("previous") == "true",
+		Timestamps:    query.Get("timestamps") == "true",
+	}
+
+	if tailLines := query.Get("tailLines"); tailLines != "" {
+		l, err := strconv.ParseInt(tailLines, 10, 64)
+		if err != nil || l <= 0 {
+			h.responseAdapter.WriteError(w, http.StatusBadRequest, "tailLines must be a positive integer")
+			return
+		}
+		logFilter.TailLines = l
+	}
+	if sinceSeconds := query.Get("sinceSeconds"); sinceSeconds != "" {
+		s, err := strconv.ParseInt(sinceSeconds, 10, 64)
+		if err != nil || s <= 0 {
+			h.responseAdapter.WriteError(w, http.StatusBadRequest, "sinceSeconds must be a positive integer")
+			return
+		}
+		logFilter.SinceSeconds = s
+	}
+
+	stream, err := h.podsController.OpenPodLogStream(r.Context(), context, logFilter)
+	if err != nil {
+		h.writeClusterError(w, logStreamErrorStatus(err), "Failed to stream pod logs", err)
+		return
+	}
+	defer stream.Close()
+
+	controller := h.startEventStream(w)
+	if controller == nil {
+		return
+	}
+
+	err = h.podsController.ReadPodLogStream(r.Context(), stream, logFilter, func(log k8sModels.ContainerLog) error {
+		return h.writeEvent(w, controller, "log", k8sWire.ContainerLogResponse{
+			Timestamp: log.Timestamp,
+			Message:   log.Message,
+			Level:     log.Level,
+		})
+	})
+	if err != nil {
+		_ = h.writeEvent(w, controller, "error", map[string]string{"error": err.Error()})
+		return
+	}
+
+	_ = h.writeEvent(w, controller, "end", map[string]string{"container": logFilter.ContainerName})
+}
+
+// logStreamErrorStatus maps a failure to open a log stream to an HTTP status
+func logStreamErrorStatus(err error) int {
+	switch {
+	case apierrors.IsNotFound(err):
+		return http.StatusNotFound
+	case errors.Is(err, k8sLogic.ErrContainerNotFound), errors.Is(err, k8sLogic.ErrContainerRequired):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// execPodHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}/exec
+// The request is upgraded to a WebSocket carrying stdin, resize and output messages.
+// Browsers authenticate by offering the access token as a "bearer.<token>" subprotocol.
+func (h *HTTPHandler) execPodHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACPodsExec, context, namespace)) {
+		return
+	}
+
+	query := r.URL.Query()
+	session := &k8sModels.ExecSession{
+		Context:   context,
+		Namespace: namespace,
+		Pod:       name,
+		Container: query.Get("container"),
+		Command:   query["command"],
+		TTY:       query.Get("tty") != "false", // Interactive terminal by default
+		User:      h.userName(r),
+	}
+
+	conn, err := h.execUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return // The upgrader already replied with an HTTP error
+	}
+
+	h.runExecSession(r, session, k8sAdapters.NewExecTerminal(conn))
+}
+
+// runExecSession bridges a terminal to the session until either side ends it
+func (h *HTTPHandler) runExecSession(r *http.Request, session *k8sModels.ExecSession, terminal *k8sAdapters.ExecTerminal) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	go terminal.ReadMessages(cancel)
+	go terminal.KeepAlive()
+
+	err := h.execController.Exec(ctx, session, terminal.Streams())
+	terminal.Close(session, err)
+}
+
+// checkExecOrigin allows exec WebSockets from the API host itself and the configured origin
+func (h *HTTPHandler) checkExecOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true // Not a browser request
+	}
+	if h.allowedOrigin != "" && strings.EqualFold(strings.TrimSuffix(h.allowedOrigin, "/"), origin) {
+		return true
+	}
+
+	originURL, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(originURL.Host, r.Host)
+}
+
+// listExecSessionsHandler handles GET /clusters/{context}/namespaces/{namespace}/exec/sessions
+func (h *HTTPHandler) listExecSessionsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACPodsExec, context, namespace)) {
+		return
+	}
+
+	sessions, err := h.execController.ListSessions(context, namespace)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list exec sessions", err)
+		return
+	}
+
+	response := k8sAdapters.ExecSessionListToResponse(sessions, namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listNamespaceEventsHandler handles GET /clusters/{context}/namespaces/{namespace}/events
+func (h *HTTPHandler) listNamespaceEventsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	events, err := h.eventsController.GetNamespaceEvents(r.Context(), context, namespace, r.URL.Query().Get("type"))
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list events", err)
+		return
+	}
+
+	response := k8sAdapters.EventsToResponse(events)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listResourceEventsHandler handles GET /clusters/{context}/namespaces/{namespace}/{resourceType}/{name}/events
+func (h *HTTPHandler) listResourceEventsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	resourceType := vars["resourceType"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || resourceType == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, resource type, and name are required")
+		return
+	}
+
+	events, err := h.eventsController.GetResourceEvents(r.Context(), context, namespace, resourceType, name, r.URL.Query().Get("type"))
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list events", err)
+		return
+	}
+
+	response := k8sAdapters.EventsToResponse(events)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// watchEventsHandler handles GET /clusters/{context}/namespaces/{namespace}/events/watch
+// New events are sent as Server-Sent Events until the client disconnects.
+func (h *HTTPHandler) watchEventsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	events, err := h.eventsController.WatchEvents(r.Context(), context, namespace)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusNotFound, "Deployment not found: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to watch events", err)
+		return
+	}
+
+	controller := h.startEventStream(w)
+	if controller == nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			// Comment lines keep idle connections open through proxies
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil || controller.Flush() != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				_ = h.writeEvent(w, controller, "end", map[string]string{"namespace": namespace})
+				return
+			}
+			if err := h.writeEvent(w, controller, "event", k8sAdapters.EventToResponse(event)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// startEventStream writes Server-Sent Events headers, returning nil if the response cannot be streamed
+func (h *HTTPHandler) startEventStream(w http.ResponseWriter) *http.ResponseController {
+	// Streams outlive the server write timeout, so lift the deadline for this response
+	controller := http.NewResponseController(w)
+	_ = controller.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return nil
+	}
+	return controller
+}
+
+// writeEvent writes a single Server-Sent Event with a JSON payload and flushes it
+func (h *HTTPHandler) writeEvent(w http.ResponseWriter, controller *http.ResponseController, event string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+	return controller.Flush()
+}
+
+// getMetricsAvailabilityHandler handles GET /clusters/{context}/metrics
+func (h *HTTPHandler) getMetricsAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	available, err := h.metricsController.IsMetricsServerAvailable(r.Context(), context)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to check metrics server", err)
+		return
+	}
+
+	response := k8sWire.MetricsAvailabilityResponse{
+		Context:   context,
+		Available: available,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getNodeMetricsHandler handles GET /clusters/{context}/nodes/{name}/metrics
+func (h *HTTPHandler) getNodeMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	nodeName := vars["name"]
+
+	if context == "" || nodeName == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and node name are required")
+		return
+	}
+
+	metrics, err := h.metricsController.GetNodeMetrics(r.Context(), context, nodeName)
+	if err != nil {
+		h.writeMetricsError(w, "Failed to get node metrics", err)
+		return
+	}
+
+	response := k8sAdapters.NodeResourcesToResponse(*metrics)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getNamespaceMetricsHandler handles GET /clusters/{context}/namespaces/{namespace}/metrics
+func (h *HTTPHandler) getNamespaceMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+
+	if context == "" || namespace == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+		return
+	}
+
+	metrics, err := h.metricsController.GetNamespaceMetrics(r.Context(), context, namespace)
+	if err != nil {
+		h.writeMetricsError(w, "Failed to get namespace metrics", err)
+		return
+	}
+
+	response := k8sAdapters.NamespaceMetricsToResponse(metrics)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getPodMetricsHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}/metrics
+func (h *HTTPHandler) getPodMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		return
+	}
+
+	metrics, err := h.metricsController.GetPodMetrics(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeMetricsError(w, "Failed to get pod metrics", err)
+		return
+	}
+
+	response := k8sAdapters.PodMetricsToResponse(metrics)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// writeMetricsError writes 503 when metrics-server is missing and 500 otherwise
+func (h *HTTPHandler) writeMetricsError(w http.ResponseWriter, message string, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, kubernetes.ErrMetricsUnavailable) {
+		status = http.StatusServiceUnavailable
+	}
+	h.writeClusterError(w, status, message, err)
+}
+
+// parseWorkloadFilter parses the namespace route variable and query parameters into WorkloadFilter
+func (h *HTTPHandler) parseWorkloadFilter(r *http.Request) *k8sModels.WorkloadFilter {
+	return &k8sModels.WorkloadFilter{
+		Namespace:     mux.Vars(r)["namespace"],
+		LabelSelector: r.URL.Query().Get("label_selector"),
+	}
+}
+
+// listStatefulSetsHandler handles GET /clusters/{context}[/namespaces/{namespace}]/statefulsets
+func (h *HTTPHandler) listStatefulSetsHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseWorkloadFilter(r)
+	statefulSets, err := h.statefulSetsController.ListStatefulSets(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list stateful sets", err)
+		return
+	}
+
+	response := k8sAdapters.StatefulSetListToResponse(statefulSets, filter.Namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getStatefulSetHandler handles GET /clusters/{context}/namespaces/{namespace}/statefulsets/{name}
+func (h *HTTPHandler) getStatefulSetHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and stateful set name are required")
+		return
+	}
+
+	statefulSet, err := h.statefulSetsController.GetStatefulSet(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Stateful set not found", err)
+		return
+	}
+
+	response := k8sAdapters.StatefulSetToResponse(statefulSet)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// scaleStatefulSetHandler handles PUT /clusters/{context}/namespaces/{namespace}/statefulsets/{name}/scale
+func (h *HTTPHandler) scaleStatefulSetHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and stateful set name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsScale, context, namespace)) {
+		return
+	}
+
+	var req k8sWire.ScaleDeploymentRequest
+	if err := h.requestAdapter.ParseJSON(r, &req); err != nil {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Invalid request format: "+err.Error())
 		return
 	}
 
-	response := k8sAdapters.DeploymentToResponse(deployment)
+	if err := h.statefulSetsController.ScaleStatefulSet(r.Context(), context, namespace, name, req.Replicas); err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to scale stateful set", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Stateful set scaled successfully",
+		Operation: "scale",
+		Resource:  namespace + "/" + name,
+	}
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// listPodsHandler handles GET /clusters/{context}/pods
-func (h *HTTPHandler) listPodsHandler(w http.ResponseWriter, r *http.Request) {
+// restartStatefulSetHandler handles POST /clusters/{context}/namespaces/{namespace}/statefulsets/{name}/restart
+func (h *HTTPHandler) restartStatefulSetHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and stateful set name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsRestart, context, namespace)) {
+		return
+	}
+
+	if err := h.statefulSetsController.RestartStatefulSet(r.Context(), context, namespace, name); err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to restart stateful set", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Stateful set restart initiated",
+		Operation: "restart",
+		Resource:  namespace + "/" + name,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
 
+// listDaemonSetsHandler handles GET /clusters/{context}[/namespaces/{namespace}]/daemonsets
+func (h *HTTPHandler) listDaemonSetsHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
 	if context == "" {
 		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
 		return
 	}
 
-	filter := h.parsePodFilter(r)
-	pods, err := h.podsController.ListPods(r.Context(), context, filter)
+	filter := h.parseWorkloadFilter(r)
+	daemonSets, err := h.daemonSetsController.ListDaemonSets(r.Context(), context, filter)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list pods: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list daemon sets", err)
 		return
 	}
 
-	response := k8sAdapters.PodsToResponse(pods.Pods)
+	response := k8sAdapters.DaemonSetListToResponse(daemonSets, filter.Namespace)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// listPodsByNamespaceHandler handles GET /clusters/{context}/namespaces/{namespace}/pods
-func (h *HTTPHandler) listPodsByNamespaceHandler(w http.ResponseWriter, r *http.Request) {
+// getDaemonSetHandler handles GET /clusters/{context}/namespaces/{namespace}/daemonsets/{name}
+func (h *HTTPHandler) getDaemonSetHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
+	name := vars["name"]
 
-	if context == "" || namespace == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context and namespace are required")
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and daemon set name are required")
 		return
 	}
 
-	filter := h.parsePodFilter(r)
-	filter.Namespace = namespace
+	daemonSet, err := h.daemonSetsController.GetDaemonSet(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Daemon set not found", err)
+		return
+	}
 
-	pods, err := h.podsController.ListPods(r.Context(), context, filter)
+	response := k8sAdapters.DaemonSetToResponse(daemonSet)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// restartDaemonSetHandler handles POST /clusters/{context}/namespaces/{namespace}/daemonsets/{name}/restart
+func (h *HTTPHandler) restartDaemonSetHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and daemon set name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACDeploymentsRestart, context, namespace)) {
+		return
+	}
+
+	if err := h.daemonSetsController.RestartDaemonSet(r.Context(), context, namespace, name); err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to restart daemon set", err)
+		return
+	}
+
+	response := k8sWire.OperationResponse{
+		Success:   true,
+		Message:   "Daemon set restart initiated",
+		Operation: "restart",
+		Resource:  namespace + "/" + name,
+	}
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listJobsHandler handles GET /clusters/{context}[/namespaces/{namespace}]/jobs
+func (h *HTTPHandler) listJobsHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseWorkloadFilter(r)
+	jobs, err := h.jobsController.ListJobs(r.Context(), context, filter)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to list pods: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list jobs", err)
 		return
 	}
 
-	response := k8sAdapters.PodsToResponse(pods.Pods)
+	response := k8sAdapters.JobListToResponse(jobs, filter.Namespace)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// getPodHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}
-func (h *HTTPHandler) getPodHandler(w http.ResponseWriter, r *http.Request) {
+// getJobHandler handles GET /clusters/{context}/namespaces/{namespace}/jobs/{name}
+func (h *HTTPHandler) getJobHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
 	name := vars["name"]
 
 	if context == "" || namespace == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and job name are required")
 		return
 	}
 
-	pod, err := h.podsController.GetPod(r.Context(), context, namespace, name)
+	job, err := h.jobsController.GetJob(r.Context(), context, namespace, name)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusNotFound, "Pod not found: "+err.Error())
+		h.writeClusterError(w, http.StatusNotFound, "Job not found", err)
 		return
 	}
 
-	response := k8sAdapters.PodToResponse(pod)
+	response := k8sAdapters.JobToResponse(job)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// deletePodHandler handles DELETE /clusters/{context}/namespaces/{namespace}/pods/{name}
-func (h *HTTPHandler) deletePodHandler(w http.ResponseWriter, r *http.Request) {
+// listCronJobsHandler handles GET /clusters/{context}[/namespaces/{namespace}]/cronjobs
+func (h *HTTPHandler) listCronJobsHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseWorkloadFilter(r)
+	cronJobs, err := h.jobsController.ListCronJobs(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list cron jobs", err)
+		return
+	}
+
+	response := k8sAdapters.CronJobListToResponse(cronJobs, filter.Namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getCronJobHandler handles GET /clusters/{context}/namespaces/{namespace}/cronjobs/{name}
+func (h *HTTPHandler) getCronJobHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
 	name := vars["name"]
 
 	if context == "" || namespace == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and cron job name are required")
 		return
 	}
 
-	err := h.podsController.DeletePod(r.Context(), context, namespace, name)
+	cronJob, err := h.jobsController.GetCronJob(r.Context(), context, namespace, name)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to delete pod: "+err.Error())
+		h.writeClusterError(w, http.StatusNotFound, "Cron job not found", err)
+		return
+	}
+
+	response := k8sAdapters.CronJobToResponse(cronJob)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// suspendCronJobHandler handles POST /clusters/{context}/namespaces/{namespace}/cronjobs/{name}/suspend
+func (h *HTTPHandler) suspendCronJobHandler(w http.ResponseWriter, r *http.Request) {
+	h.setCronJobSuspend(w, r, true)
+}
+
+// resumeCronJobHandler handles POST /clusters/{context}/namespaces/{namespace}/cronjobs/{name}/resume
+func (h *HTTPHandler) resumeCronJobHandler(w http.ResponseWriter, r *http.Request) {
+	h.setCronJobSuspend(w, r, false)
+}
+
+// setCronJobSuspend suspends or resumes the cron job addressed by the request
+func (h *HTTPHandler) setCronJobSuspend(w http.ResponseWriter, r *http.Request, suspend bool) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and cron job name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACCronJobsManage, context, namespace)) {
+		return
+	}
+
+	if err := h.jobsController.SuspendCronJob(r.Context(), context, namespace, name, suspend); err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to update cron job", err)
 		return
 	}
 
 	response := k8sWire.OperationResponse{
 		Success:   true,
-		Message:   "Pod deleted successfully",
-		Operation: "delete",
+		Message:   "Cron job resumed",
+		Operation: "resume",
 		Resource:  namespace + "/" + name,
 	}
+	if suspend {
+		response.Message = "Cron job suspended"
+		response.Operation = "suspend"
+	}
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// getPodLogsHandler handles GET /clusters/{context}/namespaces/{namespace}/pods/{name}/logs
-func (h *HTTPHandler) getPodLogsHandler(w http.ResponseWriter, r *http.Request) {
+// triggerCronJobHandler handles POST /clusters/{context}/namespaces/{namespace}/cronjobs/{name}/trigger
+func (h *HTTPHandler) triggerCronJobHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	context := vars["context"]
 	namespace := vars["namespace"]
 	name := vars["name"]
 
 	if context == "" || namespace == "" || name == "" {
-		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and pod name are required")
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and cron job name are required")
 		return
 	}
 
-	query := r.URL.Query()
-	container := query.Get("container")
-	tailLines := query.Get("tailLines")
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACCronJobsManage, context, namespace)) {
+		return
+	}
 
-	lines := int64(100) // default
-	if tailLines != "" {
-		if l, err := strconv.ParseInt(tailLines, 10, 64); err == nil && l > 0 {
-			lines = l
-		}
+	job, err := h.jobsController.TriggerCronJob(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to trigger cron job", err)
+		return
 	}
 
-	logFilter := &k8sModels.LogFilter{
-		PodName:       name,
-		Namespace:     namespace,
-		ContainerName: container,
-		TailLines:     lines,
+	response := k8sAdapters.JobToResponse(job)
+	h.responseAdapter.WriteCreated(w, "/clusters/"+context+"/namespaces/"+namespace+"/jobs/"+job.Name, response)
+}
+
+// listServicesHandler handles GET /clusters/{context}[/namespaces/{namespace}]/services
+func (h *HTTPHandler) listServicesHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
 	}
 
-	logs, err := h.podsController.GetPodLogs(r.Context(), context, logFilter)
+	filter := h.parseWorkloadFilter(r)
+	services, err := h.servicesController.ListServices(r.Context(), context, filter)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to get pod logs: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list services", err)
 		return
 	}
 
-	// Convert logs to response format
-	logResponses := make([]k8sWire.ContainerLogResponse, len(logs))
-	for i, log := range logs {
-		logResponses[i] = k8sWire.ContainerLogResponse{
-			Timestamp: log.Timestamp,
-			Message:   log.Message,
-			Level:     log.Level,
-		}
+	response := k8sAdapters.ServiceListToResponse(services, filter.Namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getServiceHandler handles GET /clusters/{context}/namespaces/{namespace}/services/{name}
+func (h *HTTPHandler) getServiceHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and service name are required")
+		return
 	}
 
-	response := k8sWire.PodLogsResponse{
-		PodName:       name,
-		Namespace:     namespace,
-		ContainerName: container,
-		Logs:          logResponses,
-		TotalLines:    len(logResponses),
+	service, err := h.servicesController.GetService(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Service not found", err)
+		return
 	}
+
+	response := k8sAdapters.ServiceToResponse(service)
 	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
 }
 
-// listClustersHandler handles GET /clusters
-func (h *HTTPHandler) listClustersHandler(w http.ResponseWriter, r *http.Request) {
-	// Build cluster list from injected client (single configured context for now)
-	version, err := h.k8sClient.GetServerVersion(r.Context())
+// listIngressesHandler handles GET /clusters/{context}[/namespaces/{namespace}]/ingresses
+func (h *HTTPHandler) listIngressesHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseWorkloadFilter(r)
+	ingresses, err := h.ingressesController.ListIngresses(r.Context(), context, filter)
 	if err != nil {
-		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to get server version: "+err.Error())
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list ingresses", err)
 		return
 	}
 
-	currentContext := h.k8sClient.GetContext()
+	response := k8sAdapters.IngressListToResponse(ingresses, filter.Namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
 
-	clusters := []k8sModels.Cluster{
-		{
-			Name:    currentContext,
-			Context: currentContext,
-			Version: version,
-			Status:  k8sModels.ClusterStatusConnected,
-		},
+// getIngressHandler handles GET /clusters/{context}/namespaces/{namespace}/ingresses/{name}
+func (h *HTTPHandler) getIngressHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and ingress name are required")
+		return
+	}
+
+	ingress, err := h.ingressesController.GetIngress(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Ingress not found", err)
+		return
+	}
+
+	response := k8sAdapters.IngressToResponse(ingress)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listConfigMapsHandler handles GET /clusters/{context}[/namespaces/{namespace}]/configmaps
+func (h *HTTPHandler) listConfigMapsHandler(w http.ResponseWriter, r *http.Request) {
+	context := mux.Vars(r)["context"]
+	if context == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context is required")
+		return
+	}
+
+	filter := h.parseWorkloadFilter(r)
+	configMaps, err := h.configMapsController.ListConfigMaps(r.Context(), context, filter)
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list config maps", err)
+		return
+	}
+
+	response := k8sAdapters.ConfigMapListToResponse(configMaps, filter.Namespace)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getConfigMapHandler handles GET /clusters/{context}/namespaces/{namespace}/configmaps/{name}
+func (h *HTTPHandler) getConfigMapHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and config map name are required")
+		return
+	}
+
+	configMap, err := h.configMapsController.GetConfigMap(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Config map not found", err)
+		return
+	}
+
+	response := k8sAdapters.ConfigMapToResponse(configMap)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// getConfigMapDataHandler handles GET /clusters/{context}/namespaces/{namespace}/configmaps/{name}/data
+func (h *HTTPHandler) getConfigMapDataHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	context := vars["context"]
+	namespace := vars["namespace"]
+	name := vars["name"]
+
+	if context == "" || namespace == "" || name == "" {
+		h.responseAdapter.WriteError(w, http.StatusBadRequest, "Context, namespace, and config map name are required")
+		return
+	}
+
+	if h.writePermissionError(w, h.permissionChecker.Authorize(h.userData(r), k8sModels.RBACConfigMapsValues, context, namespace)) {
+		return
+	}
+
+	configMap, err := h.configMapsController.GetConfigMapValues(r.Context(), context, namespace, name)
+	if err != nil {
+		h.writeClusterError(w, http.StatusNotFound, "Config map not found", err)
+		return
+	}
+
+	response := k8sAdapters.ConfigMapToResponse(configMap)
+	h.responseAdapter.WriteJSON(w, http.StatusOK, response)
+}
+
+// listClustersHandler handles GET /clusters
+func (h *HTTPHandler) listClustersHandler(w http.ResponseWriter, r *http.Request) {
+	clusters, err := h.clustersController.ListClusters(r.Context())
+	if err != nil {
+		h.writeClusterError(w, http.StatusInternalServerError, "Failed to list clusters", err)
+		return
 	}
 
 	response := k8sAdapters.ClusterListToResponse(clusters)
@@ -0,0 +1,48 @@
+package kubernetes
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// applyFieldManager identifies dash-ops as the owner of fields it sets through server-side apply
+const applyFieldManager = "dash-ops"
+
+// ResolveResource maps an apiVersion and kind to its API resource and reports whether it is namespaced.
+// Discovery is cached, so an unknown kind resets the cache once in case it was installed recently.
+func (kc *KubernetesClient) ResolveResource(apiVersion, kind string) (schema.GroupVersionResource, bool, error) {
+	gvk := schema.FromAPIVersionAndKind(apiVersion, kind)
+
+	mapping, err := kc.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		kc.restMapper.Reset()
+		mapping, err = kc.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+
+	return mapping.Resource, mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+// GetResourceLive gets any namespaced object from the API server, bypassing the informer cache
+func (kc *KubernetesClient) GetResourceLive(ctx context.Context, resource schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	return kc.dynamicClient.Resource(resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ApplyResource server-side applies a namespaced object, taking ownership of conflicting fields.
+// With dryRun set the API server runs admission and defaulting but persists nothing.
+func (kc *KubernetesClient) ApplyResource(ctx context.Context, resource schema.GroupVersionResource, namespace string, object *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error) {
+	options := metav1.ApplyOptions{
+		FieldManager: applyFieldManager,
+		Force:        true,
+	}
+	if dryRun {
+		options.DryRun = []string{metav1.DryRunAll}
+	}
+	return kc.dynamicClient.Resource(resource).Namespace(namespace).Apply(ctx, object.GetName(), object, options)
+}
@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// AuthMode represents how the client authenticates against the API server
+type AuthMode string
+
+const (
+	AuthModeKubeconfig AuthMode = "kubeconfig"
+	AuthModeInCluster  AuthMode = "in_cluster"
+	AuthModeToken      AuthMode = "token"
+	AuthModeExec       AuthMode = "exec"
+)
+
+// ExecCredentialConfig represents an exec credential plugin run to obtain API server credentials
+type ExecCredentialConfig struct {
+	Command    string
+	Args       []string
+	Env        map[string]string
+	APIVersion string
+}
+
+// buildRestConfig builds the REST config of a cluster for its auth mode
+func buildRestConfig(config *KubernetesConfig) (*rest.Config, error) {
+	switch config.AuthMode {
+	case AuthModeInCluster:
+		restConfig, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+		}
+		return restConfig, nil
+	case AuthModeKubeconfig:
+		restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: config.Kubeconfig},
+			&clientcmd.ConfigOverrides{CurrentContext: config.Context},
+		).ClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build config from kubeconfig: %w", err)
+		}
+		return restConfig, nil
+	case AuthModeToken, AuthModeExec:
+		return buildDirectRestConfig(config), nil
+	default:
+		return nil, fmt.Errorf("unknown auth mode %q", config.AuthMode)
+	}
+}
+
+// buildDirectRestConfig builds a REST config from a server address and credentials given without a kubeconfig
+func buildDirectRestConfig(config *KubernetesConfig) *rest.Config {
+	restConfig := &rest.Config{
+		Host: config.Server,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAFile:   config.CAFile,
+			CAData:   config.CAData,
+			Insecure: config.InsecureSkipTLSVerify,
+		},
+	}
+
+	if config.AuthMode == AuthModeToken {
+		restConfig.BearerToken = config.BearerToken
+		restConfig.BearerTokenFile = config.BearerTokenFile // Re-read periodically, so rotated tokens are picked up
+		return restConfig
+	}
+
+	names := make([]string, 0, len(config.Exec.Env))
+	for name := range config.Exec.Env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	env := make([]clientcmdapi.ExecEnvVar, 0, len(names))
+	for _, name := range names {
+		env = append(env, clientcmdapi.ExecEnvVar{Name: name, Value: config.Exec.Env[name]})
+	}
+
+	restConfig.ExecProvider = &clientcmdapi.ExecConfig{
+		Command:         config.Exec.Command,
+		Args:            config.Exec.Args,
+		Env:             env,
+		APIVersion:      config.Exec.APIVersion,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+	return restConfig
+}
@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrClusterNotFound is returned when a context is not configured
+var ErrClusterNotFound = errors.New("cluster not found")
+
+// ClusterEntry represents a configured cluster and its client
+type ClusterEntry struct {
+	Name          string
+	Context       string
+	AuthMode      AuthMode
+	Impersonation bool // Calls are made on behalf of the signed-in user
+	Client        *KubernetesClient
+	Err           error
+}
+
+// ClientRegistry keeps one Kubernetes client per configured cluster context
+type ClientRegistry struct {
+	mu       sync.RWMutex
+	entries  map[string]*ClusterEntry
+	contexts []string
+}
+
+// NewClientRegistry creates a new empty client registry
+func NewClientRegistry() *ClientRegistry {
+	return &ClientRegistry{
+		entries: make(map[string]*ClusterEntry),
+	}
+}
+
+// Register creates a client for the given config and stores it under its context.
+// A client that fails to build is still registered so its status can be reported.
+func (r *ClientRegistry) Register(config *KubernetesConfig) error {
+	if config == nil {
+		return fmt.Errorf("cluster config cannot be nil")
+	}
+
+	contextName := ContextKey(config)
+	if contextName == "" {
+		return fmt.Errorf("cluster name or context is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.entries[contextName]; exists {
+		return fmt.Errorf("cluster context %s is configured more than once", contextName)
+	}
+
+	name := config.Name
+	if name == "" {
+		name = contextName
+	}
+
+	entry := &ClusterEntry{
+		Name:    name,
+		Context: contextName,
+	}
+	client, err := NewKubernetesClient(config)
+	if err != nil {
+		entry.Err = err
+	} else {
+		entry.Client = client
+	}
+	entry.AuthMode = config.AuthMode // Resolved by the client even when it fails to build
+	entry.Impersonation = config.Impersonation != nil
+
+	r.entries[contextName] = entry
+	r.contexts = append(r.contexts, contextName)
+
+	return nil
+}
+
+// GetClient returns the client registered for a context
+func (r *ClientRegistry) GetClient(context string) (*KubernetesClient, error) {
+	entry, err := r.GetEntry(context)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Client == nil {
+		return nil, fmt.Errorf("cluster %s is not available: %w", context, entry.Err)
+	}
+	return entry.Client, nil
+}
+
+// GetEntry returns the registry entry for a context
+func (r *ClientRegistry) GetEntry(context string) (*ClusterEntry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, exists := r.entries[context]
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, context)
+	}
+	return entry, nil
+}
+
+// HasContext checks if a context is configured
+func (r *ClientRegistry) HasContext(context string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, exists := r.entries[context]
+	return exists
+}
+
+// ListEntries returns all registered clusters in configuration order
+func (r *ClientRegistry) ListEntries() []ClusterEntry {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := make([]ClusterEntry, 0, len(r.contexts))
+	for _, contextName := range r.contexts {
+		entries = append(entries, *r.entries[contextName])
+	}
+	return entries
+}
+
+// ListContexts returns all registered contexts in configuration order
+func (r *ClientRegistry) ListContexts() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	contexts := make([]string, len(r.contexts))
+	copy(contexts, r.contexts)
+	return contexts
+}
+
+// Close releases the resources held by every registered client
+func (r *ClientRegistry) Close() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, entry := range r.entries {
+		if entry.Client != nil {
+			entry.Client.Close()
+		}
+	}
+}
+
+// ContextKey returns the key used to route requests to a cluster config
+func ContextKey(config *KubernetesConfig) string {
+	if config.Context != "" {
+		return config.Context
+	}
+	return config.Name
+}
@@ -0,0 +1,83 @@
+package kubernetes
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRegistry_Register_WithInvalidKubeconfig_KeepsEntryWithError(t *testing.T) {
+	// Arrange
+	registry := NewClientRegistry()
+
+	// Act
+	err := registry.Register(&KubernetesConfig{
+		Name:       "staging",
+		Kubeconfig: "/nonexistent/kubeconfig",
+		Context:    "staging-context",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, registry.HasContext("staging-context"))
+	entry, err := registry.GetEntry("staging-context")
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", entry.Name)
+	assert.Error(t, entry.Err)
+	_, err = registry.GetClient("staging-context")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "cluster staging-context is not available")
+}
+
+func TestClientRegistry_Register_WithDuplicateContext_ReturnsError(t *testing.T) {
+	// Arrange
+	registry := NewClientRegistry()
+	config := &KubernetesConfig{Name: "prod", Kubeconfig: "/nonexistent/kubeconfig", Context: "prod"}
+	_ = registry.Register(config)
+
+	// Act
+	err := registry.Register(config)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "configured more than once")
+}
+
+func TestClientRegistry_GetClient_WithUnknownContext_ReturnsNotFound(t *testing.T) {
+	// Arrange
+	registry := NewClientRegistry()
+
+	// Act
+	client, err := registry.GetClient("unknown")
+
+	// Assert
+	assert.Nil(t, client)
+	assert.True(t, errors.Is(err, ErrClusterNotFound))
+}
+
+func TestClientRegistry_ListContexts_KeepsConfigurationOrder(t *testing.T) {
+	// Arrange
+	registry := NewClientRegistry()
+	_ = registry.Register(&KubernetesConfig{Kubeconfig: "/nonexistent/kubeconfig", Context: "staging"})
+	_ = registry.Register(&KubernetesConfig{Kubeconfig: "/nonexistent/kubeconfig", Context: "prod"})
+	_ = registry.Register(&KubernetesConfig{Name: "in-cluster", Kubeconfig: "/nonexistent/kubeconfig"})
+
+	// Act
+	contexts := registry.ListContexts()
+
+	// Assert
+	assert.Equal(t, []string{"staging", "prod", "in-cluster"}, contexts)
+}
+
+func TestClientRegistry_Close_WithUnavailableClient_DoesNotPanic(t *testing.T) {
+	// Arrange
+	registry := NewClientRegistry()
+	_ = registry.Register(&KubernetesConfig{Name: "prod", Kubeconfig: "/nonexistent/kubeconfig", Context: "prod"})
+
+	// Act
+	closeRegistry := func() { registry.Close() }
+
+	// Assert
+	assert.NotPanics(t, closeRegistry)
+}
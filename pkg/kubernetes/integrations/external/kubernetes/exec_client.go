@@ -0,0 +1,39 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// ExecPod runs a command in a pod container, bridging the given streams until it exits.
+// The WebSocket protocol is tried first, falling back to SPDY for older API servers.
+func (kc *KubernetesClient) ExecPod(ctx context.Context, namespace, name string, options *corev1.PodExecOptions, streams remotecommand.StreamOptions) error {
+	request := kc.clientSet.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(options, scheme.ParameterCodec)
+
+	websocketExecutor, err := remotecommand.NewWebSocketExecutor(kc.restConfig, "GET", request.URL().String())
+	if err != nil {
+		return fmt.Errorf("failed to create websocket executor: %w", err)
+	}
+	spdyExecutor, err := remotecommand.NewSPDYExecutor(kc.restConfig, "POST", request.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create spdy executor: %w", err)
+	}
+	executor, err := remotecommand.NewFallbackExecutor(websocketExecutor, spdyExecutor, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create exec executor: %w", err)
+	}
+
+	return executor.StreamWithContext(ctx, streams)
+}
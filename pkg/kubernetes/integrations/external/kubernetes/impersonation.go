@@ -0,0 +1,72 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/transport"
+)
+
+// ErrIdentityRequired is returned when a cluster impersonates users but a call carries no user
+var ErrIdentityRequired = errors.New("impersonation requires an authenticated user")
+
+// ImpersonationConfig represents how the user of each call is impersonated
+type ImpersonationConfig struct {
+	UserPrefix  string
+	GroupPrefix string
+}
+
+// Identity represents the user an API call is made on behalf of
+type Identity struct {
+	User   string
+	Groups []string
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context whose API calls impersonate the user on clusters that enable impersonation.
+// Clusters without impersonation ignore it.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFromContext returns the identity attached to a context, if any
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok && identity.User != ""
+}
+
+// impersonatingRoundTripper sets the impersonation headers from the identity of each request.
+// Requests without an identity are refused instead of falling back to the cluster's own credentials.
+type impersonatingRoundTripper struct {
+	config   ImpersonationConfig
+	delegate http.RoundTripper
+}
+
+// newImpersonatingRoundTripper wraps a round tripper with per-request impersonation
+func newImpersonatingRoundTripper(config ImpersonationConfig, delegate http.RoundTripper) http.RoundTripper {
+	return &impersonatingRoundTripper{config: config, delegate: delegate}
+}
+
+// RoundTrip implements http.RoundTripper
+func (rt *impersonatingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	identity, ok := IdentityFromContext(req.Context())
+	if !ok {
+		return nil, ErrIdentityRequired
+	}
+
+	req = utilnet.CloneRequest(req)
+	req.Header.Set(transport.ImpersonateUserHeader, rt.config.UserPrefix+identity.User)
+	req.Header.Del(transport.ImpersonateGroupHeader)
+	for _, group := range identity.Groups {
+		req.Header.Add(transport.ImpersonateGroupHeader, rt.config.GroupPrefix+group)
+	}
+	return rt.delegate.RoundTrip(req)
+}
+
+// WrappedRoundTripper returns the round tripper being wrapped
+func (rt *impersonatingRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.delegate
+}
@@ -0,0 +1,58 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestImpersonatingRoundTripper_RoundTrip_WithIdentity_SetsImpersonationHeaders(t *testing.T) {
+	// Arrange
+	var sent *http.Request
+	rt := newImpersonatingRoundTripper(ImpersonationConfig{UserPrefix: "github:", GroupPrefix: "github:"}, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		sent = req
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	ctx := WithIdentity(context.Background(), Identity{User: "octocat", Groups: []string{"dash-ops*sre", "dash-ops*payments"}})
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.prod:6443/api/v1/pods", nil)
+	require.NoError(t, err)
+	req.Header.Set("Impersonate-Group", "system:masters")
+
+	// Act
+	_, err = rt.RoundTrip(req)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "github:octocat", sent.Header.Get("Impersonate-User"))
+	assert.Equal(t, []string{"github:dash-ops*sre", "github:dash-ops*payments"}, sent.Header.Values("Impersonate-Group"))
+	assert.Equal(t, "system:masters", req.Header.Get("Impersonate-Group"), "the original request must not be modified")
+}
+
+func TestImpersonatingRoundTripper_RoundTrip_WithoutIdentity_RefusesRequest(t *testing.T) {
+	// Arrange
+	called := false
+	rt := newImpersonatingRoundTripper(ImpersonationConfig{}, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.prod:6443/api/v1/pods", nil)
+	require.NoError(t, err)
+
+	// Act
+	_, err = rt.RoundTrip(req)
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrIdentityRequired))
+	assert.False(t, called)
+}
@@ -0,0 +1,212 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CacheStatus reports the state of a cluster informer cache
+type CacheStatus struct {
+	Enabled  bool
+	Synced   bool
+	SyncedAt *time.Time
+}
+
+// InformerCache serves pod, deployment and node reads from shared informers
+type InformerCache struct {
+	factory          informers.SharedInformerFactory
+	podLister        corelisters.PodLister
+	deploymentLister appslisters.DeploymentLister
+	nodeLister       corelisters.NodeLister
+	hasSynced        []cache.InformerSynced
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+
+	mu       sync.RWMutex
+	syncedAt time.Time
+}
+
+// NewInformerCache creates an informer cache; it serves nothing until started and synced
+func NewInformerCache(clientSet kubernetes.Interface, resyncPeriod time.Duration) *InformerCache {
+	factory := informers.NewSharedInformerFactory(clientSet, resyncPeriod)
+	pods := factory.Core().V1().Pods()
+	deployments := factory.Apps().V1().Deployments()
+	nodes := factory.Core().V1().Nodes()
+
+	return &InformerCache{
+		factory:          factory,
+		podLister:        pods.Lister(),
+		deploymentLister: deployments.Lister(),
+		nodeLister:       nodes.Lister(),
+		hasSynced: []cache.InformerSynced{
+			pods.Informer().HasSynced,
+			deployments.Informer().HasSynced,
+			nodes.Informer().HasSynced,
+		},
+		stopCh: make(chan struct{}),
+	}
+}
+
+// Start starts the informers and records when their initial sync completes
+func (c *InformerCache) Start() {
+	c.factory.Start(c.stopCh)
+
+	go func() {
+		if !cache.WaitForCacheSync(c.stopCh, c.hasSynced...) {
+			return
+		}
+		c.mu.Lock()
+		c.syncedAt = time.Now()
+		c.mu.Unlock()
+	}()
+}
+
+// Stop stops the informers
+func (c *InformerCache) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+		c.factory.Shutdown()
+	})
+}
+
+// HasSynced checks if every informer completed its initial list
+func (c *InformerCache) HasSynced() bool {
+	for _, synced := range c.hasSynced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Status returns the current sync status of the cache
+func (c *InformerCache) Status() CacheStatus {
+	status := CacheStatus{Enabled: true, Synced: c.HasSynced()}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.syncedAt.IsZero() {
+		syncedAt := c.syncedAt
+		status.SyncedAt = &syncedAt
+	}
+	return status
+}
+
+// CanServe checks if a list with these options can be answered from memory.
+// Field selectors, pagination and resource versions still go to the API server.
+func (c *InformerCache) CanServe(options metav1.ListOptions) bool {
+	if options.FieldSelector != "" || options.Limit > 0 || options.Continue != "" || options.ResourceVersion != "" {
+		return false
+	}
+	return c.HasSynced()
+}
+
+// GetPod gets a pod from the cache
+func (c *InformerCache) GetPod(namespace, name string) (*corev1.Pod, error) {
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return pod.DeepCopy(), nil
+}
+
+// ListPods lists pods from the cache
+func (c *InformerCache) ListPods(namespace, labelSelector string) (*corev1.PodList, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	pods, err := c.podLister.Pods(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool { return objectKeyLess(&pods[i].ObjectMeta, &pods[j].ObjectMeta) })
+
+	list := &corev1.PodList{Items: make([]corev1.Pod, 0, len(pods))}
+	for _, pod := range pods {
+		list.Items = append(list.Items, *pod.DeepCopy())
+	}
+	return list, nil
+}
+
+// GetDeployment gets a deployment from the cache
+func (c *InformerCache) GetDeployment(namespace, name string) (*appsv1.Deployment, error) {
+	deployment, err := c.deploymentLister.Deployments(namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return deployment.DeepCopy(), nil
+}
+
+// ListDeployments lists deployments from the cache
+func (c *InformerCache) ListDeployments(namespace, labelSelector string) (*appsv1.DeploymentList, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	deployments, err := c.deploymentLister.Deployments(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return objectKeyLess(&deployments[i].ObjectMeta, &deployments[j].ObjectMeta)
+	})
+
+	list := &appsv1.DeploymentList{Items: make([]appsv1.Deployment, 0, len(deployments))}
+	for _, deployment := range deployments {
+		list.Items = append(list.Items, *deployment.DeepCopy())
+	}
+	return list, nil
+}
+
+// GetNode gets a node from the cache
+func (c *InformerCache) GetNode(name string) (*corev1.Node, error) {
+	node, err := c.nodeLister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return node.DeepCopy(), nil
+}
+
+// ListNodes lists nodes from the cache
+func (c *InformerCache) ListNodes(labelSelector string) (*corev1.NodeList, error) {
+	selector, err := labels.Parse(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector: %w", err)
+	}
+
+	nodes, err := c.nodeLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Name < nodes[j].Name })
+
+	list := &corev1.NodeList{Items: make([]corev1.Node, 0, len(nodes))}
+	for _, node := range nodes {
+		list.Items = append(list.Items, *node.DeepCopy())
+	}
+	return list, nil
+}
+
+// objectKeyLess orders objects by namespace and name, matching API server list order
+func objectKeyLess(a, b *metav1.ObjectMeta) bool {
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,88 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestPod(namespace, name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}}
+}
+
+func startSyncedCache(t *testing.T, cache *InformerCache) {
+	t.Helper()
+	cache.Start()
+	t.Cleanup(cache.Stop)
+	require.Eventually(t, func() bool { return cache.Status().SyncedAt != nil }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestInformerCache_Status_BeforeStart_ReportsNotSynced(t *testing.T) {
+	// Arrange
+	cache := NewInformerCache(fake.NewClientset(), 0)
+
+	// Act
+	status := cache.Status()
+
+	// Assert
+	assert.True(t, status.Enabled)
+	assert.False(t, status.Synced)
+	assert.Nil(t, status.SyncedAt)
+	assert.False(t, cache.CanServe(metav1.ListOptions{}))
+}
+
+func TestInformerCache_ListPods_WithLabelSelector_ReturnsMatchingPodsInOrder(t *testing.T) {
+	// Arrange
+	clientSet := fake.NewClientset(
+		newTestPod("shop", "web-b", map[string]string{"app": "web"}),
+		newTestPod("shop", "web-a", map[string]string{"app": "web"}),
+		newTestPod("shop", "worker", map[string]string{"app": "worker"}),
+		newTestPod("other", "web-c", map[string]string{"app": "web"}),
+	)
+	cache := NewInformerCache(clientSet, 0)
+	startSyncedCache(t, cache)
+
+	// Act
+	pods, err := cache.ListPods("shop", "app=web")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, pods.Items, 2)
+	assert.Equal(t, "web-a", pods.Items[0].Name)
+	assert.Equal(t, "web-b", pods.Items[1].Name)
+}
+
+func TestInformerCache_GetPod_ReturnsCopyAndNotFound(t *testing.T) {
+	// Arrange
+	clientSet := fake.NewClientset(newTestPod("shop", "web", map[string]string{"app": "web"}))
+	cache := NewInformerCache(clientSet, 0)
+	startSyncedCache(t, cache)
+
+	// Act
+	pod, err := cache.GetPod("shop", "web")
+	require.NoError(t, err)
+	pod.Labels["app"] = "changed"
+	cached, _ := cache.GetPod("shop", "web")
+	_, missingErr := cache.GetPod("shop", "missing")
+
+	// Assert
+	assert.Equal(t, "web", cached.Labels["app"])
+	assert.True(t, apierrors.IsNotFound(missingErr))
+}
+
+func TestInformerCache_CanServe_WithFieldSelectorOrPagination_ReturnsFalse(t *testing.T) {
+	// Arrange
+	cache := NewInformerCache(fake.NewClientset(), 0)
+	startSyncedCache(t, cache)
+
+	// Act & Assert
+	assert.True(t, cache.CanServe(metav1.ListOptions{LabelSelector: "app=web"}))
+	assert.False(t, cache.CanServe(metav1.ListOptions{FieldSelector: "spec.nodeName=node-1"}))
+	assert.False(t, cache.CanServe(metav1.ListOptions{Limit: 1}))
+}
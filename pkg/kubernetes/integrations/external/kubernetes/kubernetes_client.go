@@ -3,59 +3,90 @@ package kubernetes
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/restmapper"
 )
 
 // KubernetesClient handles communication with Kubernetes API
 type KubernetesClient struct {
-	clientSet *kubernetes.Clientset
-	context   string
-	config    *KubernetesConfig
+	clientSet     *kubernetes.Clientset
+	dynamicClient dynamic.Interface
+	restMapper    *restmapper.DeferredDiscoveryRESTMapper
+	restConfig    *rest.Config
+	context       string
+	server        string
+	config        *KubernetesConfig
+	metrics       metricsAvailability
+	cache         *InformerCache
 }
 
 // KubernetesConfig represents Kubernetes connection configuration
 type KubernetesConfig struct {
-	Kubeconfig string
-	Context    string
+	Name              string
+	Kubeconfig        string
+	Context           string
+	CacheEnabled      bool
+	CacheResyncPeriod time.Duration
+
+	// AuthMode selects how credentials are loaded; empty keeps the kubeconfig or in-cluster default
+	AuthMode              AuthMode
+	Server                string
+	BearerToken           string
+	BearerTokenFile       string
+	CAFile                string
+	CAData                []byte
+	InsecureSkipTLSVerify bool
+	Exec                  *ExecCredentialConfig
+
+	// Impersonation, when set, makes every call on behalf of the identity in its context
+	Impersonation *ImpersonationConfig
 }
 
 // NewKubernetesClient creates a new Kubernetes client
 func NewKubernetesClient(config *KubernetesConfig) (*KubernetesClient, error) {
-	var restConfig *rest.Config
-	var err error
-
-	if config.Kubeconfig != "" {
-		// Use kubeconfig file
-		restConfig, err = clientcmd.BuildConfigFromFlags("", config.Kubeconfig)
-		if err != nil {
-			return nil, fmt.Errorf("failed to build config from kubeconfig: %w", err)
-		}
-	} else {
-		// Use in-cluster config
-		restConfig, err = rest.InClusterConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+	if config.AuthMode == "" {
+		config.AuthMode = AuthModeInCluster
+		if config.Kubeconfig != "" {
+			config.AuthMode = AuthModeKubeconfig
 		}
 	}
 
-	// Override context if specified
-	if config.Context != "" {
-		restConfig, err = clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
-			&clientcmd.ClientConfigLoadingRules{ExplicitPath: config.Kubeconfig},
-			&clientcmd.ConfigOverrides{CurrentContext: config.Context},
-		).ClientConfig()
-		if err != nil {
-			return nil, fmt.Errorf("failed to override context: %w", err)
-		}
+	restConfig, err := buildRestConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	// API discovery runs without a request context, so it keeps the cluster's own credentials
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+
+	if config.Impersonation != nil {
+		impersonation := *config.Impersonation
+		restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+			return newImpersonatingRoundTripper(impersonation, rt)
+		})
 	}
 
 	clientSet, err := kubernetes.NewForConfig(restConfig)
@@ -63,40 +94,88 @@ func NewKubernetesClient(config *KubernetesConfig) (*KubernetesClient, error) {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
 	}
 
-	return &KubernetesClient{
-		clientSet: clientSet,
-		context:   config.Context,
-		config:    config,
-	}, nil
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	client := &KubernetesClient{
+		clientSet:     clientSet,
+		dynamicClient: dynamicClient,
+		restMapper:    restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+		restConfig:    restConfig,
+		context:       config.Context,
+		server:        restConfig.Host,
+		config:        config,
+	}
+
+	// Reads fall back to the API server until the informers finish their initial sync
+	if config.CacheEnabled {
+		client.cache = NewInformerCache(clientSet, config.CacheResyncPeriod)
+		client.cache.Start()
+	}
+
+	return client, nil
 }
 
-// GetDeployment gets a deployment
+// Close stops the informer cache, if any; the client keeps working against the API server
+func (kc *KubernetesClient) Close() {
+	if kc.cache != nil {
+		kc.cache.Stop()
+	}
+}
+
+// GetDeployment gets a deployment, from the informer cache when it is synced
 func (kc *KubernetesClient) GetDeployment(ctx context.Context, namespace, name string) (*appsv1.Deployment, error) {
+	if kc.cache != nil && kc.cache.HasSynced() {
+		return kc.cache.GetDeployment(namespace, name)
+	}
 	return kc.clientSet.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
-// ListDeployments lists deployments
+// GetDeploymentLive gets a deployment from the API server, bypassing the informer cache.
+// Read-modify-write paths use it so updates are not rejected for a stale cached version.
+func (kc *KubernetesClient) GetDeploymentLive(ctx context.Context, namespace, name string) (*appsv1.Deployment, error) {
+	return kc.clientSet.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListDeployments lists deployments, from the informer cache when it can serve the options
 func (kc *KubernetesClient) ListDeployments(ctx context.Context, namespace string, options metav1.ListOptions) (*appsv1.DeploymentList, error) {
+	if kc.cache != nil && kc.cache.CanServe(options) {
+		return kc.cache.ListDeployments(namespace, options.LabelSelector)
+	}
 	return kc.clientSet.AppsV1().Deployments(namespace).List(ctx, options)
 }
 
-// GetPod gets a pod
+// GetPod gets a pod, from the informer cache when it is synced
 func (kc *KubernetesClient) GetPod(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
+	if kc.cache != nil && kc.cache.HasSynced() {
+		return kc.cache.GetPod(namespace, name)
+	}
 	return kc.clientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
 }
 
-// ListPods lists pods
+// ListPods lists pods, from the informer cache when it can serve the options
 func (kc *KubernetesClient) ListPods(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.PodList, error) {
+	if kc.cache != nil && kc.cache.CanServe(options) {
+		return kc.cache.ListPods(namespace, options.LabelSelector)
+	}
 	return kc.clientSet.CoreV1().Pods(namespace).List(ctx, options)
 }
 
-// GetNode gets a node
+// GetNode gets a node, from the informer cache when it is synced
 func (kc *KubernetesClient) GetNode(ctx context.Context, name string) (*corev1.Node, error) {
+	if kc.cache != nil && kc.cache.HasSynced() {
+		return kc.cache.GetNode(name)
+	}
 	return kc.clientSet.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
 }
 
-// ListNodes lists nodes
+// ListNodes lists nodes, from the informer cache when it can serve the options
 func (kc *KubernetesClient) ListNodes(ctx context.Context, options metav1.ListOptions) (*corev1.NodeList, error) {
+	if kc.cache != nil && kc.cache.CanServe(options) {
+		return kc.cache.ListNodes(options.LabelSelector)
+	}
 	return kc.clientSet.CoreV1().Nodes().List(ctx, options)
 }
 
@@ -110,6 +189,16 @@ func (kc *KubernetesClient) ListNamespaces(ctx context.Context, options metav1.L
 	return kc.clientSet.CoreV1().Namespaces().List(ctx, options)
 }
 
+// ListResourceQuotas lists resource quotas; an empty namespace lists them across all namespaces
+func (kc *KubernetesClient) ListResourceQuotas(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.ResourceQuotaList, error) {
+	return kc.clientSet.CoreV1().ResourceQuotas(namespace).List(ctx, options)
+}
+
+// ListLimitRanges lists limit ranges; an empty namespace lists them across all namespaces
+func (kc *KubernetesClient) ListLimitRanges(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.LimitRangeList, error) {
+	return kc.clientSet.CoreV1().LimitRanges(namespace).List(ctx, options)
+}
+
 // GetPodLogs gets pod logs
 func (kc *KubernetesClient) GetPodLogs(ctx context.Context, namespace, podName string, options *corev1.PodLogOptions) (io.ReadCloser, error) {
 	return kc.clientSet.CoreV1().Pods(namespace).GetLogs(podName, options).Stream(ctx)
@@ -150,7 +239,7 @@ func (kc *KubernetesClient) ScaleDeployment(ctx context.Context, namespace, name
 
 // RestartDeployment restarts a deployment
 func (kc *KubernetesClient) RestartDeployment(ctx context.Context, namespace, name string) error {
-	deployment, err := kc.GetDeployment(ctx, namespace, name)
+	deployment, err := kc.GetDeploymentLive(ctx, namespace, name)
 	if err != nil {
 		return fmt.Errorf("failed to get deployment: %w", err)
 	}
@@ -169,6 +258,181 @@ func (kc *KubernetesClient) RestartDeployment(ctx context.Context, namespace, na
 	return nil
 }
 
+// UpdateDeployment updates a deployment
+func (kc *KubernetesClient) UpdateDeployment(ctx context.Context, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	return kc.clientSet.AppsV1().Deployments(deployment.Namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+}
+
+// GetReplicaSet gets a replica set by name
+func (kc *KubernetesClient) GetReplicaSet(ctx context.Context, namespace, name string) (*appsv1.ReplicaSet, error) {
+	return kc.clientSet.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListReplicaSets lists replica sets in a namespace
+func (kc *KubernetesClient) ListReplicaSets(ctx context.Context, namespace string, options metav1.ListOptions) (*appsv1.ReplicaSetList, error) {
+	return kc.clientSet.AppsV1().ReplicaSets(namespace).List(ctx, options)
+}
+
+// GetStatefulSet gets a stateful set by name
+func (kc *KubernetesClient) GetStatefulSet(ctx context.Context, namespace, name string) (*appsv1.StatefulSet, error) {
+	return kc.clientSet.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListStatefulSets lists stateful sets in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListStatefulSets(ctx context.Context, namespace string, options metav1.ListOptions) (*appsv1.StatefulSetList, error) {
+	return kc.clientSet.AppsV1().StatefulSets(namespace).List(ctx, options)
+}
+
+// ScaleStatefulSet scales a stateful set
+func (kc *KubernetesClient) ScaleStatefulSet(ctx context.Context, namespace, name string, replicas int32) error {
+	scale, err := kc.clientSet.AppsV1().StatefulSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get stateful set scale: %w", err)
+	}
+
+	scale.Spec.Replicas = replicas
+	_, err = kc.clientSet.AppsV1().StatefulSets(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to scale stateful set: %w", err)
+	}
+
+	return nil
+}
+
+// RestartStatefulSet restarts a stateful set
+func (kc *KubernetesClient) RestartStatefulSet(ctx context.Context, namespace, name string) error {
+	_, err := kc.clientSet.AppsV1().StatefulSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, restartPatch(), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restart stateful set: %w", err)
+	}
+	return nil
+}
+
+// GetDaemonSet gets a daemon set by name
+func (kc *KubernetesClient) GetDaemonSet(ctx context.Context, namespace, name string) (*appsv1.DaemonSet, error) {
+	return kc.clientSet.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListDaemonSets lists daemon sets in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListDaemonSets(ctx context.Context, namespace string, options metav1.ListOptions) (*appsv1.DaemonSetList, error) {
+	return kc.clientSet.AppsV1().DaemonSets(namespace).List(ctx, options)
+}
+
+// RestartDaemonSet restarts a daemon set
+func (kc *KubernetesClient) RestartDaemonSet(ctx context.Context, namespace, name string) error {
+	_, err := kc.clientSet.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.StrategicMergePatchType, restartPatch(), metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to restart daemon set: %w", err)
+	}
+	return nil
+}
+
+// GetJob gets a job by name
+func (kc *KubernetesClient) GetJob(ctx context.Context, namespace, name string) (*batchv1.Job, error) {
+	return kc.clientSet.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListJobs lists jobs in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListJobs(ctx context.Context, namespace string, options metav1.ListOptions) (*batchv1.JobList, error) {
+	return kc.clientSet.BatchV1().Jobs(namespace).List(ctx, options)
+}
+
+// CreateJob creates a job
+func (kc *KubernetesClient) CreateJob(ctx context.Context, job *batchv1.Job) (*batchv1.Job, error) {
+	return kc.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+}
+
+// GetCronJob gets a cron job by name
+func (kc *KubernetesClient) GetCronJob(ctx context.Context, namespace, name string) (*batchv1.CronJob, error) {
+	return kc.clientSet.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListCronJobs lists cron jobs in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListCronJobs(ctx context.Context, namespace string, options metav1.ListOptions) (*batchv1.CronJobList, error) {
+	return kc.clientSet.BatchV1().CronJobs(namespace).List(ctx, options)
+}
+
+// SetCronJobSuspend suspends or resumes a cron job
+func (kc *KubernetesClient) SetCronJobSuspend(ctx context.Context, namespace, name string, suspend bool) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"suspend": suspend},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build cron job patch: %w", err)
+	}
+
+	_, err = kc.clientSet.BatchV1().CronJobs(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update cron job suspend: %w", err)
+	}
+	return nil
+}
+
+// restartPatch builds the pod template annotation patch used by kubectl rollout restart
+func restartPatch() []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":%q}}}}}`, time.Now().Format(time.RFC3339)))
+}
+
+// GetHorizontalPodAutoscaler gets a horizontal pod autoscaler by name
+func (kc *KubernetesClient) GetHorizontalPodAutoscaler(ctx context.Context, namespace, name string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return kc.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListHorizontalPodAutoscalers lists horizontal pod autoscalers in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListHorizontalPodAutoscalers(ctx context.Context, namespace string, options metav1.ListOptions) (*autoscalingv2.HorizontalPodAutoscalerList, error) {
+	return kc.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, options)
+}
+
+// SetHorizontalPodAutoscalerBounds updates the min and max replicas of a horizontal pod autoscaler
+func (kc *KubernetesClient) SetHorizontalPodAutoscalerBounds(ctx context.Context, namespace, name string, minReplicas, maxReplicas int32) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"minReplicas": minReplicas,
+			"maxReplicas": maxReplicas,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build autoscaler patch: %w", err)
+	}
+
+	return kc.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+}
+
+// GetService gets a service by name
+func (kc *KubernetesClient) GetService(ctx context.Context, namespace, name string) (*corev1.Service, error) {
+	return kc.clientSet.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListServices lists services in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListServices(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.ServiceList, error) {
+	return kc.clientSet.CoreV1().Services(namespace).List(ctx, options)
+}
+
+// ListEndpointSlices lists endpoint slices in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListEndpointSlices(ctx context.Context, namespace string, options metav1.ListOptions) (*discoveryv1.EndpointSliceList, error) {
+	return kc.clientSet.DiscoveryV1().EndpointSlices(namespace).List(ctx, options)
+}
+
+// GetIngress gets an ingress by name
+func (kc *KubernetesClient) GetIngress(ctx context.Context, namespace, name string) (*networkingv1.Ingress, error) {
+	return kc.clientSet.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListIngresses lists ingresses in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListIngresses(ctx context.Context, namespace string, options metav1.ListOptions) (*networkingv1.IngressList, error) {
+	return kc.clientSet.NetworkingV1().Ingresses(namespace).List(ctx, options)
+}
+
+// GetConfigMap gets a config map by name
+func (kc *KubernetesClient) GetConfigMap(ctx context.Context, namespace, name string) (*corev1.ConfigMap, error) {
+	return kc.clientSet.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+// ListConfigMaps lists config maps in a namespace (all namespaces when empty)
+func (kc *KubernetesClient) ListConfigMaps(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.ConfigMapList, error) {
+	return kc.clientSet.CoreV1().ConfigMaps(namespace).List(ctx, options)
+}
+
 // DeletePod deletes a pod
 func (kc *KubernetesClient) DeletePod(ctx context.Context, namespace, name string) error {
 	return kc.clientSet.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
@@ -191,16 +455,44 @@ func (kc *KubernetesClient) DeleteNamespace(ctx context.Context, name string) er
 	return kc.clientSet.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
 }
 
+// ListEvents lists core/v1 events
+func (kc *KubernetesClient) ListEvents(ctx context.Context, namespace string, options metav1.ListOptions) (*corev1.EventList, error) {
+	return kc.clientSet.CoreV1().Events(namespace).List(ctx, options)
+}
+
+// WatchEvents watches core/v1 events
+func (kc *KubernetesClient) WatchEvents(ctx context.Context, namespace string, options metav1.ListOptions) (watch.Interface, error) {
+	return kc.clientSet.CoreV1().Events(namespace).Watch(ctx, options)
+}
+
 // GetContext returns the current context
 func (kc *KubernetesClient) GetContext() string {
 	return kc.context
 }
 
+// GetServer returns the API server address
+func (kc *KubernetesClient) GetServer() string {
+	return kc.server
+}
+
+// Impersonates reports whether calls are made on behalf of the identity in their context
+func (kc *KubernetesClient) Impersonates() bool {
+	return kc.config.Impersonation != nil
+}
+
 // GetConfig returns the client configuration
 func (kc *KubernetesClient) GetConfig() *KubernetesConfig {
 	return kc.config
 }
 
+// CacheStatus returns the informer cache status of this client
+func (kc *KubernetesClient) CacheStatus() CacheStatus {
+	if kc.cache == nil {
+		return CacheStatus{}
+	}
+	return kc.cache.Status()
+}
+
 // TestConnection tests the connection to Kubernetes API
 func (kc *KubernetesClient) TestConnection(ctx context.Context) error {
 	_, err := kc.clientSet.CoreV1().Namespaces().List(ctx, metav1.ListOptions{Limit: 1})
@@ -209,17 +501,22 @@ func (kc *KubernetesClient) TestConnection(ctx context.Context) error {
 
 // GetServerVersion gets the Kubernetes server version
 func (kc *KubernetesClient) GetServerVersion(ctx context.Context) (string, error) {
-	version, err := kc.clientSet.Discovery().ServerVersion()
+	body, err := kc.clientSet.Discovery().RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
 	if err != nil {
 		return "", err
 	}
-	return version.String(), nil
+
+	var info version.Info
+	if err := json.Unmarshal(body, &info); err != nil {
+		return "", fmt.Errorf("failed to decode server version: %w", err)
+	}
+	return info.String(), nil
 }
 
 // GetClusterInfo gets basic cluster information
 func (kc *KubernetesClient) GetClusterInfo(ctx context.Context) (*ClusterInfo, error) {
 	// Get server version
-	version, err := kc.GetServerVersion(ctx)
+	serverVersion, err := kc.GetServerVersion(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get server version: %w", err)
 	}
@@ -237,7 +534,7 @@ func (kc *KubernetesClient) GetClusterInfo(ctx context.Context) (*ClusterInfo, e
 	}
 
 	return &ClusterInfo{
-		Version:        version,
+		Version:        serverVersion,
 		Context:        kc.context,
 		NodeCount:      len(nodes.Items),
 		NamespaceCount: len(namespaces.Items),
@@ -0,0 +1,151 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// metricsAPIPath is the root of the metrics.k8s.io API served by metrics-server
+	metricsAPIPath = "/apis/metrics.k8s.io/v1beta1"
+	// metricsAvailabilityTTL is how long a metrics API availability check is reused
+	metricsAvailabilityTTL = time.Minute
+)
+
+// ErrMetricsUnavailable is returned when the metrics.k8s.io API is not served by a cluster
+var ErrMetricsUnavailable = errors.New("metrics API is not available")
+
+// NodeMetrics represents metrics.k8s.io usage of a node
+type NodeMetrics struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Timestamp         metav1.Time         `json:"timestamp"`
+	Window            metav1.Duration     `json:"window"`
+	Usage             corev1.ResourceList `json:"usage"`
+}
+
+// NodeMetricsList represents a list of node metrics
+type NodeMetricsList struct {
+	Items []NodeMetrics `json:"items"`
+}
+
+// PodMetrics represents metrics.k8s.io usage of a pod
+type PodMetrics struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Timestamp         metav1.Time        `json:"timestamp"`
+	Window            metav1.Duration    `json:"window"`
+	Containers        []ContainerMetrics `json:"containers"`
+}
+
+// ContainerMetrics represents metrics.k8s.io usage of a container
+type ContainerMetrics struct {
+	Name  string              `json:"name"`
+	Usage corev1.ResourceList `json:"usage"`
+}
+
+// PodMetricsList represents a list of pod metrics
+type PodMetricsList struct {
+	Items []PodMetrics `json:"items"`
+}
+
+// metricsAvailability caches whether the metrics API is served
+type metricsAvailability struct {
+	mu        sync.Mutex
+	available bool
+	checkedAt time.Time
+}
+
+// IsMetricsAvailable checks if the metrics.k8s.io API is served, caching the answer briefly.
+// A missing or unavailable API is reported as false without an error.
+func (kc *KubernetesClient) IsMetricsAvailable(ctx context.Context) (bool, error) {
+	kc.metrics.mu.Lock()
+	defer kc.metrics.mu.Unlock()
+
+	if !kc.metrics.checkedAt.IsZero() && time.Since(kc.metrics.checkedAt) < metricsAvailabilityTTL {
+		return kc.metrics.available, nil
+	}
+
+	_, err := kc.clientSet.Discovery().RESTClient().Get().AbsPath(metricsAPIPath).Do(ctx).Raw()
+	switch {
+	case err == nil:
+		kc.metrics.available = true
+	case isMetricsAPIMissing(err):
+		kc.metrics.available = false
+	default:
+		return false, fmt.Errorf("failed to check metrics API: %w", err)
+	}
+	kc.metrics.checkedAt = time.Now()
+
+	return kc.metrics.available, nil
+}
+
+// ListNodeMetrics lists usage of all nodes
+func (kc *KubernetesClient) ListNodeMetrics(ctx context.Context) (*NodeMetricsList, error) {
+	var list NodeMetricsList
+	if err := kc.getMetrics(ctx, metricsAPIPath+"/nodes", "", &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// GetNodeMetrics gets usage of a node
+func (kc *KubernetesClient) GetNodeMetrics(ctx context.Context, name string) (*NodeMetrics, error) {
+	var metrics NodeMetrics
+	if err := kc.getMetrics(ctx, metricsAPIPath+"/nodes/"+name, "", &metrics); err != nil {
+		return nil, err
+	}
+	return &metrics, nil
+}
+
+// ListPodMetrics lists usage of pods in a namespace, or in all namespaces when namespace is empty
+func (kc *KubernetesClient) ListPodMetrics(ctx context.Context, namespace, labelSelector string) (*PodMetricsList, error) {
+	path := metricsAPIPath + "/pods"
+	if namespace != "" {
+		path = metricsAPIPath + "/namespaces/" + namespace + "/pods"
+	}
+
+	var list PodMetricsList
+	if err := kc.getMetrics(ctx, path, labelSelector, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// GetPodMetrics gets usage of a pod
+func (kc *KubernetesClient) GetPodMetrics(ctx context.Context, namespace, name string) (*PodMetrics, error) {
+	var metrics PodMetrics
+	if err := kc.getMetrics(ctx, metricsAPIPath+"/namespaces/"+namespace+"/pods/"+name, "", &metrics); err != nil {
+		return nil, err
+	}
+	return &metrics, nil
+}
+
+// getMetrics reads a metrics.k8s.io resource into out
+func (kc *KubernetesClient) getMetrics(ctx context.Context, path, labelSelector string, out interface{}) error {
+	request := kc.clientSet.Discovery().RESTClient().Get().AbsPath(path)
+	if labelSelector != "" {
+		request = request.Param("labelSelector", labelSelector)
+	}
+
+	body, err := request.Do(ctx).Raw()
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode metrics: %w", err)
+	}
+	return nil
+}
+
+// isMetricsAPIMissing checks if an error means the metrics API is not installed or not serving
+func isMetricsAPIMissing(err error) bool {
+	return apierrors.IsNotFound(err) || apierrors.IsServiceUnavailable(err)
+}
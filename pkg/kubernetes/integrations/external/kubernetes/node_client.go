@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ErrEvictionBlocked is returned when the API server refuses an eviction, usually because of a PodDisruptionBudget
+var ErrEvictionBlocked = errors.New("eviction blocked")
+
+// SetNodeUnschedulable cordons or uncordons a node
+func (kc *KubernetesClient) SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"unschedulable": unschedulable},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build node patch: %w", err)
+	}
+
+	_, err = kc.clientSet.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// EvictPod requests the eviction of a pod through the Eviction API so PodDisruptionBudgets are honoured.
+// A nil grace period uses the pod's own terminationGracePeriodSeconds.
+func (kc *KubernetesClient) EvictPod(ctx context.Context, namespace, name string, gracePeriodSeconds *int64) error {
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		DeleteOptions: &metav1.DeleteOptions{GracePeriodSeconds: gracePeriodSeconds},
+	}
+
+	err := kc.clientSet.PolicyV1().Evictions(namespace).Evict(ctx, eviction)
+	if apierrors.IsTooManyRequests(err) {
+		return fmt.Errorf("%w: %s", ErrEvictionBlocked, err.Error())
+	}
+	return err
+}
+
+// GetPodLive gets a pod from the API server, bypassing the informer cache
+func (kc *KubernetesClient) GetPodLive(ctx context.Context, namespace, name string) (*corev1.Pod, error) {
+	return kc.clientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+var (
+	// ErrDrainBlocked is returned when pods on a node cannot be drained with the given options
+	ErrDrainBlocked = errors.New("drain blocked")
+	// ErrInvalidDrainOptions is returned when drain options are out of range
+	ErrInvalidDrainOptions = errors.New("invalid drain options")
+)
+
+// DrainPlanner decides which pods of a node a drain evicts, following kubectl drain rules
+type DrainPlanner struct{}
+
+// NewDrainPlanner creates a new drain planner
+func NewDrainPlanner() *DrainPlanner {
+	return &DrainPlanner{}
+}
+
+// PlanDrain returns the drain progress entries for the pods of a node.
+// DaemonSet and static pods are skipped; unmanaged pods and pods with emptyDir data
+// block the drain unless Force or DeleteEmptyDirData allow them.
+func (dp *DrainPlanner) PlanDrain(candidates []k8sModels.DrainCandidate, options k8sModels.DrainOptions) ([]k8sModels.DrainPod, error) {
+	pods := make([]k8sModels.DrainPod, 0, len(candidates))
+	var blocked []string
+
+	for _, candidate := range candidates {
+		pod := k8sModels.DrainPod{
+			Namespace: candidate.Namespace,
+			Name:      candidate.Name,
+			UID:       candidate.UID,
+			Status:    k8sModels.DrainPodPending,
+		}
+
+		switch {
+		case candidate.Mirror:
+			pod.Status = k8sModels.DrainPodSkipped
+			pod.Reason = "static pod managed by the kubelet"
+		case candidate.OwnerKind == "DaemonSet" && !candidate.Finished:
+			pod.Status = k8sModels.DrainPodSkipped
+			pod.Reason = "managed by a DaemonSet"
+		case candidate.OwnerKind == "" && !candidate.Finished && !options.Force:
+			blocked = append(blocked, fmt.Sprintf("%s/%s is not managed by a controller", candidate.Namespace, candidate.Name))
+		case candidate.HasEmptyDir && !candidate.Finished && !options.DeleteEmptyDirData:
+			blocked = append(blocked, fmt.Sprintf("%s/%s uses emptyDir data", candidate.Namespace, candidate.Name))
+		}
+
+		pods = append(pods, pod)
+	}
+
+	if len(blocked) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrDrainBlocked, strings.Join(blocked, "; "))
+	}
+	return pods, nil
+}
@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestDrainPlanner_PlanDrain_SkipsDaemonSetAndStaticPods(t *testing.T) {
+	// Arrange
+	planner := NewDrainPlanner()
+	candidates := []k8sModels.DrainCandidate{
+		{Namespace: "shop", Name: "web-1", OwnerKind: "ReplicaSet"},
+		{Namespace: "kube-system", Name: "fluentd-x", OwnerKind: "DaemonSet"},
+		{Namespace: "kube-system", Name: "kube-proxy-node-1", OwnerKind: "Node", Mirror: true},
+	}
+
+	// Act
+	pods, err := planner.PlanDrain(candidates, k8sModels.DrainOptions{})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, pods, 3)
+	assert.Equal(t, k8sModels.DrainPodPending, pods[0].Status)
+	assert.Equal(t, k8sModels.DrainPodSkipped, pods[1].Status)
+	assert.Equal(t, k8sModels.DrainPodSkipped, pods[2].Status)
+}
+
+func TestDrainPlanner_PlanDrain_WithUnmanagedOrEmptyDirPods_ReturnsDrainBlocked(t *testing.T) {
+	// Arrange
+	planner := NewDrainPlanner()
+	candidates := []k8sModels.DrainCandidate{
+		{Namespace: "shop", Name: "debug"},
+		{Namespace: "shop", Name: "cache-0", OwnerKind: "StatefulSet", HasEmptyDir: true},
+	}
+
+	// Act
+	_, err := planner.PlanDrain(candidates, k8sModels.DrainOptions{})
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrDrainBlocked))
+	assert.Contains(t, err.Error(), "shop/debug is not managed by a controller")
+	assert.Contains(t, err.Error(), "shop/cache-0 uses emptyDir data")
+}
+
+func TestDrainPlanner_PlanDrain_WithForceAndDeleteEmptyDirData_EvictsAllPods(t *testing.T) {
+	// Arrange
+	planner := NewDrainPlanner()
+	candidates := []k8sModels.DrainCandidate{
+		{Namespace: "shop", Name: "debug"},
+		{Namespace: "shop", Name: "cache-0", OwnerKind: "StatefulSet", HasEmptyDir: true},
+	}
+
+	// Act
+	pods, err := planner.PlanDrain(candidates, k8sModels.DrainOptions{Force: true, DeleteEmptyDirData: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, k8sModels.DrainPodPending, pods[0].Status)
+	assert.Equal(t, k8sModels.DrainPodPending, pods[1].Status)
+}
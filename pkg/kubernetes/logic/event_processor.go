@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+// EventTypeWarning is the Kubernetes event type for warnings
+const EventTypeWarning = "Warning"
+
+// eventKinds maps API resource paths to the kind recorded in event involved objects
+var eventKinds = map[string]string{
+	"pods":                     "Pod",
+	"deployments":              "Deployment",
+	"replicasets":              "ReplicaSet",
+	"statefulsets":             "StatefulSet",
+	"daemonsets":               "DaemonSet",
+	"jobs":                     "Job",
+	"cronjobs":                 "CronJob",
+	"services":                 "Service",
+	"ingresses":                "Ingress",
+	"configmaps":               "ConfigMap",
+	"persistentvolumeclaims":   "PersistentVolumeClaim",
+	"horizontalpodautoscalers": "HorizontalPodAutoscaler",
+}
+
+// EventProcessor provides filtering and ordering logic for Kubernetes events
+type EventProcessor struct{}
+
+// NewEventProcessor creates a new event processor
+func NewEventProcessor() *EventProcessor {
+	return &EventProcessor{}
+}
+
+// ResolveKind converts a resource type such as "deployments" or "Deployment" into an event kind
+func (ep *EventProcessor) ResolveKind(resourceType string) (string, error) {
+	normalized := strings.ToLower(resourceType)
+	if kind, ok := eventKinds[normalized]; ok {
+		return kind, nil
+	}
+	for _, kind := range eventKinds {
+		if strings.ToLower(kind) == normalized {
+			return kind, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported resource type for events: %s", resourceType)
+}
+
+// SortByLastSeen sorts events with the most recently seen first
+func (ep *EventProcessor) SortByLastSeen(events []k8sPorts.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastTime.After(events[j].LastTime)
+	})
+}
+
+// FilterByType keeps only events of the given type (Normal or Warning)
+func (ep *EventProcessor) FilterByType(events []k8sPorts.Event, eventType string) []k8sPorts.Event {
+	if eventType == "" {
+		return events
+	}
+
+	filtered := make([]k8sPorts.Event, 0, len(events))
+	for _, event := range events {
+		if strings.EqualFold(event.Type, eventType) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// FilterDeploymentEvents keeps events about a deployment, its replica sets and their pods.
+// Replica sets and pods belong to the deployment when their ownerReferences lead to it, whatever their names.
+func (ep *EventProcessor) FilterDeploymentEvents(events []k8sPorts.Event, objects *k8sPorts.DeploymentObjects) []k8sPorts.Event {
+	owned := ep.deploymentOwnedObjects(objects)
+
+	filtered := make([]k8sPorts.Event, 0, len(events))
+	for _, event := range events {
+		if ep.isOwnedObject(event.Object, owned) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+// RecentWarnings returns the most recent warning events, up to limit (0 means no limit)
+func (ep *EventProcessor) RecentWarnings(events []k8sPorts.Event, limit int) []k8sPorts.Event {
+	warnings := ep.FilterByType(events, EventTypeWarning)
+	ep.SortByLastSeen(warnings)
+	if limit > 0 && len(warnings) > limit {
+		warnings = warnings[:limit]
+	}
+	return warnings
+}
+
+// deploymentOwnedObjects returns the deployment, the replica sets it controls and the pods those control, by kind and name
+func (ep *EventProcessor) deploymentOwnedObjects(objects *k8sPorts.DeploymentObjects) map[k8sPorts.EventObject]string {
+	owned := map[k8sPorts.EventObject]string{ownedKey(objects.Deployment): objects.Deployment.UID}
+
+	replicaSetUIDs := make(map[string]bool)
+	for _, replicaSet := range objects.ReplicaSets {
+		if replicaSet.ControllerUID != "" && replicaSet.ControllerUID == objects.Deployment.UID {
+			replicaSetUIDs[replicaSet.Object.UID] = true
+			owned[ownedKey(replicaSet.Object)] = replicaSet.Object.UID
+		}
+	}
+	for _, pod := range objects.Pods {
+		if replicaSetUIDs[pod.ControllerUID] {
+			owned[ownedKey(pod.Object)] = pod.Object.UID
+		}
+	}
+	return owned
+}
+
+// isOwnedObject checks if an involved object is one of the owned objects.
+// Events of an earlier object with the same name are told apart by UID when the event records one.
+func (ep *EventProcessor) isOwnedObject(object k8sPorts.EventObject, owned map[k8sPorts.EventObject]string) bool {
+	uid, ok := owned[ownedKey(object)]
+	return ok && (object.UID == "" || uid == "" || object.UID == uid)
+}
+
+// ownedKey identifies an object by kind and name only
+func ownedKey(object k8sPorts.EventObject) k8sPorts.EventObject {
+	return k8sPorts.EventObject{Kind: object.Kind, Name: object.Name}
+}
@@ -0,0 +1,115 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+func TestEventProcessor_ResolveKind_WithPluralOrKind_ReturnsKind(t *testing.T) {
+	// Arrange
+	processor := NewEventProcessor()
+
+	// Act
+	fromPlural, pluralErr := processor.ResolveKind("deployments")
+	fromKind, kindErr := processor.ResolveKind("Pod")
+	_, unknownErr := processor.ResolveKind("widgets")
+
+	// Assert
+	assert.NoError(t, pluralErr)
+	assert.Equal(t, "Deployment", fromPlural)
+	assert.NoError(t, kindErr)
+	assert.Equal(t, "Pod", fromKind)
+	assert.Error(t, unknownErr)
+}
+
+func TestEventProcessor_FilterDeploymentEvents_MatchesOwnedObjectsOnly(t *testing.T) {
+	// Arrange
+	processor := NewEventProcessor()
+	objects := &k8sPorts.DeploymentObjects{
+		Deployment:  k8sPorts.EventObject{Kind: "Deployment", Name: "api", UID: "deploy-api"},
+		ReplicaSets: []k8sPorts.OwnedObject{{Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-7d9f8c6b5", UID: "rs-api"}, ControllerUID: "deploy-api"}},
+		Pods:        []k8sPorts.OwnedObject{{Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-7d9f8c6b5-x2k4p", UID: "pod-api"}, ControllerUID: "rs-api"}},
+	}
+	events := []k8sPorts.Event{
+		{Reason: "ScalingReplicaSet", Object: k8sPorts.EventObject{Kind: "Deployment", Name: "api"}},
+		{Reason: "SuccessfulCreate", Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-7d9f8c6b5"}},
+		{Reason: "FailedScheduling", Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-7d9f8c6b5-x2k4p", UID: "pod-api"}},
+		{Reason: "BackOff", Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-gateway-5c6d7-abcde"}},
+		{Reason: "SuccessfulCreate", Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-gateway-5c6d7"}},
+		{Reason: "ScalingReplicaSet", Object: k8sPorts.EventObject{Kind: "Deployment", Name: "api-gateway"}},
+		{Reason: "Killing", Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-7d9f8c6b5-x2k4p", UID: "pod-api-before-recreate"}},
+	}
+
+	// Act
+	result := processor.FilterDeploymentEvents(events, objects)
+
+	// Assert
+	assert.Len(t, result, 3)
+	assert.Equal(t, "ScalingReplicaSet", result[0].Reason)
+	assert.Equal(t, "SuccessfulCreate", result[1].Reason)
+	assert.Equal(t, "FailedScheduling", result[2].Reason)
+}
+
+func TestEventProcessor_FilterDeploymentEvents_WithNameCollision_IgnoresOtherWorkloads(t *testing.T) {
+	// Arrange
+	processor := NewEventProcessor()
+	// ReplicaSet api-worker belongs to another owner, yet its name fits the "<deployment>-<hash>" pattern of api,
+	// and its pods fit "<deployment>-<hash>-<suffix>"; both match the selector of api
+	objects := &k8sPorts.DeploymentObjects{
+		Deployment: k8sPorts.EventObject{Kind: "Deployment", Name: "api", UID: "deploy-api"},
+		ReplicaSets: []k8sPorts.OwnedObject{
+			{Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-7d9f8c6b5", UID: "rs-api"}, ControllerUID: "deploy-api"},
+			{Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-worker", UID: "rs-worker"}, ControllerUID: "deploy-other"},
+		},
+		Pods: []k8sPorts.OwnedObject{
+			{Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-7d9f8c6b5-x2k4p", UID: "pod-api"}, ControllerUID: "rs-api"},
+			{Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-worker-q8m3z", UID: "pod-worker"}, ControllerUID: "rs-worker"},
+		},
+	}
+	events := []k8sPorts.Event{
+		{Reason: "FailedCreate", Object: k8sPorts.EventObject{Kind: "ReplicaSet", Name: "api-worker"}},
+		{Reason: "BackOff", Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-worker-q8m3z"}},
+		{Reason: "Unhealthy", Object: k8sPorts.EventObject{Kind: "Pod", Name: "api-7d9f8c6b5-x2k4p"}},
+	}
+
+	// Act
+	result := processor.FilterDeploymentEvents(events, objects)
+
+	// Assert
+	assert.Len(t, result, 1)
+	assert.Equal(t, "Unhealthy", result[0].Reason)
+}
+
+func TestEventProcessor_RecentWarnings_SortsNewestFirstAndLimits(t *testing.T) {
+	// Arrange
+	processor := NewEventProcessor()
+	now := time.Now()
+	events := []k8sPorts.Event{
+		{Type: "Warning", Reason: "BackOff", LastTime: now.Add(-10 * time.Minute)},
+		{Type: "Normal", Reason: "Pulled", LastTime: now},
+		{Type: "Warning", Reason: "FailedScheduling", LastTime: now.Add(-time.Minute)},
+		{Type: "Warning", Reason: "Unhealthy", LastTime: now.Add(-time.Hour)},
+	}
+
+	// Act
+	result := processor.RecentWarnings(events, 2)
+
+	// Assert
+	assert.Len(t, result, 2)
+	assert.Equal(t, "FailedScheduling", result[0].Reason)
+	assert.Equal(t, "BackOff", result[1].Reason)
+}
+
+func TestEventProcessor_FilterByType_WithEmptyType_ReturnsAll(t *testing.T) {
+	// Arrange
+	processor := NewEventProcessor()
+	events := []k8sPorts.Event{{Type: "Normal"}, {Type: "Warning"}}
+
+	// Act & Assert
+	assert.Len(t, processor.FilterByType(events, ""), 2)
+	assert.Len(t, processor.FilterByType(events, "warning"), 1)
+}
@@ -2,16 +2,23 @@ package logic
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
 )
 
 // HealthCalculator provides health calculation logic for Kubernetes resources
-type HealthCalculator struct{}
+type HealthCalculator struct {
+	resourceCalculator *ResourceCalculator
+}
 
 // NewHealthCalculator creates a new health calculator
 func NewHealthCalculator() *HealthCalculator {
-	return &HealthCalculator{}
+	return &HealthCalculator{
+		resourceCalculator: NewResourceCalculator(),
+	}
 }
 
 // CalculateDeploymentHealth calculates health status for a deployment
@@ -39,6 +46,75 @@ func (hc *HealthCalculator) CalculateDeploymentHealth(deployment *k8sModels.Depl
 	return DeploymentStatusUnhealthy
 }
 
+// CalculateStatefulSetHealth calculates health status for a stateful set
+func (hc *HealthCalculator) CalculateStatefulSetHealth(statefulSet *k8sModels.StatefulSet) DeploymentHealthStatus {
+	if statefulSet == nil {
+		return DeploymentStatusUnknown
+	}
+	return hc.calculateReplicaHealth(statefulSet.Replicas.Desired, statefulSet.Replicas.Ready)
+}
+
+// CalculateDaemonSetHealth calculates health status for a daemon set
+func (hc *HealthCalculator) CalculateDaemonSetHealth(daemonSet *k8sModels.DaemonSet) DeploymentHealthStatus {
+	if daemonSet == nil {
+		return DeploymentStatusUnknown
+	}
+	return hc.calculateReplicaHealth(daemonSet.Scheduling.Desired, daemonSet.Scheduling.Ready)
+}
+
+// CalculateJobHealth calculates health status for a job
+func (hc *HealthCalculator) CalculateJobHealth(job *k8sModels.Job) DeploymentHealthStatus {
+	if job == nil {
+		return DeploymentStatusUnknown
+	}
+
+	switch job.Status {
+	case k8sModels.JobStatusComplete:
+		return DeploymentStatusHealthy
+	case k8sModels.JobStatusFailed:
+		return DeploymentStatusUnhealthy
+	case k8sModels.JobStatusSuspended:
+		return DeploymentStatusStopped
+	case k8sModels.JobStatusRunning:
+		// Pods failing while the job is still retrying
+		if job.Failed > 0 {
+			return DeploymentStatusDegraded
+		}
+		return DeploymentStatusHealthy
+	default:
+		return DeploymentStatusUnknown
+	}
+}
+
+// CalculateCronJobHealth calculates health status for a cron job based on its last finished job
+func (hc *HealthCalculator) CalculateCronJobHealth(cronJob *k8sModels.CronJob) DeploymentHealthStatus {
+	if cronJob == nil {
+		return DeploymentStatusUnknown
+	}
+
+	if cronJob.Suspended {
+		return DeploymentStatusStopped
+	}
+	if cronJob.LastJobStatus == k8sModels.JobStatusFailed {
+		return DeploymentStatusUnhealthy
+	}
+	return DeploymentStatusHealthy
+}
+
+// calculateReplicaHealth maps desired and ready replicas to a health status
+func (hc *HealthCalculator) calculateReplicaHealth(desired, ready int32) DeploymentHealthStatus {
+	switch {
+	case desired == 0:
+		return DeploymentStatusStopped
+	case ready >= desired:
+		return DeploymentStatusHealthy
+	case ready > 0:
+		return DeploymentStatusDegraded
+	default:
+		return DeploymentStatusUnhealthy
+	}
+}
+
 // CalculatePodHealth calculates health status for a pod
 func (hc *HealthCalculator) CalculatePodHealth(pod *k8sModels.Pod) PodHealthStatus {
 	if pod == nil {
@@ -116,6 +192,114 @@ func (hc *HealthCalculator) CalculateClusterHealth(clusterInfo *k8sModels.Cluste
 	}
 }
 
+// BuildClusterHealth builds detailed cluster health with per-node and per-namespace health
+func (hc *HealthCalculator) BuildClusterHealth(clusterInfo *k8sModels.ClusterInfo, deployments []k8sModels.Deployment, pods []k8sModels.Pod) *k8sPorts.ClusterHealth {
+	now := time.Now()
+	health := &k8sPorts.ClusterHealth{
+		Context:     clusterInfo.Cluster.Context,
+		Status:      k8sModels.ClusterStatus(hc.CalculateClusterHealth(clusterInfo)),
+		Nodes:       []k8sPorts.NodeHealth{},
+		Namespaces:  []k8sPorts.NamespaceHealth{},
+		Summary:     clusterInfo.Summary,
+		LastUpdated: now,
+	}
+
+	for i := range clusterInfo.Nodes {
+		node := &clusterInfo.Nodes[i]
+		health.Nodes = append(health.Nodes, k8sPorts.NodeHealth{
+			Name:        node.Name,
+			Status:      node.Status,
+			Conditions:  node.Conditions,
+			Resources:   hc.resourceCalculator.CalculateNodeResourceHealth(node),
+			LastUpdated: now,
+		})
+	}
+
+	namespaces := make(map[string]*k8sPorts.NamespaceHealth)
+	for _, namespace := range clusterInfo.Namespaces {
+		namespaces[namespace.Name] = &k8sPorts.NamespaceHealth{
+			Name:        namespace.Name,
+			Status:      namespace.Status,
+			Deployments: []k8sPorts.DeploymentHealth{},
+			Pods:        []k8sPorts.PodHealth{},
+			LastUpdated: now,
+		}
+	}
+
+	for i := range deployments {
+		if namespaceHealth, exists := namespaces[deployments[i].Namespace]; exists {
+			namespaceHealth.Deployments = append(namespaceHealth.Deployments, hc.buildDeploymentHealth(&deployments[i], now))
+		}
+	}
+
+	for i := range pods {
+		if namespaceHealth, exists := namespaces[pods[i].Namespace]; exists {
+			namespaceHealth.Pods = append(namespaceHealth.Pods, hc.buildPodHealth(&pods[i], now))
+		}
+	}
+
+	for _, namespaceHealth := range namespaces {
+		health.Namespaces = append(health.Namespaces, *namespaceHealth)
+	}
+	sort.Slice(health.Namespaces, func(i, j int) bool {
+		return health.Namespaces[i].Name < health.Namespaces[j].Name
+	})
+
+	return health
+}
+
+// buildDeploymentHealth builds deployment health information
+func (hc *HealthCalculator) buildDeploymentHealth(deployment *k8sModels.Deployment, now time.Time) k8sPorts.DeploymentHealth {
+	return k8sPorts.DeploymentHealth{
+		Name:                deployment.Name,
+		Namespace:           deployment.Namespace,
+		Status:              string(hc.CalculateDeploymentHealth(deployment)),
+		Replicas:            deployment.Replicas,
+		Conditions:          deployment.Conditions,
+		AvailabilityPercent: deployment.GetAvailabilityPercentage(),
+		LastUpdated:         now,
+	}
+}
+
+// buildPodHealth builds pod health information
+func (hc *HealthCalculator) buildPodHealth(pod *k8sModels.Pod, now time.Time) k8sPorts.PodHealth {
+	containers := make([]k8sPorts.ContainerHealth, 0, len(pod.Containers))
+	for _, container := range pod.Containers {
+		containers = append(containers, k8sPorts.ContainerHealth{
+			Name:         container.Name,
+			Ready:        container.Ready,
+			RestartCount: container.RestartCount,
+			State:        containerStateName(container.State),
+			LastUpdated:  now,
+		})
+	}
+
+	return k8sPorts.PodHealth{
+		Name:        pod.Name,
+		Namespace:   pod.Namespace,
+		Status:      pod.Status,
+		Phase:       pod.Phase,
+		Ready:       pod.IsReady(),
+		Restarts:    pod.GetTotalRestarts(),
+		Containers:  containers,
+		LastUpdated: now,
+	}
+}
+
+// containerStateName returns a short name for a container state
+func containerStateName(state k8sModels.ContainerState) string {
+	switch {
+	case state.Running != nil:
+		return "running"
+	case state.Waiting != nil:
+		return "waiting"
+	case state.Terminated != nil:
+		return "terminated"
+	default:
+		return "unknown"
+	}
+}
+
 // GetDeploymentHealthSummary provides a summary of deployment health issues
 func (hc *HealthCalculator) GetDeploymentHealthSummary(deployment *k8sModels.Deployment) *DeploymentHealthSummary {
 	if deployment == nil {
@@ -88,6 +88,72 @@ func TestHealthCalculator_CalculateDeploymentHealth_WithStoppedDeployment_Return
 	assert.Equal(t, DeploymentStatusStopped, result)
 }
 
+func TestHealthCalculator_CalculateStatefulSetHealth_WithPartiallyReadyReplicas_ReturnsDegraded(t *testing.T) {
+	// Arrange
+	calculator := NewHealthCalculator()
+	statefulSet := &k8sModels.StatefulSet{
+		Replicas: k8sModels.DeploymentReplicas{
+			Desired: 3,
+			Ready:   1,
+		},
+	}
+
+	// Act
+	result := calculator.CalculateStatefulSetHealth(statefulSet)
+
+	// Assert
+	assert.Equal(t, DeploymentStatusDegraded, result)
+}
+
+func TestHealthCalculator_CalculateDaemonSetHealth_WithNoScheduledNodes_ReturnsStopped(t *testing.T) {
+	// Arrange
+	calculator := NewHealthCalculator()
+	daemonSet := &k8sModels.DaemonSet{}
+
+	// Act
+	result := calculator.CalculateDaemonSetHealth(daemonSet)
+
+	// Assert
+	assert.Equal(t, DeploymentStatusStopped, result)
+}
+
+func TestHealthCalculator_CalculateJobHealth_WithRunningJobAndFailures_ReturnsDegraded(t *testing.T) {
+	// Arrange
+	calculator := NewHealthCalculator()
+	job := &k8sModels.Job{
+		Status: k8sModels.JobStatusRunning,
+		Active: 1,
+		Failed: 2,
+	}
+
+	// Act
+	result := calculator.CalculateJobHealth(job)
+
+	// Assert
+	assert.Equal(t, DeploymentStatusDegraded, result)
+}
+
+func TestHealthCalculator_CalculateCronJobHealth_WithLastJobFailed_ReturnsUnhealthy(t *testing.T) {
+	// Arrange
+	calculator := NewHealthCalculator()
+	cronJob := &k8sModels.CronJob{
+		Schedule:      "*/5 * * * *",
+		LastJobStatus: k8sModels.JobStatusFailed,
+	}
+	suspended := &k8sModels.CronJob{
+		Suspended:     true,
+		LastJobStatus: k8sModels.JobStatusFailed,
+	}
+
+	// Act
+	result := calculator.CalculateCronJobHealth(cronJob)
+	suspendedResult := calculator.CalculateCronJobHealth(suspended)
+
+	// Assert
+	assert.Equal(t, DeploymentStatusUnhealthy, result)
+	assert.Equal(t, DeploymentStatusStopped, suspendedResult)
+}
+
 func TestHealthCalculator_CalculatePodHealth_WithNilPod_ReturnsUnknown(t *testing.T) {
 	// Arrange
 	calculator := NewHealthCalculator()
@@ -393,3 +459,52 @@ func TestHealthCalculator_GetDeploymentHealthSummary_WithNoReplicasReadyDeployme
 	assert.Equal(t, DeploymentStatusUnhealthy, summary.Status)
 	assert.Len(t, summary.Issues, 1)
 }
+
+func TestHealthCalculator_BuildClusterHealth_WithWorkloads_GroupsByNamespace(t *testing.T) {
+	// Arrange
+	calculator := NewHealthCalculator()
+	clusterInfo := &k8sModels.ClusterInfo{
+		Cluster: k8sModels.Cluster{
+			Context: "prod",
+			Status:  k8sModels.ClusterStatusConnected,
+		},
+		Nodes: []k8sModels.Node{
+			{
+				Name:   "node-1",
+				Status: k8sModels.NodeStatusReady,
+				Resources: k8sModels.NodeResources{
+					Allocatable: k8sModels.ResourceList{CPU: "2", Memory: "4Gi", Pods: "110"},
+					Used:        k8sModels.ResourceList{CPU: "1", Memory: "1Gi", Pods: "2"},
+				},
+			},
+		},
+		Namespaces: []k8sModels.Namespace{
+			{Name: "payments", Status: k8sModels.NamespaceStatusActive},
+			{Name: "default", Status: k8sModels.NamespaceStatusActive},
+		},
+	}
+	deployments := []k8sModels.Deployment{
+		{Name: "api", Namespace: "payments", Replicas: k8sModels.DeploymentReplicas{Desired: 2, Ready: 1}},
+	}
+	pods := []k8sModels.Pod{
+		{Name: "api-1", Namespace: "payments", Status: k8sModels.PodStatusRunning, Phase: "Running"},
+		{Name: "orphan", Namespace: "unknown", Status: k8sModels.PodStatusRunning},
+	}
+
+	// Act
+	result := calculator.BuildClusterHealth(clusterInfo, deployments, pods)
+
+	// Assert
+	assert.Equal(t, "prod", result.Context)
+	assert.Equal(t, k8sModels.ClusterStatus(ClusterHealthStatusHealthy), result.Status)
+	assert.Len(t, result.Nodes, 1)
+	assert.InDelta(t, 50.0, result.Nodes[0].Resources.CPU.UtilizationPercent, 0.001)
+	assert.Len(t, result.Namespaces, 2)
+	assert.Equal(t, "default", result.Namespaces[0].Name)
+	assert.Empty(t, result.Namespaces[0].Deployments)
+	assert.Equal(t, "payments", result.Namespaces[1].Name)
+	assert.Len(t, result.Namespaces[1].Deployments, 1)
+	assert.Equal(t, string(DeploymentStatusDegraded), result.Namespaces[1].Deployments[0].Status)
+	assert.Len(t, result.Namespaces[1].Pods, 1)
+	assert.Equal(t, "Running", result.Namespaces[1].Pods[0].Phase)
+}
@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+var (
+	// ErrContainerNotFound is returned when the requested container is not part of the pod
+	ErrContainerNotFound = errors.New("container not found")
+	// ErrContainerRequired is returned when a multi-container pod is read without choosing a container
+	ErrContainerRequired = errors.New("container required")
+)
+
+// LogProcessor provides parsing logic for container log streams
+type LogProcessor struct{}
+
+// NewLogProcessor creates a new log processor
+func NewLogProcessor() *LogProcessor {
+	return &LogProcessor{}
+}
+
+// ResolveContainer selects the container to read logs from.
+// Pods with a single container default to it; multi-container pods require an explicit choice.
+func (lp *LogProcessor) ResolveContainer(pod *k8sModels.Pod, containerName string) (string, error) {
+	names := make([]string, 0, len(pod.Containers))
+	for _, container := range pod.Containers {
+		if containerName != "" && container.Name == containerName {
+			return containerName, nil
+		}
+		names = append(names, container.Name)
+	}
+
+	if containerName != "" {
+		return "", fmt.Errorf("%w: container %s not found in pod %s, choose one of: %s", ErrContainerNotFound, containerName, pod.Name, strings.Join(names, ", "))
+	}
+	if len(names) == 1 {
+		return names[0], nil
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("pod %s has no containers", pod.Name)
+	}
+	return "", fmt.Errorf("%w: pod %s has multiple containers, choose one of: %s", ErrContainerRequired, pod.Name, strings.Join(names, ", "))
+}
+
+// ParseLine builds a log entry from a raw log line read with the given filter.
+// When timestamps are enabled the API server prefixes each line with an RFC3339 timestamp.
+func (lp *LogProcessor) ParseLine(line string, filter *k8sModels.LogFilter) k8sModels.ContainerLog {
+	log := k8sModels.ContainerLog{
+		ContainerName: filter.ContainerName,
+		PodName:       filter.PodName,
+		Namespace:     filter.Namespace,
+		Message:       line,
+		Timestamp:     time.Now(), // Default to receive time
+	}
+
+	if filter.Timestamps {
+		if prefix, message, found := strings.Cut(line, " "); found {
+			if timestamp, err := time.Parse(time.RFC3339Nano, prefix); err == nil {
+				log.Timestamp = timestamp
+				log.Message = message
+			}
+		}
+	}
+
+	log.Level = lp.DetermineLogLevel(log.Message)
+	return log
+}
+
+// DetermineLogLevel determines log level from message content
+func (lp *LogProcessor) DetermineLogLevel(message string) string {
+	message = strings.ToUpper(message)
+
+	switch {
+	case strings.Contains(message, "ERROR") || strings.Contains(message, "FATAL"):
+		return "ERROR"
+	case strings.Contains(message, "WARN"):
+		return "WARN"
+	case strings.Contains(message, "DEBUG"):
+		return "DEBUG"
+	case strings.Contains(message, "TRACE"):
+		return "TRACE"
+	default:
+		return "INFO"
+	}
+}
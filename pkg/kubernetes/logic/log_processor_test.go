@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestLogProcessor_ResolveContainer_WithSingleContainer_DefaultsToIt(t *testing.T) {
+	// Arrange
+	processor := NewLogProcessor()
+	pod := &k8sModels.Pod{Name: "api-0", Containers: []k8sModels.Container{{Name: "api"}}}
+
+	// Act
+	container, err := processor.ResolveContainer(pod, "")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "api", container)
+}
+
+func TestLogProcessor_ResolveContainer_WithMultipleContainers_RequiresSelection(t *testing.T) {
+	// Arrange
+	processor := NewLogProcessor()
+	pod := &k8sModels.Pod{Name: "api-0", Containers: []k8sModels.Container{{Name: "api"}, {Name: "envoy"}}}
+
+	// Act
+	_, err := processor.ResolveContainer(pod, "")
+	container, selectErr := processor.ResolveContainer(pod, "envoy")
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrContainerRequired))
+	assert.Contains(t, err.Error(), "choose one of: api, envoy")
+	assert.NoError(t, selectErr)
+	assert.Equal(t, "envoy", container)
+}
+
+func TestLogProcessor_ResolveContainer_WithUnknownContainer_ReturnsError(t *testing.T) {
+	// Arrange
+	processor := NewLogProcessor()
+	pod := &k8sModels.Pod{Name: "api-0", Containers: []k8sModels.Container{{Name: "api"}}}
+
+	// Act
+	_, err := processor.ResolveContainer(pod, "sidecar")
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrContainerNotFound))
+	assert.Contains(t, err.Error(), "container sidecar not found in pod api-0")
+}
+
+func TestLogProcessor_ParseLine_WithTimestamps_SplitsTimestampAndMessage(t *testing.T) {
+	// Arrange
+	processor := NewLogProcessor()
+	filter := &k8sModels.LogFilter{Namespace: "default", PodName: "api-0", ContainerName: "api", Timestamps: true}
+
+	// Act
+	log := processor.ParseLine("2024-03-01T10:30:45.123456789Z ERROR connection refused", filter)
+
+	// Assert
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 30, 45, 123456789, time.UTC), log.Timestamp)
+	assert.Equal(t, "ERROR connection refused", log.Message)
+	assert.Equal(t, "ERROR", log.Level)
+	assert.Equal(t, "api", log.ContainerName)
+}
+
+func TestLogProcessor_ParseLine_WithoutTimestamps_KeepsWholeLine(t *testing.T) {
+	// Arrange
+	processor := NewLogProcessor()
+	filter := &k8sModels.LogFilter{Namespace: "default", PodName: "api-0", ContainerName: "api"}
+
+	// Act
+	log := processor.ParseLine("2024-03-01T10:30:45Z warn: slow request", filter)
+
+	// Assert
+	assert.Equal(t, "2024-03-01T10:30:45Z warn: slow request", log.Message)
+	assert.Equal(t, "WARN", log.Level)
+}
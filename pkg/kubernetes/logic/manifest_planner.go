@@ -0,0 +1,320 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+var (
+	// ErrInvalidManifest is returned when a manifest cannot be previewed
+	ErrInvalidManifest = errors.New("invalid manifest")
+	// ErrInvalidDeploymentChange is returned when a deployment change is empty or malformed
+	ErrInvalidDeploymentChange = errors.New("invalid deployment change")
+)
+
+// ignoredMetadataFields are set by the API server and would show up in every diff
+var ignoredMetadataFields = []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"}
+
+// ManifestPlanner prepares apply configurations and diffs them against live objects
+type ManifestPlanner struct{}
+
+// NewManifestPlanner creates a new manifest planner
+func NewManifestPlanner() *ManifestPlanner {
+	return &ManifestPlanner{}
+}
+
+// ParseManifest decodes a single YAML or JSON object into an apply configuration for the namespace.
+// A missing namespace is defaulted; a different namespace is refused so permissions stay per namespace.
+func (mp *ManifestPlanner) ParseManifest(manifest, namespace string) (map[string]interface{}, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	var object map[string]interface{}
+	for object == nil {
+		if err := decoder.Decode(&object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("%w: manifest is empty", ErrInvalidManifest)
+			}
+			return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err.Error())
+		}
+	}
+
+	var extra map[string]interface{}
+	for {
+		err := decoder.Decode(&extra)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidManifest, err.Error())
+		}
+		if extra != nil {
+			return nil, fmt.Errorf("%w: manifest must contain a single object", ErrInvalidManifest)
+		}
+	}
+
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+	if apiVersion == "" || kind == "" {
+		return nil, fmt.Errorf("%w: apiVersion and kind are required", ErrInvalidManifest)
+	}
+
+	metadata, _ := object["metadata"].(map[string]interface{})
+	if metadata == nil {
+		return nil, fmt.Errorf("%w: metadata is required", ErrInvalidManifest)
+	}
+	if name, _ := metadata["name"].(string); name == "" {
+		return nil, fmt.Errorf("%w: metadata.name is required", ErrInvalidManifest)
+	}
+	if _, generated := metadata["generateName"]; generated {
+		return nil, fmt.Errorf("%w: generateName is not supported", ErrInvalidManifest)
+	}
+
+	switch objectNamespace, _ := metadata["namespace"].(string); objectNamespace {
+	case "":
+		metadata["namespace"] = namespace
+	case namespace:
+	default:
+		return nil, fmt.Errorf("%w: manifest namespace %s does not match %s", ErrInvalidManifest, objectNamespace, namespace)
+	}
+
+	// Server-managed fields in a copied manifest would make the apply fail or conflict
+	for _, field := range ignoredMetadataFields {
+		delete(metadata, field)
+	}
+	delete(object, "status")
+
+	return object, nil
+}
+
+// BuildDeploymentApply builds an apply configuration that only sets the changed container fields.
+// Containers and env vars are merged by name, so untouched fields keep their current values.
+func (mp *ManifestPlanner) BuildDeploymentApply(namespace string, change k8sModels.DeploymentChange) (map[string]interface{}, error) {
+	if change.Name == "" {
+		return nil, fmt.Errorf("%w: deployment name is required", ErrInvalidDeploymentChange)
+	}
+	if len(change.Containers) == 0 {
+		return nil, fmt.Errorf("%w: at least one container change is required", ErrInvalidDeploymentChange)
+	}
+
+	containers := make([]interface{}, 0, len(change.Containers))
+	seen := make(map[string]bool, len(change.Containers))
+	for _, containerChange := range change.Containers {
+		if containerChange.Name == "" {
+			return nil, fmt.Errorf("%w: container name is required", ErrInvalidDeploymentChange)
+		}
+		if seen[containerChange.Name] {
+			return nil, fmt.Errorf("%w: container %s is listed twice", ErrInvalidDeploymentChange, containerChange.Name)
+		}
+		seen[containerChange.Name] = true
+
+		container, err := mp.buildContainerApply(containerChange)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, container)
+	}
+
+	return map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      change.Name,
+			"namespace": namespace,
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": containers,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildContainerApply builds the apply configuration of a single container change
+func (mp *ManifestPlanner) buildContainerApply(change k8sModels.ContainerChange) (map[string]interface{}, error) {
+	container := map[string]interface{}{"name": change.Name}
+
+	if change.Image != "" {
+		container["image"] = change.Image
+	}
+
+	if len(change.Env) > 0 {
+		names := make([]string, 0, len(change.Env))
+		for name := range change.Env {
+			if name == "" {
+				return nil, fmt.Errorf("%w: env var name of container %s is empty", ErrInvalidDeploymentChange, change.Name)
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		env := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			env = append(env, map[string]interface{}{"name": name, "value": change.Env[name]})
+		}
+		container["env"] = env
+	}
+
+	resources := make(map[string]interface{})
+	for field, quantities := range map[string]map[string]string{"requests": change.Requests, "limits": change.Limits} {
+		if len(quantities) == 0 {
+			continue
+		}
+		values := make(map[string]interface{}, len(quantities))
+		for name, value := range quantities {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				return nil, fmt.Errorf("%w: invalid %s %s %q of container %s", ErrInvalidDeploymentChange, field, name, value, change.Name)
+			}
+			values[name] = value
+		}
+		resources[field] = values
+	}
+	if len(resources) > 0 {
+		container["resources"] = resources
+	}
+
+	if len(container) == 1 {
+		return nil, fmt.Errorf("%w: container %s has nothing to change", ErrInvalidDeploymentChange, change.Name)
+	}
+	return container, nil
+}
+
+// Diff compares a live object with the result of a dry-run apply.
+// A nil live object means the apply creates it, so every field is reported as added.
+func (mp *ManifestPlanner) Diff(live, desired map[string]interface{}) []k8sModels.ManifestChange {
+	changes := make([]k8sModels.ManifestChange, 0)
+	diffValues("", normalizeObject(live), normalizeObject(desired), &changes)
+	return changes
+}
+
+// normalizeObject returns a copy of the object without status and server-managed metadata
+func normalizeObject(object map[string]interface{}) map[string]interface{} {
+	if object == nil {
+		return map[string]interface{}{}
+	}
+
+	normalized := runtime.DeepCopyJSON(object)
+	delete(normalized, "status")
+	if metadata, ok := normalized["metadata"].(map[string]interface{}); ok {
+		for _, field := range ignoredMetadataFields {
+			delete(metadata, field)
+		}
+	}
+	return normalized
+}
+
+// diffValues walks two JSON values and records the differing leaves
+func diffValues(path string, before, after interface{}, changes *[]k8sModels.ManifestChange) {
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if beforeIsMap && afterIsMap {
+		keys := make(map[string]bool, len(beforeMap)+len(afterMap))
+		for key := range beforeMap {
+			keys[key] = true
+		}
+		for key := range afterMap {
+			keys[key] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		for _, key := range sorted {
+			diffValues(joinFieldPath(path, key), beforeMap[key], afterMap[key], changes)
+		}
+		return
+	}
+
+	beforeList, beforeIsList := before.([]interface{})
+	afterList, afterIsList := after.([]interface{})
+	if beforeIsList && afterIsList {
+		if beforeNamed, ok := indexByName(beforeList); ok {
+			if afterNamed, ok := indexByName(afterList); ok {
+				for _, name := range mergedNames(beforeList, afterList) {
+					diffValues(path+"["+name+"]", beforeNamed[name], afterNamed[name], changes)
+				}
+				return
+			}
+		}
+		if len(beforeList) == len(afterList) {
+			for i := range beforeList {
+				diffValues(path+"["+strconv.Itoa(i)+"]", beforeList[i], afterList[i], changes)
+			}
+			return
+		}
+	}
+
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+
+	change := k8sModels.ManifestChange{Path: path, Before: before, After: after}
+	switch {
+	case before == nil:
+		change.Operation = k8sModels.ManifestFieldAdded
+	case after == nil:
+		change.Operation = k8sModels.ManifestFieldRemoved
+	default:
+		change.Operation = k8sModels.ManifestFieldChanged
+	}
+	*changes = append(*changes, change)
+}
+
+// joinFieldPath appends a field to a dotted path
+func joinFieldPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// indexByName indexes list items by their name field, such as containers or env vars.
+// It reports false when any item is not an object with a unique name.
+func indexByName(items []interface{}) (map[string]interface{}, bool) {
+	named := make(map[string]interface{}, len(items))
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := object["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		if _, duplicate := named[name]; duplicate {
+			return nil, false
+		}
+		named[name] = object
+	}
+	return named, true
+}
+
+// mergedNames returns item names in their live order followed by names only present after the apply
+func mergedNames(before, after []interface{}) []string {
+	names := make([]string, 0, len(before)+len(after))
+	seen := make(map[string]bool, len(before)+len(after))
+	for _, items := range [][]interface{}{before, after} {
+		for _, item := range items {
+			name := item.(map[string]interface{})["name"].(string)
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,152 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestManifestPlanner_ParseManifest_WithoutNamespace_DefaultsNamespace(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  resourceVersion: "42"
+data:
+  mode: fast
+`
+
+	// Act
+	object, err := planner.ParseManifest(manifest, "team-payments")
+
+	// Assert
+	require.NoError(t, err)
+	metadata := object["metadata"].(map[string]interface{})
+	assert.Equal(t, "team-payments", metadata["namespace"])
+	assert.NotContains(t, metadata, "resourceVersion")
+	assert.Equal(t, "fast", object["data"].(map[string]interface{})["mode"])
+}
+
+func TestManifestPlanner_ParseManifest_WithInvalidManifests_ReturnsInvalidManifest(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	manifests := map[string]string{
+		"empty":              "",
+		"missing kind":       "apiVersion: v1\nmetadata:\n  name: settings\n",
+		"missing name":       "apiVersion: v1\nkind: ConfigMap\nmetadata: {}\n",
+		"other namespace":    "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n  namespace: kube-system\n",
+		"multiple documents": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: b\n",
+	}
+
+	for name, manifest := range manifests {
+		// Act
+		_, err := planner.ParseManifest(manifest, "team-payments")
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidManifest), name)
+	}
+}
+
+func TestManifestPlanner_BuildDeploymentApply_SetsOnlyChangedContainerFields(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	change := k8sModels.DeploymentChange{
+		Name: "api",
+		Containers: []k8sModels.ContainerChange{{
+			Name:   "app",
+			Image:  "registry/api:v2",
+			Env:    map[string]string{"LOG_LEVEL": "debug"},
+			Limits: map[string]string{"memory": "512Mi"},
+		}},
+	}
+
+	// Act
+	object, err := planner.BuildDeploymentApply("team-payments", change)
+
+	// Assert
+	require.NoError(t, err)
+	containers := object["spec"].(map[string]interface{})["template"].(map[string]interface{})["spec"].(map[string]interface{})["containers"].([]interface{})
+	require.Len(t, containers, 1)
+	container := containers[0].(map[string]interface{})
+	assert.Equal(t, "registry/api:v2", container["image"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"name": "LOG_LEVEL", "value": "debug"}}, container["env"])
+	assert.Equal(t, map[string]interface{}{"limits": map[string]interface{}{"memory": "512Mi"}}, container["resources"])
+}
+
+func TestManifestPlanner_BuildDeploymentApply_WithInvalidChange_ReturnsInvalidDeploymentChange(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	changes := map[string]k8sModels.DeploymentChange{
+		"no containers":    {Name: "api"},
+		"nothing to set":   {Name: "api", Containers: []k8sModels.ContainerChange{{Name: "app"}}},
+		"invalid quantity": {Name: "api", Containers: []k8sModels.ContainerChange{{Name: "app", Requests: map[string]string{"cpu": "lots"}}}},
+		"duplicate":        {Name: "api", Containers: []k8sModels.ContainerChange{{Name: "app", Image: "a"}, {Name: "app", Image: "b"}}},
+	}
+
+	for name, change := range changes {
+		// Act
+		_, err := planner.BuildDeploymentApply("team-payments", change)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidDeploymentChange), name)
+	}
+}
+
+func TestManifestPlanner_Diff_ReportsChangedFieldsByContainerName(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "api", "resourceVersion": "1"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "api:v1"},
+				map[string]interface{}{"name": "proxy", "image": "envoy:v1"},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(2)},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "api", "resourceVersion": "2"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "api:v2", "env": []interface{}{map[string]interface{}{"name": "A", "value": "1"}}},
+				map[string]interface{}{"name": "proxy", "image": "envoy:v1"},
+			},
+		},
+	}
+
+	// Act
+	changes := planner.Diff(live, desired)
+
+	// Assert
+	assert.Equal(t, []k8sModels.ManifestChange{
+		{Path: "spec.containers[app].env", Operation: k8sModels.ManifestFieldAdded, After: []interface{}{map[string]interface{}{"name": "A", "value": "1"}}},
+		{Path: "spec.containers[app].image", Operation: k8sModels.ManifestFieldChanged, Before: "api:v1", After: "api:v2"},
+	}, changes)
+}
+
+func TestManifestPlanner_Diff_WithoutLiveObject_ReportsTopLevelFieldsAdded(t *testing.T) {
+	// Arrange
+	planner := NewManifestPlanner()
+	desired := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "settings", "uid": "abc"},
+	}
+
+	// Act
+	changes := planner.Diff(nil, desired)
+
+	// Assert
+	require.Len(t, changes, 3)
+	assert.Equal(t, "apiVersion", changes[0].Path)
+	assert.Equal(t, k8sModels.ManifestFieldAdded, changes[2].Operation)
+	assert.Equal(t, map[string]interface{}{"name": "settings"}, changes[2].After)
+}
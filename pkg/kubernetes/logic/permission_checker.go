@@ -0,0 +1,235 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// ErrPermissionDenied is returned when a user is not allowed to perform an operation
+var ErrPermissionDenied = errors.New("permission denied")
+
+// PermissionChecker enforces the per-cluster Kubernetes permission model
+type PermissionChecker struct {
+	permissions map[string]k8sModels.Permission
+}
+
+// NewPermissionChecker creates a new permission checker from permissions keyed by cluster context
+func NewPermissionChecker(permissions map[string]k8sModels.Permission) *PermissionChecker {
+	if permissions == nil {
+		permissions = make(map[string]k8sModels.Permission)
+	}
+	return &PermissionChecker{
+		permissions: permissions,
+	}
+}
+
+// GetPermission returns the permission configured for a cluster context
+func (pc *PermissionChecker) GetPermission(context string) k8sModels.Permission {
+	return pc.permissions[context]
+}
+
+// Authorize checks if a user may perform an operation, named by its RBAC permission.
+// Central roles decide when configured and the cluster's group lists otherwise;
+// namespaces outside the cluster's allowed set are refused either way.
+func (pc *PermissionChecker) Authorize(user *commonsModels.UserData, rbacPermission, context, namespace string) error {
+	if !user.HasRBAC() {
+		var userGroups []string
+		if user != nil {
+			userGroups = user.Groups
+		}
+		return pc.checkGroups(rbacPermission, context, namespace, userGroups)
+	}
+
+	if namespace != "" {
+		permission := pc.GetPermission(context)
+		if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+			return err
+		}
+	}
+	if !user.Can(rbacPermission, commonsModels.PermissionScope{Cluster: context, Namespace: namespace}) {
+		return fmt.Errorf("%w: no role grants %s in cluster %s", ErrPermissionDenied, rbacPermission, context)
+	}
+	return nil
+}
+
+// checkGroups checks an operation against the group lists of the cluster
+func (pc *PermissionChecker) checkGroups(rbacPermission, context, namespace string, userGroups []string) error {
+	switch rbacPermission {
+	case k8sModels.RBACDeploymentsScale:
+		return pc.CheckScale(context, namespace, userGroups)
+	case k8sModels.RBACDeploymentsRestart:
+		return pc.CheckRestart(context, namespace, userGroups)
+	case k8sModels.RBACDeploymentsRollback:
+		return pc.CheckRollback(context, namespace, userGroups)
+	case k8sModels.RBACPodsDelete:
+		return pc.CheckPodDelete(context, namespace, userGroups)
+	case k8sModels.RBACPodsExec:
+		return pc.CheckExec(context, namespace, userGroups)
+	case k8sModels.RBACCronJobsManage:
+		return pc.CheckCronJobManagement(context, namespace, userGroups)
+	case k8sModels.RBACConfigMapsValues:
+		return pc.CheckConfigMapValues(context, namespace, userGroups)
+	case k8sModels.RBACResourcesApply:
+		return pc.CheckApply(context, namespace, userGroups)
+	case k8sModels.RBACNodesMaintain:
+		return pc.CheckNodeMaintenance(context, userGroups)
+	case k8sModels.RBACNamespacesManage:
+		return pc.CheckNamespaceManagement(context, namespace, userGroups)
+	default:
+		return fmt.Errorf("%w: unknown permission %s", ErrPermissionDenied, rbacPermission)
+	}
+}
+
+// CheckScale checks if user groups allow scaling deployments in a namespace
+func (pc *PermissionChecker) CheckScale(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasScalePermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to scale deployments in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckRestart checks if user groups allow restarting deployments in a namespace
+func (pc *PermissionChecker) CheckRestart(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasRestartPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to restart deployments in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckPodDelete checks if user groups allow deleting pods in a namespace.
+// Deleting a pod restarts it, so it requires the restart permission.
+func (pc *PermissionChecker) CheckPodDelete(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasRestartPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to delete pods in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckRollback checks if user groups allow rolling back deployments in a namespace.
+// Rolling back replaces the pod template and restarts pods, so it requires the restart permission.
+func (pc *PermissionChecker) CheckRollback(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasRestartPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to roll back deployments in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckCronJobManagement checks if user groups allow suspending, resuming or triggering cron jobs.
+// Running a job on demand is comparable to a restart, so it requires the restart permission.
+func (pc *PermissionChecker) CheckCronJobManagement(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasRestartPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to manage cron jobs in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckConfigMapValues checks if user groups allow reading config map values in a namespace
+func (pc *PermissionChecker) CheckConfigMapValues(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasConfigMapValuesPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to read config map values in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckExec checks if user groups allow executing commands in pods of a namespace
+func (pc *PermissionChecker) CheckExec(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasExecPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to exec into pods in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckApply checks if user groups allow previewing and applying changes in a namespace
+func (pc *PermissionChecker) CheckApply(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasApplyPermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to apply changes in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckNodeMaintenance checks if user groups allow cordoning, uncordoning and draining nodes
+func (pc *PermissionChecker) CheckNodeMaintenance(context string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if !permission.HasNodeMaintenancePermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to perform node maintenance in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// CheckNamespaceManagement checks if user groups allow creating or deleting a namespace.
+// It requires the namespace to be allowed and the user to hold restart or scale permission.
+func (pc *PermissionChecker) CheckNamespaceManagement(context, namespace string, userGroups []string) error {
+	permission := pc.GetPermission(context)
+	if err := pc.checkNamespace(&permission, context, namespace); err != nil {
+		return err
+	}
+	if !permission.HasRestartPermission(userGroups) && !permission.HasScalePermission(userGroups) {
+		return fmt.Errorf("%w: user is not in any group allowed to manage namespaces in cluster %s", ErrPermissionDenied, context)
+	}
+	return nil
+}
+
+// IsNamespaceAllowed checks if a namespace is within the allowed set for a cluster
+func (pc *PermissionChecker) IsNamespaceAllowed(context, namespace string) bool {
+	permission := pc.GetPermission(context)
+	return permission.IsNamespaceAllowed(namespace)
+}
+
+// FilterNamespaces keeps only the namespaces allowed for a cluster
+func (pc *PermissionChecker) FilterNamespaces(context string, namespaces []k8sModels.Namespace) []k8sModels.Namespace {
+	permission := pc.GetPermission(context)
+	if len(permission.Deployments.Namespaces) == 0 {
+		return namespaces
+	}
+
+	filtered := make([]k8sModels.Namespace, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if permission.IsNamespaceAllowed(namespace.Name) {
+			filtered = append(filtered, namespace)
+		}
+	}
+	return filtered
+}
+
+// checkNamespace returns a permission error if the namespace is outside the allowed set
+func (pc *PermissionChecker) checkNamespace(permission *k8sModels.Permission, context, namespace string) error {
+	if !permission.IsNamespaceAllowed(namespace) {
+		return fmt.Errorf("%w: namespace %s is not allowed in cluster %s", ErrPermissionDenied, namespace, context)
+	}
+	return nil
+}
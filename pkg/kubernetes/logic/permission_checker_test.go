@@ -0,0 +1,238 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func newTestPermissionChecker() *PermissionChecker {
+	return NewPermissionChecker(map[string]k8sModels.Permission{
+		"prod": {
+			Deployments: k8sModels.DeploymentsPermissions{
+				Namespaces: []string{"default", "team-*"},
+				Restart:    []string{"dash-ops*developers"},
+				Scale:      []string{"dash-ops*sre"},
+			},
+			Exec:  []string{"dash-ops*sre"},
+			Nodes: k8sModels.NodesPermissions{Maintenance: []string{"dash-ops*sre"}},
+			Apply: []string{"dash-ops*sre"},
+		},
+	})
+}
+
+func TestPermissionChecker_CheckScale_WithAllowedGroup_ReturnsNil(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckScale("prod", "team-payments", []string{"DASH-OPS*SRE"})
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPermissionChecker_CheckScale_WithoutAllowedGroup_ReturnsPermissionDenied(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckScale("prod", "default", []string{"dash-ops*developers"})
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+	assert.Contains(t, err.Error(), "allowed to scale deployments in cluster prod")
+}
+
+func TestPermissionChecker_CheckRestart_WithNamespaceOutsideAllowedSet_ReturnsPermissionDenied(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckRestart("prod", "kube-system", []string{"dash-ops*developers"})
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+	assert.Contains(t, err.Error(), "namespace kube-system is not allowed")
+}
+
+func TestPermissionChecker_CheckRollback_RequiresRestartGroup(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	allowedErr := checker.CheckRollback("prod", "default", []string{"dash-ops*developers"})
+	deniedErr := checker.CheckRollback("prod", "default", []string{"dash-ops*sre"})
+
+	// Assert
+	assert.NoError(t, allowedErr)
+	assert.True(t, errors.Is(deniedErr, ErrPermissionDenied))
+	assert.Contains(t, deniedErr.Error(), "allowed to roll back deployments")
+}
+
+func TestPermissionChecker_CheckConfigMapValues_WithoutConfiguredGroups_ReturnsPermissionDenied(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckConfigMapValues("prod", "default", []string{"dash-ops*sre"})
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+	assert.Contains(t, err.Error(), "read config map values")
+}
+
+func TestPermissionChecker_CheckExec_WithExecGroupOnlyInAllowedNamespace_ReturnsNil(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	allowedErr := checker.CheckExec("prod", "team-payments", []string{"dash-ops*sre"})
+	deniedErr := checker.CheckExec("prod", "team-payments", []string{"dash-ops*developers"})
+	namespaceErr := checker.CheckExec("prod", "kube-system", []string{"dash-ops*sre"})
+
+	// Assert
+	assert.NoError(t, allowedErr)
+	assert.True(t, errors.Is(deniedErr, ErrPermissionDenied))
+	assert.Contains(t, deniedErr.Error(), "exec into pods")
+	assert.True(t, errors.Is(namespaceErr, ErrPermissionDenied))
+}
+
+func TestPermissionChecker_CheckNodeMaintenance_RequiresMaintenanceGroup(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	allowedErr := checker.CheckNodeMaintenance("prod", []string{"dash-ops*sre"})
+	deniedErr := checker.CheckNodeMaintenance("prod", []string{"dash-ops*developers"})
+	unconfiguredErr := checker.CheckNodeMaintenance("staging", []string{"dash-ops*sre"})
+
+	// Assert
+	assert.NoError(t, allowedErr)
+	assert.True(t, errors.Is(deniedErr, ErrPermissionDenied))
+	assert.Contains(t, deniedErr.Error(), "node maintenance")
+	assert.True(t, errors.Is(unconfiguredErr, ErrPermissionDenied))
+}
+
+func TestPermissionChecker_CheckApply_RequiresApplyGroupInAllowedNamespace(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	allowedErr := checker.CheckApply("prod", "team-payments", []string{"dash-ops*sre"})
+	deniedErr := checker.CheckApply("prod", "team-payments", []string{"dash-ops*developers"})
+	namespaceErr := checker.CheckApply("prod", "kube-system", []string{"dash-ops*sre"})
+	unconfiguredErr := checker.CheckApply("staging", "default", []string{"dash-ops*sre"})
+
+	// Assert
+	assert.NoError(t, allowedErr)
+	assert.True(t, errors.Is(deniedErr, ErrPermissionDenied))
+	assert.Contains(t, deniedErr.Error(), "apply changes")
+	assert.True(t, errors.Is(namespaceErr, ErrPermissionDenied))
+	assert.True(t, errors.Is(unconfiguredErr, ErrPermissionDenied))
+}
+
+func TestPermissionChecker_CheckPodDelete_WithoutUserData_ReturnsPermissionDenied(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckPodDelete("prod", "default", nil)
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+}
+
+func TestPermissionChecker_CheckNamespaceManagement_WithRestartOrScaleGroup_ReturnsNil(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act & Assert
+	assert.NoError(t, checker.CheckNamespaceManagement("prod", "team-new", []string{"dash-ops*developers"}))
+	assert.NoError(t, checker.CheckNamespaceManagement("prod", "team-new", []string{"dash-ops*sre"}))
+	assert.Error(t, checker.CheckNamespaceManagement("prod", "team-new", []string{"dash-ops*viewers"}))
+}
+
+func TestPermissionChecker_CheckScale_WithUnconfiguredCluster_AllowsAll(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+
+	// Act
+	err := checker.CheckScale("staging", "kube-system", nil)
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestPermissionChecker_FilterNamespaces_KeepsAllowedNamespaces(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+	namespaces := []k8sModels.Namespace{
+		{Name: "default"},
+		{Name: "kube-system"},
+		{Name: "team-payments"},
+	}
+
+	// Act
+	result := checker.FilterNamespaces("prod", namespaces)
+
+	// Assert
+	assert.Len(t, result, 2)
+	assert.Equal(t, "default", result[0].Name)
+	assert.Equal(t, "team-payments", result[1].Name)
+	assert.Len(t, checker.FilterNamespaces("staging", namespaces), 3)
+}
+
+func TestPermissionChecker_Authorize_WithoutRBAC_UsesClusterGroups(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+	sre := &commonsModels.UserData{Groups: []string{"dash-ops*sre"}}
+	developer := &commonsModels.UserData{Groups: []string{"dash-ops*developers"}}
+
+	// Act
+	sreErr := checker.Authorize(sre, k8sModels.RBACDeploymentsScale, "prod", "default")
+	developerErr := checker.Authorize(developer, k8sModels.RBACDeploymentsScale, "prod", "default")
+	anonymousErr := checker.Authorize(nil, k8sModels.RBACNodesMaintain, "prod", "")
+
+	// Assert
+	assert.NoError(t, sreErr)
+	assert.True(t, errors.Is(developerErr, ErrPermissionDenied))
+	assert.True(t, errors.Is(anonymousErr, ErrPermissionDenied))
+}
+
+func TestPermissionChecker_Authorize_WithRBAC_UsesRoleGrantsInsteadOfGroups(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+	user := &commonsModels.UserData{
+		Groups: []string{"dash-ops*sre"},
+		Permissions: &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{
+			{Permission: "k8s:deployments:*", Scope: commonsModels.PermissionScope{Cluster: "prod", Namespace: "team-*"}},
+		}},
+	}
+
+	// Act
+	scaleErr := checker.Authorize(user, k8sModels.RBACDeploymentsScale, "prod", "team-payments")
+	otherNamespaceErr := checker.Authorize(user, k8sModels.RBACDeploymentsScale, "prod", "default")
+	execErr := checker.Authorize(user, k8sModels.RBACPodsExec, "prod", "team-payments")
+
+	// Assert
+	assert.NoError(t, scaleErr)
+	assert.True(t, errors.Is(otherNamespaceErr, ErrPermissionDenied))
+	assert.True(t, errors.Is(execErr, ErrPermissionDenied), "groups no longer grant exec once RBAC is configured")
+}
+
+func TestPermissionChecker_Authorize_WithRBAC_KeepsNamespaceAllowlist(t *testing.T) {
+	// Arrange
+	checker := newTestPermissionChecker()
+	user := &commonsModels.UserData{Permissions: &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{{Permission: "*"}}}}
+
+	// Act
+	err := checker.Authorize(user, k8sModels.RBACDeploymentsRestart, "prod", "kube-system")
+
+	// Assert
+	assert.True(t, errors.Is(err, ErrPermissionDenied))
+}
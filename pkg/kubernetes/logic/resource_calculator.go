@@ -0,0 +1,247 @@
+package logic
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+// Resource utilization thresholds (percent) used to classify resource health
+const (
+	ResourceWarningThreshold  = 75.0
+	ResourceCriticalThreshold = 90.0
+)
+
+// ResourceCalculator provides resource aggregation logic for Kubernetes resources
+type ResourceCalculator struct{}
+
+// NewResourceCalculator creates a new resource calculator
+func NewResourceCalculator() *ResourceCalculator {
+	return &ResourceCalculator{}
+}
+
+// SumNodeResources sums capacity, allocatable, requested, limit and live usage resources across nodes.
+// Usage is only reported when every node has live metrics.
+func (rc *ResourceCalculator) SumNodeResources(nodes []k8sModels.Node) k8sModels.NodeResources {
+	var capacity, allocatable, used, limits, usage resourceTotals
+	hasUsage := len(nodes) > 0
+	for _, node := range nodes {
+		capacity.add(node.Resources.Capacity)
+		allocatable.add(node.Resources.Allocatable)
+		used.add(node.Resources.Used)
+		limits.add(node.Resources.Limits)
+		if node.Resources.Usage == nil {
+			hasUsage = false
+		} else {
+			usage.add(*node.Resources.Usage)
+		}
+	}
+
+	resources := k8sModels.NodeResources{
+		Capacity:    capacity.toResourceList(),
+		Allocatable: allocatable.toResourceList(),
+		Used:        used.toResourceList(),
+		Limits:      limits.toResourceList(),
+	}
+	if hasUsage {
+		usageList := usage.toResourceList()
+		resources.Usage = &usageList
+	}
+
+	return resources
+}
+
+// SumResourceLists sums resource lists such as container usage into a single list
+func (rc *ResourceCalculator) SumResourceLists(lists []k8sModels.ResourceList) k8sModels.ResourceList {
+	var totals resourceTotals
+	for _, list := range lists {
+		totals.add(list)
+	}
+	return totals.toResourceList()
+}
+
+// CalculatePodResourceUsage sums container requests, limits and live usage of a pod
+// and compares usage against requests and limits
+func (rc *ResourceCalculator) CalculatePodResourceUsage(pod *k8sModels.Pod) *k8sModels.ResourceUsage {
+	var requests, limits, usage resourceTotals
+	hasUsage := len(pod.Containers) > 0
+	for _, container := range pod.Containers {
+		requests.add(container.Resources.Requests)
+		limits.add(container.Resources.Limits)
+		if container.Usage == nil {
+			hasUsage = false
+		} else {
+			usage.add(*container.Usage)
+		}
+	}
+
+	result := &k8sModels.ResourceUsage{
+		Requests: requests.toResourceList(),
+		Limits:   limits.toResourceList(),
+	}
+	result.Requests.Pods = ""
+	result.Limits.Pods = ""
+	if !hasUsage {
+		return result
+	}
+
+	usageList := usage.toResourceList()
+	usageList.Pods = ""
+	result.Usage = &usageList
+	result.CPURequestPercent = utilizationPercent(usage.cpuMillis, requests.cpuMillis)
+	result.CPULimitPercent = utilizationPercent(usage.cpuMillis, limits.cpuMillis)
+	result.MemoryRequestPercent = utilizationPercent(usage.memoryBytes, requests.memoryBytes)
+	result.MemoryLimitPercent = utilizationPercent(usage.memoryBytes, limits.memoryBytes)
+
+	return result
+}
+
+// CalculateNodeResourceHealth calculates resource health of a node against its allocatable resources
+func (rc *ResourceCalculator) CalculateNodeResourceHealth(node *k8sModels.Node) k8sPorts.ResourceHealth {
+	var allocatable, used resourceTotals
+	allocatable.add(node.Resources.Allocatable)
+	used.add(node.Resources.Used)
+
+	return k8sPorts.ResourceHealth{
+		CPU:    rc.CalculateResourceHealthDetail(used.cpuMillis, allocatable.cpuMillis),
+		Memory: rc.CalculateResourceHealthDetail(used.memoryBytes, allocatable.memoryBytes),
+		Pods:   rc.CalculateResourceHealthDetail(used.pods, allocatable.pods),
+	}
+}
+
+// CalculateResourceHealthDetail builds a resource health detail from used and total quantities
+func (rc *ResourceCalculator) CalculateResourceHealthDetail(used, total int64) k8sPorts.ResourceHealthDetail {
+	detail := k8sPorts.ResourceHealthDetail{
+		Used:   used,
+		Total:  total,
+		Status: string(k8sModels.ResourceStatusUnknown),
+	}
+	if total <= 0 {
+		return detail
+	}
+
+	detail.Available = total - used
+	if detail.Available < 0 {
+		detail.Available = 0
+	}
+	detail.UtilizationPercent = utilizationPercent(used, total)
+	detail.Status = string(rc.CalculateResourceStatus(detail.UtilizationPercent))
+
+	return detail
+}
+
+// CalculateResourceStatus classifies a utilization percentage as healthy, warning or critical
+func (rc *ResourceCalculator) CalculateResourceStatus(utilizationPercent float64) k8sModels.ResourceStatus {
+	switch {
+	case utilizationPercent >= ResourceCriticalThreshold:
+		return k8sModels.ResourceStatusCritical
+	case utilizationPercent >= ResourceWarningThreshold:
+		return k8sModels.ResourceStatusWarning
+	default:
+		return k8sModels.ResourceStatusHealthy
+	}
+}
+
+// CalculateQuotaUtilization fills the utilization and status of every resource of a quota.
+// Resources with a zero or unparsable hard limit are reported as unknown.
+func (rc *ResourceCalculator) CalculateQuotaUtilization(quota *k8sModels.ResourceQuota) {
+	for i := range quota.Resources {
+		quotaResource := &quota.Resources[i]
+		quotaResource.UtilizationPercent = 0
+		quotaResource.Status = k8sModels.ResourceStatusUnknown
+
+		hard, err := resource.ParseQuantity(quotaResource.Hard)
+		if err != nil || hard.Sign() <= 0 {
+			continue
+		}
+		used, err := resource.ParseQuantity(quotaResource.Used)
+		if err != nil {
+			continue
+		}
+
+		quotaResource.UtilizationPercent = used.AsApproximateFloat64() / hard.AsApproximateFloat64() * 100
+		quotaResource.Status = rc.CalculateResourceStatus(quotaResource.UtilizationPercent)
+	}
+}
+
+// RankNamespaceQuotas groups quotas by namespace and ranks namespaces by their most utilized quota resource.
+// Quotas must already have their utilization calculated; namespaces without quota resources are left out.
+func (rc *ResourceCalculator) RankNamespaceQuotas(quotas []k8sModels.ResourceQuota) []k8sModels.NamespaceQuotaUsage {
+	byNamespace := make(map[string]*k8sModels.NamespaceQuotaUsage)
+	namespaces := make([]string, 0)
+	for _, quota := range quotas {
+		usage, exists := byNamespace[quota.Namespace]
+		if !exists {
+			usage = &k8sModels.NamespaceQuotaUsage{Namespace: quota.Namespace, Status: k8sModels.ResourceStatusUnknown}
+			byNamespace[quota.Namespace] = usage
+			namespaces = append(namespaces, quota.Namespace)
+		}
+		usage.Quotas = append(usage.Quotas, quota)
+
+		peak, found := quota.PeakResource()
+		if !found || peak.Status == k8sModels.ResourceStatusUnknown {
+			continue
+		}
+		if usage.Resource == "" || peak.UtilizationPercent > usage.UtilizationPercent {
+			usage.Quota = quota.Name
+			usage.Resource = peak.Name
+			usage.UtilizationPercent = peak.UtilizationPercent
+			usage.Status = peak.Status
+		}
+	}
+
+	ranking := make([]k8sModels.NamespaceQuotaUsage, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if usage := byNamespace[namespace]; usage.Resource != "" {
+			ranking = append(ranking, *usage)
+		}
+	}
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if ranking[i].UtilizationPercent != ranking[j].UtilizationPercent {
+			return ranking[i].UtilizationPercent > ranking[j].UtilizationPercent
+		}
+		return ranking[i].Namespace < ranking[j].Namespace
+	})
+
+	return ranking
+}
+
+// utilizationPercent returns used as a percentage of total, or zero when total is unknown
+func utilizationPercent(used, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(used) / float64(total) * 100
+}
+
+// resourceTotals accumulates resource quantities in base units
+type resourceTotals struct {
+	cpuMillis   int64
+	memoryBytes int64
+	pods        int64
+}
+
+// add parses a resource list and adds its quantities to the totals
+func (t *resourceTotals) add(list k8sModels.ResourceList) {
+	if q, err := resource.ParseQuantity(list.CPU); err == nil {
+		t.cpuMillis += q.MilliValue()
+	}
+	if q, err := resource.ParseQuantity(list.Memory); err == nil {
+		t.memoryBytes += q.Value()
+	}
+	if q, err := resource.ParseQuantity(list.Pods); err == nil {
+		t.pods += q.Value()
+	}
+}
+
+// toResourceList formats the totals as a resource list
+func (t *resourceTotals) toResourceList() k8sModels.ResourceList {
+	return k8sModels.ResourceList{
+		CPU:    resource.NewMilliQuantity(t.cpuMillis, resource.DecimalSI).String(),
+		Memory: resource.NewQuantity(t.memoryBytes, resource.BinarySI).String(),
+		Pods:   resource.NewQuantity(t.pods, resource.DecimalSI).String(),
+	}
+}
@@ -0,0 +1,219 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestResourceCalculator_SumNodeResources_WithMultipleNodes_SumsQuantities(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	nodes := []k8sModels.Node{
+		{
+			Resources: k8sModels.NodeResources{
+				Capacity:    k8sModels.ResourceList{CPU: "4", Memory: "8Gi", Pods: "110"},
+				Allocatable: k8sModels.ResourceList{CPU: "3800m", Memory: "7Gi", Pods: "110"},
+				Used:        k8sModels.ResourceList{CPU: "500m", Memory: "512Mi", Pods: "10"},
+			},
+		},
+		{
+			Resources: k8sModels.NodeResources{
+				Capacity:    k8sModels.ResourceList{CPU: "2", Memory: "4Gi", Pods: "110"},
+				Allocatable: k8sModels.ResourceList{CPU: "1800m", Memory: "3Gi", Pods: "110"},
+				Used:        k8sModels.ResourceList{CPU: "0", Memory: "0", Pods: "0"},
+			},
+		},
+	}
+
+	// Act
+	result := calculator.SumNodeResources(nodes)
+
+	// Assert
+	assert.Equal(t, "6", result.Capacity.CPU)
+	assert.Equal(t, "12Gi", result.Capacity.Memory)
+	assert.Equal(t, "220", result.Capacity.Pods)
+	assert.Equal(t, "5600m", result.Allocatable.CPU)
+	assert.Equal(t, "10Gi", result.Allocatable.Memory)
+	assert.Equal(t, "500m", result.Used.CPU)
+	assert.Equal(t, "512Mi", result.Used.Memory)
+	assert.Equal(t, "10", result.Used.Pods)
+}
+
+func TestResourceCalculator_CalculateResourceHealthDetail_WithZeroTotal_ReturnsUnknown(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+
+	// Act
+	result := calculator.CalculateResourceHealthDetail(100, 0)
+
+	// Assert
+	assert.Equal(t, string(k8sModels.ResourceStatusUnknown), result.Status)
+	assert.Equal(t, float64(0), result.UtilizationPercent)
+}
+
+func TestResourceCalculator_CalculateResourceHealthDetail_WithHighUsage_ReturnsCritical(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+
+	// Act
+	result := calculator.CalculateResourceHealthDetail(950, 1000)
+
+	// Assert
+	assert.Equal(t, string(k8sModels.ResourceStatusCritical), result.Status)
+	assert.Equal(t, int64(50), result.Available)
+	assert.InDelta(t, 95.0, result.UtilizationPercent, 0.001)
+}
+
+func TestResourceCalculator_CalculateResourceStatus_WithThresholds_ClassifiesCorrectly(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+
+	// Act & Assert
+	assert.Equal(t, k8sModels.ResourceStatusHealthy, calculator.CalculateResourceStatus(50))
+	assert.Equal(t, k8sModels.ResourceStatusWarning, calculator.CalculateResourceStatus(ResourceWarningThreshold))
+	assert.Equal(t, k8sModels.ResourceStatusCritical, calculator.CalculateResourceStatus(ResourceCriticalThreshold))
+}
+
+func TestResourceCalculator_CalculateNodeResourceHealth_WithRequests_ComputesUtilization(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	node := &k8sModels.Node{
+		Resources: k8sModels.NodeResources{
+			Allocatable: k8sModels.ResourceList{CPU: "2", Memory: "4Gi", Pods: "10"},
+			Used:        k8sModels.ResourceList{CPU: "1", Memory: "1Gi", Pods: "8"},
+		},
+	}
+
+	// Act
+	result := calculator.CalculateNodeResourceHealth(node)
+
+	// Assert
+	assert.Equal(t, int64(1000), result.CPU.Used)
+	assert.Equal(t, int64(2000), result.CPU.Total)
+	assert.Equal(t, string(k8sModels.ResourceStatusHealthy), result.CPU.Status)
+	assert.InDelta(t, 25.0, result.Memory.UtilizationPercent, 0.001)
+	assert.Equal(t, string(k8sModels.ResourceStatusWarning), result.Pods.Status)
+}
+
+func TestResourceCalculator_CalculatePodResourceUsage_WithUsage_ComparesWithRequestsAndLimits(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	pod := &k8sModels.Pod{
+		Containers: []k8sModels.Container{
+			{
+				Resources: k8sModels.ContainerResources{
+					Requests: k8sModels.ResourceList{CPU: "200m", Memory: "256Mi"},
+					Limits:   k8sModels.ResourceList{CPU: "1", Memory: "512Mi"},
+				},
+				Usage: &k8sModels.ResourceList{CPU: "150m", Memory: "256Mi"},
+			},
+			{
+				Resources: k8sModels.ContainerResources{
+					Requests: k8sModels.ResourceList{CPU: "100m", Memory: "0"},
+					Limits:   k8sModels.ResourceList{CPU: "0", Memory: "0"},
+				},
+				Usage: &k8sModels.ResourceList{CPU: "150m", Memory: "0"},
+			},
+		},
+	}
+
+	// Act
+	result := calculator.CalculatePodResourceUsage(pod)
+
+	// Assert
+	assert.Equal(t, "300m", result.Requests.CPU)
+	assert.Equal(t, "1", result.Limits.CPU)
+	assert.Equal(t, "300m", result.Usage.CPU)
+	assert.InDelta(t, 100.0, result.CPURequestPercent, 0.001)
+	assert.InDelta(t, 30.0, result.CPULimitPercent, 0.001)
+	assert.InDelta(t, 100.0, result.MemoryRequestPercent, 0.001)
+	assert.InDelta(t, 50.0, result.MemoryLimitPercent, 0.001)
+}
+
+func TestResourceCalculator_CalculatePodResourceUsage_WithoutMetrics_OmitsUsage(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	pod := &k8sModels.Pod{
+		Containers: []k8sModels.Container{
+			{Resources: k8sModels.ContainerResources{Requests: k8sModels.ResourceList{CPU: "250m", Memory: "128Mi"}}},
+		},
+	}
+
+	// Act
+	result := calculator.CalculatePodResourceUsage(pod)
+
+	// Assert
+	assert.Nil(t, result.Usage)
+	assert.Equal(t, "250m", result.Requests.CPU)
+	assert.Equal(t, float64(0), result.CPURequestPercent)
+}
+
+func TestResourceCalculator_SumNodeResources_WithPartialMetrics_OmitsUsage(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	nodes := []k8sModels.Node{
+		{Resources: k8sModels.NodeResources{Usage: &k8sModels.ResourceList{CPU: "500m", Memory: "1Gi"}}},
+		{Resources: k8sModels.NodeResources{}},
+	}
+
+	// Act
+	result := calculator.SumNodeResources(nodes)
+	withAllMetrics := calculator.SumNodeResources(nodes[:1])
+
+	// Assert
+	assert.Nil(t, result.Usage)
+	assert.Equal(t, "500m", withAllMetrics.Usage.CPU)
+}
+
+func TestResourceCalculator_CalculateQuotaUtilization_UsesResourceThresholds(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	quota := &k8sModels.ResourceQuota{
+		Name: "team",
+		Resources: []k8sModels.QuotaResource{
+			{Name: "requests.cpu", Hard: "4", Used: "3800m"},
+			{Name: "requests.memory", Hard: "8Gi", Used: "6Gi"},
+			{Name: "pods", Hard: "20", Used: "2"},
+			{Name: "services", Hard: "0", Used: "0"},
+		},
+	}
+
+	// Act
+	calculator.CalculateQuotaUtilization(quota)
+
+	// Assert
+	assert.InDelta(t, 95.0, quota.Resources[0].UtilizationPercent, 0.001)
+	assert.Equal(t, k8sModels.ResourceStatusCritical, quota.Resources[0].Status)
+	assert.Equal(t, k8sModels.ResourceStatusWarning, quota.Resources[1].Status)
+	assert.Equal(t, k8sModels.ResourceStatusHealthy, quota.Resources[2].Status)
+	assert.Equal(t, k8sModels.ResourceStatusUnknown, quota.Resources[3].Status)
+}
+
+func TestResourceCalculator_RankNamespaceQuotas_OrdersByPeakUtilization(t *testing.T) {
+	// Arrange
+	calculator := NewResourceCalculator()
+	quotas := []k8sModels.ResourceQuota{
+		{Name: "compute", Namespace: "team-a", Resources: []k8sModels.QuotaResource{{Name: "requests.cpu", Hard: "4", Used: "1"}}},
+		{Name: "objects", Namespace: "team-a", Resources: []k8sModels.QuotaResource{{Name: "pods", Hard: "10", Used: "8"}}},
+		{Name: "compute", Namespace: "team-b", Resources: []k8sModels.QuotaResource{{Name: "requests.cpu", Hard: "2", Used: "1900m"}}},
+		{Name: "empty", Namespace: "team-c", Resources: []k8sModels.QuotaResource{{Name: "pods", Hard: "0", Used: "0"}}},
+	}
+	for i := range quotas {
+		calculator.CalculateQuotaUtilization(&quotas[i])
+	}
+
+	// Act
+	ranking := calculator.RankNamespaceQuotas(quotas)
+
+	// Assert
+	assert.Len(t, ranking, 2)
+	assert.Equal(t, "team-b", ranking[0].Namespace)
+	assert.Equal(t, k8sModels.ResourceStatusCritical, ranking[0].Status)
+	assert.Equal(t, "team-a", ranking[1].Namespace)
+	assert.Equal(t, "objects", ranking[1].Quota)
+	assert.Equal(t, "pods", ranking[1].Resource)
+	assert.Len(t, ranking[1].Quotas, 2)
+}
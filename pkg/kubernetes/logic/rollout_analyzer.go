@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// progressDeadlineExceededReason is set on the Progressing condition when a rollout stalls
+const progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+
+// ErrInvalidRollback is returned when the requested rollback revision cannot be used
+var ErrInvalidRollback = errors.New("invalid rollback")
+
+// RolloutAnalyzer provides rollout status and history logic for deployments
+type RolloutAnalyzer struct{}
+
+// NewRolloutAnalyzer creates a new rollout analyzer
+func NewRolloutAnalyzer() *RolloutAnalyzer {
+	return &RolloutAnalyzer{}
+}
+
+// CalculateStatus determines whether a deployment rollout is progressing, complete or stalled.
+// It follows the same checks as kubectl rollout status.
+func (ra *RolloutAnalyzer) CalculateStatus(deployment *k8sModels.Deployment) k8sModels.RolloutStatus {
+	status := k8sModels.RolloutStatus{
+		Name:      deployment.Name,
+		Namespace: deployment.Namespace,
+		Revision:  deployment.Revision,
+		Replicas:  deployment.Replicas,
+		State:     k8sModels.RolloutStateProgressing,
+	}
+	replicas := deployment.Replicas
+
+	if deployment.Generation > deployment.ObservedGeneration {
+		status.Message = "Waiting for deployment spec update to be observed"
+		return status
+	}
+
+	for _, condition := range deployment.Conditions {
+		if condition.Type == "Progressing" && condition.Reason == progressDeadlineExceededReason {
+			status.State = k8sModels.RolloutStateStalled
+			status.Message = fmt.Sprintf("Rollout exceeded its progress deadline: %s", condition.Message)
+			return status
+		}
+	}
+
+	switch {
+	case replicas.Updated < replicas.Desired:
+		status.Message = fmt.Sprintf("%d of %d updated replicas available", replicas.Updated, replicas.Desired)
+	case replicas.Current > replicas.Updated:
+		status.Message = fmt.Sprintf("%d old replicas pending termination", replicas.Current-replicas.Updated)
+	case replicas.Available < replicas.Updated:
+		status.Message = fmt.Sprintf("%d of %d updated replicas available", replicas.Available, replicas.Updated)
+	default:
+		status.State = k8sModels.RolloutStateComplete
+		status.Message = "Rollout complete"
+	}
+
+	return status
+}
+
+// SortRevisions sorts revisions with the newest first
+func (ra *RolloutAnalyzer) SortRevisions(revisions []k8sModels.DeploymentRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+}
+
+// ResolveRollbackTarget picks the revision to roll back to.
+// A zero revision selects the newest revision older than the current one.
+func (ra *RolloutAnalyzer) ResolveRollbackTarget(revisions []k8sModels.DeploymentRevision, revision int64) (*k8sModels.DeploymentRevision, error) {
+	if revision < 0 {
+		return nil, fmt.Errorf("%w: revision must be non-negative", ErrInvalidRollback)
+	}
+
+	var current int64
+	for _, candidate := range revisions {
+		if candidate.Current {
+			current = candidate.Revision
+		}
+	}
+
+	if revision == 0 {
+		var target *k8sModels.DeploymentRevision
+		for i := range revisions {
+			candidate := &revisions[i]
+			if candidate.Revision < current && (target == nil || candidate.Revision > target.Revision) {
+				target = candidate
+			}
+		}
+		if target == nil {
+			return nil, fmt.Errorf("%w: no previous revision to roll back to", ErrInvalidRollback)
+		}
+		return target, nil
+	}
+
+	if revision == current {
+		return nil, fmt.Errorf("%w: deployment is already at revision %d", ErrInvalidRollback, revision)
+	}
+	for i := range revisions {
+		if revisions[i].Revision == revision {
+			return &revisions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: revision %d not found", ErrInvalidRollback, revision)
+}
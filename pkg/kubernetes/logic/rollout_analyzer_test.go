@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestRolloutAnalyzer_CalculateStatus_WithAllReplicasUpdated_ReturnsComplete(t *testing.T) {
+	// Arrange
+	analyzer := NewRolloutAnalyzer()
+	deployment := &k8sModels.Deployment{
+		Name:               "api",
+		Revision:           4,
+		Generation:         7,
+		ObservedGeneration: 7,
+		Replicas:           k8sModels.DeploymentReplicas{Desired: 3, Current: 3, Ready: 3, Available: 3, Updated: 3},
+	}
+
+	// Act
+	status := analyzer.CalculateStatus(deployment)
+
+	// Assert
+	assert.Equal(t, k8sModels.RolloutStateComplete, status.State)
+	assert.Equal(t, int64(4), status.Revision)
+}
+
+func TestRolloutAnalyzer_CalculateStatus_WithOldReplicasRunning_ReturnsProgressing(t *testing.T) {
+	// Arrange
+	analyzer := NewRolloutAnalyzer()
+	deployment := &k8sModels.Deployment{
+		Generation:         2,
+		ObservedGeneration: 2,
+		Replicas:           k8sModels.DeploymentReplicas{Desired: 3, Current: 5, Ready: 4, Available: 4, Updated: 3},
+	}
+
+	// Act
+	status := analyzer.CalculateStatus(deployment)
+
+	// Assert
+	assert.Equal(t, k8sModels.RolloutStateProgressing, status.State)
+	assert.Equal(t, "2 old replicas pending termination", status.Message)
+}
+
+func TestRolloutAnalyzer_CalculateStatus_WithDeadlineExceeded_ReturnsStalled(t *testing.T) {
+	// Arrange
+	analyzer := NewRolloutAnalyzer()
+	deployment := &k8sModels.Deployment{
+		Generation:         2,
+		ObservedGeneration: 2,
+		Replicas:           k8sModels.DeploymentReplicas{Desired: 3, Current: 4, Ready: 3, Available: 3, Updated: 1},
+		Conditions: []k8sModels.DeploymentCondition{
+			{Type: "Progressing", Status: "False", Reason: "ProgressDeadlineExceeded", Message: "ReplicaSet \"api-6f7\" has timed out progressing."},
+		},
+	}
+
+	// Act
+	status := analyzer.CalculateStatus(deployment)
+
+	// Assert
+	assert.Equal(t, k8sModels.RolloutStateStalled, status.State)
+	assert.Contains(t, status.Message, "timed out progressing")
+}
+
+func TestRolloutAnalyzer_ResolveRollbackTarget_WithZeroRevision_ReturnsPrevious(t *testing.T) {
+	// Arrange
+	analyzer := NewRolloutAnalyzer()
+	revisions := []k8sModels.DeploymentRevision{
+		{Revision: 1, ReplicaSet: "api-1"},
+		{Revision: 5, ReplicaSet: "api-5", Current: true},
+		{Revision: 3, ReplicaSet: "api-3"},
+	}
+
+	// Act
+	target, err := analyzer.ResolveRollbackTarget(revisions, 0)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "api-3", target.ReplicaSet)
+}
+
+func TestRolloutAnalyzer_ResolveRollbackTarget_WithInvalidRevision_ReturnsError(t *testing.T) {
+	// Arrange
+	analyzer := NewRolloutAnalyzer()
+	revisions := []k8sModels.DeploymentRevision{
+		{Revision: 2, ReplicaSet: "api-2", Current: true},
+	}
+
+	// Act
+	_, currentErr := analyzer.ResolveRollbackTarget(revisions, 2)
+	_, missingErr := analyzer.ResolveRollbackTarget(revisions, 9)
+	_, previousErr := analyzer.ResolveRollbackTarget(revisions, 0)
+
+	// Assert
+	assert.ErrorIs(t, currentErr, ErrInvalidRollback)
+	assert.Contains(t, currentErr.Error(), "already at revision 2")
+	assert.ErrorIs(t, missingErr, ErrInvalidRollback)
+	assert.Contains(t, missingErr.Error(), "revision 9 not found")
+	assert.ErrorIs(t, previousErr, ErrInvalidRollback)
+}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+var (
+	// ErrManagedByAutoscaler is returned when a manual scale would be overridden by an autoscaler
+	ErrManagedByAutoscaler = errors.New("managed by autoscaler")
+	// ErrInvalidAutoscalerBounds is returned when autoscaler min/max replicas are out of range
+	ErrInvalidAutoscalerBounds = errors.New("invalid autoscaler bounds")
+)
+
+// ScalingPolicy decides how manual scaling interacts with horizontal pod autoscalers
+type ScalingPolicy struct{}
+
+// NewScalingPolicy creates a new scaling policy
+func NewScalingPolicy() *ScalingPolicy {
+	return &ScalingPolicy{}
+}
+
+// CheckManualScale checks a manual scale of a workload managed by the given autoscaler, if any.
+// It is refused unless forced; a forced scale returns a warning because the autoscaler will override it.
+func (sp *ScalingPolicy) CheckManualScale(autoscaler *k8sModels.HorizontalPodAutoscaler, replicas int32, force bool) (string, error) {
+	if autoscaler == nil {
+		return "", nil
+	}
+
+	message := fmt.Sprintf("horizontal pod autoscaler %s manages this deployment with %d-%d replicas and will override a manual scale",
+		autoscaler.Name, autoscaler.MinReplicas, autoscaler.MaxReplicas)
+	if !force {
+		return "", fmt.Errorf("%w: %s; adjust the autoscaler bounds instead or force the scale", ErrManagedByAutoscaler, message)
+	}
+	if replicas < autoscaler.MinReplicas || replicas > autoscaler.MaxReplicas {
+		return message + fmt.Sprintf(" and will move %d replicas back into range", replicas), nil
+	}
+	return message, nil
+}
+
+// ValidateBounds checks autoscaler min and max replicas
+func (sp *ScalingPolicy) ValidateBounds(minReplicas, maxReplicas, limit int32) error {
+	if minReplicas < 1 {
+		return fmt.Errorf("%w: min replicas must be at least 1", ErrInvalidAutoscalerBounds)
+	}
+	if maxReplicas < minReplicas {
+		return fmt.Errorf("%w: max replicas must not be lower than min replicas", ErrInvalidAutoscalerBounds)
+	}
+	if maxReplicas > limit {
+		return fmt.Errorf("%w: max replicas cannot exceed %d", ErrInvalidAutoscalerBounds, limit)
+	}
+	return nil
+}
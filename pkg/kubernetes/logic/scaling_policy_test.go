@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestScalingPolicy_CheckManualScale_WithAutoscaler_RefusesUnlessForced(t *testing.T) {
+	// Arrange
+	policy := NewScalingPolicy()
+	autoscaler := &k8sModels.HorizontalPodAutoscaler{Name: "api", MinReplicas: 2, MaxReplicas: 10}
+
+	// Act
+	_, refusedErr := policy.CheckManualScale(autoscaler, 5, false)
+	warning, forcedErr := policy.CheckManualScale(autoscaler, 20, true)
+
+	// Assert
+	assert.True(t, errors.Is(refusedErr, ErrManagedByAutoscaler))
+	assert.NoError(t, forcedErr)
+	assert.Contains(t, warning, "horizontal pod autoscaler api manages this deployment with 2-10 replicas")
+	assert.Contains(t, warning, "move 20 replicas back into range")
+}
+
+func TestScalingPolicy_CheckManualScale_WithoutAutoscaler_Allows(t *testing.T) {
+	// Arrange
+	policy := NewScalingPolicy()
+
+	// Act
+	warning, err := policy.CheckManualScale(nil, 3, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, warning)
+}
+
+func TestScalingPolicy_ValidateBounds_WithInvalidBounds_ReturnsError(t *testing.T) {
+	// Arrange
+	policy := NewScalingPolicy()
+
+	// Act
+	validErr := policy.ValidateBounds(2, 10, 1000)
+	zeroMinErr := policy.ValidateBounds(0, 10, 1000)
+	invertedErr := policy.ValidateBounds(5, 3, 1000)
+	overLimitErr := policy.ValidateBounds(1, 2000, 1000)
+
+	// Assert
+	assert.NoError(t, validErr)
+	assert.True(t, errors.Is(zeroMinErr, ErrInvalidAutoscalerBounds))
+	assert.True(t, errors.Is(invertedErr, ErrInvalidAutoscalerBounds))
+	assert.True(t, errors.Is(overLimitErr, ErrInvalidAutoscalerBounds))
+}
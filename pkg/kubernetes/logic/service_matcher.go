@@ -0,0 +1,38 @@
+package logic
+
+import (
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// ServiceMatcher provides the logic linking services to the pods they select
+type ServiceMatcher struct{}
+
+// NewServiceMatcher creates a new service matcher
+func NewServiceMatcher() *ServiceMatcher {
+	return &ServiceMatcher{}
+}
+
+// SelectsLabels checks if a service selector matches a set of pod labels.
+// An empty selector never matches, since such services have manually managed endpoints.
+func (sm *ServiceMatcher) SelectsLabels(selector, podLabels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for key, value := range selector {
+		if podLabels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// FindSelectingServices returns the services whose selector matches the pod labels
+func (sm *ServiceMatcher) FindSelectingServices(services []k8sModels.Service, podLabels map[string]string) []k8sModels.Service {
+	matched := make([]k8sModels.Service, 0)
+	for _, service := range services {
+		if sm.SelectsLabels(service.Selector, podLabels) {
+			matched = append(matched, service)
+		}
+	}
+	return matched
+}
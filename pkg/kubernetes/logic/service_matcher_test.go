@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestServiceMatcher_SelectsLabels_WithSubsetSelector_ReturnsTrue(t *testing.T) {
+	// Arrange
+	matcher := NewServiceMatcher()
+	podLabels := map[string]string{"app": "api", "tier": "backend", "pod-template-hash": "7d9f8c6b5"}
+
+	// Act
+	result := matcher.SelectsLabels(map[string]string{"app": "api"}, podLabels)
+
+	// Assert
+	assert.True(t, result)
+}
+
+func TestServiceMatcher_SelectsLabels_WithEmptyOrMismatchedSelector_ReturnsFalse(t *testing.T) {
+	// Arrange
+	matcher := NewServiceMatcher()
+	podLabels := map[string]string{"app": "api"}
+
+	// Act & Assert
+	assert.False(t, matcher.SelectsLabels(nil, podLabels))
+	assert.False(t, matcher.SelectsLabels(map[string]string{"app": "web"}, podLabels))
+	assert.False(t, matcher.SelectsLabels(map[string]string{"app": "api", "tier": "backend"}, podLabels))
+}
+
+func TestServiceMatcher_FindSelectingServices_ReturnsMatchingOnly(t *testing.T) {
+	// Arrange
+	matcher := NewServiceMatcher()
+	services := []k8sModels.Service{
+		{Name: "api", Selector: map[string]string{"app": "api"}},
+		{Name: "api-headless", Selector: map[string]string{"app": "api", "tier": "backend"}},
+		{Name: "web", Selector: map[string]string{"app": "web"}},
+		{Name: "external-db"},
+	}
+
+	// Act
+	result := matcher.FindSelectingServices(services, map[string]string{"app": "api", "tier": "backend"})
+
+	// Assert
+	assert.Len(t, result, 2)
+	assert.Equal(t, "api", result[0].Name)
+	assert.Equal(t, "api-headless", result[1].Name)
+}
@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// ErrInvalidSearchQuery is returned when a search query is empty or not a valid label selector
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+// maxSearchQueryLength bounds the length of a search query
+const maxSearchQueryLength = 256
+
+// WorkloadQuery represents a parsed workload search query.
+// Queries with selector operators (=, !, parentheses) are label selectors, anything else matches names and images.
+type WorkloadQuery struct {
+	raw      string
+	text     string
+	selector labels.Selector
+}
+
+// WorkloadMatcher matches deployments and pods against search queries
+type WorkloadMatcher struct{}
+
+// NewWorkloadMatcher creates a new workload matcher
+func NewWorkloadMatcher() *WorkloadMatcher {
+	return &WorkloadMatcher{}
+}
+
+// ParseQuery parses a search query
+func (wm *WorkloadMatcher) ParseQuery(query string) (*WorkloadQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: query is required", ErrInvalidSearchQuery)
+	}
+	if len(query) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w: query is longer than %d characters", ErrInvalidSearchQuery, maxSearchQueryLength)
+	}
+
+	if !strings.ContainsAny(query, "=!()") {
+		return &WorkloadQuery{raw: query, text: strings.ToLower(query)}, nil
+	}
+
+	selector, err := labels.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSearchQuery, err.Error())
+	}
+	return &WorkloadQuery{raw: query, selector: selector}, nil
+}
+
+// String returns the query as it was given
+func (q *WorkloadQuery) String() string {
+	return q.raw
+}
+
+// MatchDeployment checks a deployment against the query and reports which field matched.
+// Label selectors match the deployment's own labels or its pod template labels.
+func (wm *WorkloadMatcher) MatchDeployment(query *WorkloadQuery, deployment *k8sModels.Deployment) (k8sModels.SearchMatchField, bool) {
+	if query.selector != nil {
+		if query.selector.Matches(labels.Set(deployment.Labels)) || query.selector.Matches(labels.Set(deployment.PodLabels)) {
+			return k8sModels.SearchMatchLabel, true
+		}
+		return "", false
+	}
+	return wm.matchText(query, deployment.Name, deployment.Images)
+}
+
+// MatchPod checks a pod against the query and reports which field matched
+func (wm *WorkloadMatcher) MatchPod(query *WorkloadQuery, pod *k8sModels.Pod) (k8sModels.SearchMatchField, bool) {
+	if query.selector != nil {
+		if query.selector.Matches(labels.Set(pod.Labels)) {
+			return k8sModels.SearchMatchLabel, true
+		}
+		return "", false
+	}
+
+	images := make([]string, 0, len(pod.Containers))
+	for _, container := range pod.Containers {
+		images = append(images, container.Image)
+	}
+	return wm.matchText(query, pod.Name, images)
+}
+
+// GroupByNamespace groups deployment and pod matches by namespace, sorted by namespace and name
+func (wm *WorkloadMatcher) GroupByNamespace(deployments, pods []k8sModels.WorkloadMatch) []k8sModels.NamespaceSearchResult {
+	byNamespace := make(map[string]*k8sModels.NamespaceSearchResult)
+	group := func(namespace string) *k8sModels.NamespaceSearchResult {
+		result, exists := byNamespace[namespace]
+		if !exists {
+			result = &k8sModels.NamespaceSearchResult{
+				Namespace:   namespace,
+				Deployments: []k8sModels.WorkloadMatch{},
+				Pods:        []k8sModels.WorkloadMatch{},
+			}
+			byNamespace[namespace] = result
+		}
+		return result
+	}
+
+	for _, match := range deployments {
+		result := group(match.Namespace)
+		result.Deployments = append(result.Deployments, match)
+	}
+	for _, match := range pods {
+		result := group(match.Namespace)
+		result.Pods = append(result.Pods, match)
+	}
+
+	results := make([]k8sModels.NamespaceSearchResult, 0, len(byNamespace))
+	for _, result := range byNamespace {
+		sortMatchesByName(result.Deployments)
+		sortMatchesByName(result.Pods)
+		results = append(results, *result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Namespace < results[j].Namespace
+	})
+	return results
+}
+
+// matchText matches a text query case-insensitively against a name, then against images
+func (wm *WorkloadMatcher) matchText(query *WorkloadQuery, name string, images []string) (k8sModels.SearchMatchField, bool) {
+	if strings.Contains(strings.ToLower(name), query.text) {
+		return k8sModels.SearchMatchName, true
+	}
+	for _, image := range images {
+		if strings.Contains(strings.ToLower(image), query.text) {
+			return k8sModels.SearchMatchImage, true
+		}
+	}
+	return "", false
+}
+
+// sortMatchesByName sorts matches by name for consistent ordering
+func sortMatchesByName(matches []k8sModels.WorkloadMatch) {
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Name < matches[j].Name
+	})
+}
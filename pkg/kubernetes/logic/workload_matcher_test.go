@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestWorkloadMatcher_MatchDeployment_WithTextQuery_MatchesNameThenImage(t *testing.T) {
+	// Arrange
+	matcher := NewWorkloadMatcher()
+	query, err := matcher.ParseQuery("Payments-API")
+	require.NoError(t, err)
+	byName := &k8sModels.Deployment{Name: "payments-api"}
+	byImage := &k8sModels.Deployment{Name: "checkout", Images: []string{"registry/payments-api:v3"}}
+	other := &k8sModels.Deployment{Name: "checkout", Images: []string{"registry/checkout:v1"}}
+
+	// Act
+	nameField, nameMatched := matcher.MatchDeployment(query, byName)
+	imageField, imageMatched := matcher.MatchDeployment(query, byImage)
+	_, otherMatched := matcher.MatchDeployment(query, other)
+
+	// Assert
+	assert.True(t, nameMatched)
+	assert.Equal(t, k8sModels.SearchMatchName, nameField)
+	assert.True(t, imageMatched)
+	assert.Equal(t, k8sModels.SearchMatchImage, imageField)
+	assert.False(t, otherMatched)
+}
+
+func TestWorkloadMatcher_MatchDeployment_WithSelector_MatchesPodTemplateLabels(t *testing.T) {
+	// Arrange
+	matcher := NewWorkloadMatcher()
+	query, err := matcher.ParseQuery("app=payments-api,tier!=batch")
+	require.NoError(t, err)
+	deployment := &k8sModels.Deployment{Name: "api", PodLabels: map[string]string{"app": "payments-api"}}
+	batch := &k8sModels.Deployment{Name: "worker", PodLabels: map[string]string{"app": "payments-api", "tier": "batch"}}
+
+	// Act
+	field, matched := matcher.MatchDeployment(query, deployment)
+	_, batchMatched := matcher.MatchDeployment(query, batch)
+
+	// Assert
+	assert.True(t, matched)
+	assert.Equal(t, k8sModels.SearchMatchLabel, field)
+	assert.False(t, batchMatched)
+}
+
+func TestWorkloadMatcher_MatchPod_WithTextQuery_MatchesContainerImage(t *testing.T) {
+	// Arrange
+	matcher := NewWorkloadMatcher()
+	query, err := matcher.ParseQuery("envoy")
+	require.NoError(t, err)
+	pod := &k8sModels.Pod{Name: "api-7d9f", Containers: []k8sModels.Container{{Image: "api:v1"}, {Image: "envoyproxy/envoy:v1.30"}}}
+
+	// Act
+	field, matched := matcher.MatchPod(query, pod)
+
+	// Assert
+	assert.True(t, matched)
+	assert.Equal(t, k8sModels.SearchMatchImage, field)
+}
+
+func TestWorkloadMatcher_ParseQuery_WithInvalidQueries_ReturnsError(t *testing.T) {
+	// Arrange
+	matcher := NewWorkloadMatcher()
+
+	for _, query := range []string{"", "   ", "app in (", "=value"} {
+		// Act
+		_, err := matcher.ParseQuery(query)
+
+		// Assert
+		assert.True(t, errors.Is(err, ErrInvalidSearchQuery), query)
+	}
+}
+
+func TestWorkloadMatcher_GroupByNamespace_SortsNamespacesAndMatches(t *testing.T) {
+	// Arrange
+	matcher := NewWorkloadMatcher()
+	deployments := []k8sModels.WorkloadMatch{{Kind: "Deployment", Name: "b", Namespace: "team-b"}, {Kind: "Deployment", Name: "a", Namespace: "team-b"}}
+	pods := []k8sModels.WorkloadMatch{{Kind: "Pod", Name: "a-1", Namespace: "team-a"}}
+
+	// Act
+	groups := matcher.GroupByNamespace(deployments, pods)
+
+	// Assert
+	require.Len(t, groups, 2)
+	assert.Equal(t, "team-a", groups[0].Namespace)
+	assert.Empty(t, groups[0].Deployments)
+	assert.Len(t, groups[0].Pods, 1)
+	assert.Equal(t, "a", groups[1].Deployments[0].Name)
+}
@@ -13,12 +13,13 @@ type ClusterInfo struct {
 
 // ClusterSummary represents cluster summary statistics
 type ClusterSummary struct {
-	TotalNodes       int `json:"total_nodes"`
-	ReadyNodes       int `json:"ready_nodes"`
-	TotalNamespaces  int `json:"total_namespaces"`
-	TotalDeployments int `json:"total_deployments"`
-	TotalPods        int `json:"total_pods"`
-	RunningPods      int `json:"running_pods"`
+	TotalNodes       int           `json:"total_nodes"`
+	ReadyNodes       int           `json:"ready_nodes"`
+	TotalNamespaces  int           `json:"total_namespaces"`
+	TotalDeployments int           `json:"total_deployments"`
+	TotalPods        int           `json:"total_pods"`
+	RunningPods      int           `json:"running_pods"`
+	Resources        NodeResources `json:"resources"`
 }
 
 // DeploymentList represents a list of deployments with metadata
@@ -66,8 +67,10 @@ type LogFilter struct {
 	ContainerName string     `json:"container_name,omitempty"`
 	Follow        bool       `json:"follow,omitempty"`
 	TailLines     int64      `json:"tail_lines,omitempty"`
+	SinceSeconds  int64      `json:"since_seconds,omitempty"`
 	SinceTime     *time.Time `json:"since_time,omitempty"`
 	Previous      bool       `json:"previous,omitempty"`
+	Timestamps    bool       `json:"timestamps,omitempty"`
 }
 
 // Methods for ClusterInfo
@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+// ApplyPlanTTL is how long a previewed change can be confirmed
+const ApplyPlanTTL = 10 * time.Minute
+
+// ManifestChangeOperation represents how a field differs between the live and the previewed object
+type ManifestChangeOperation string
+
+const (
+	ManifestFieldAdded   ManifestChangeOperation = "added"
+	ManifestFieldRemoved ManifestChangeOperation = "removed"
+	ManifestFieldChanged ManifestChangeOperation = "changed"
+)
+
+// ApplyAction represents what confirming a plan does to the target object
+type ApplyAction string
+
+const (
+	ApplyActionCreate ApplyAction = "create"
+	ApplyActionUpdate ApplyAction = "update"
+	ApplyActionNone   ApplyAction = "none"
+)
+
+// ManifestChange represents a single field difference between the live and the previewed object.
+// Paths use dots for fields and [name] or [index] for list items, e.g. spec.template.spec.containers[api].image.
+type ManifestChange struct {
+	Path      string                  `json:"path"`
+	Operation ManifestChangeOperation `json:"operation"`
+	Before    interface{}             `json:"before,omitempty"`
+	After     interface{}             `json:"after,omitempty"`
+}
+
+// ApplyPlan represents a change previewed with a server-side dry run, waiting to be confirmed
+type ApplyPlan struct {
+	Token               string                 `json:"token"`
+	Context             string                 `json:"context"`
+	Namespace           string                 `json:"namespace"`
+	APIVersion          string                 `json:"api_version"`
+	Kind                string                 `json:"kind"`
+	Name                string                 `json:"name"`
+	User                string                 `json:"user,omitempty"`
+	Object              map[string]interface{} `json:"object"`                          // Apply configuration sent to the API server
+	LiveResourceVersion string                 `json:"live_resource_version,omitempty"` // Empty when the object does not exist yet
+	Changes             []ManifestChange       `json:"changes"`
+	CreatedAt           time.Time              `json:"created_at"`
+	ExpiresAt           time.Time              `json:"expires_at"`
+}
+
+// Action returns what confirming the plan does to the target object
+func (p *ApplyPlan) Action() ApplyAction {
+	switch {
+	case p.LiveResourceVersion == "":
+		return ApplyActionCreate
+	case len(p.Changes) == 0:
+		return ApplyActionNone
+	default:
+		return ApplyActionUpdate
+	}
+}
+
+// IsExpired checks if the plan can no longer be confirmed
+func (p *ApplyPlan) IsExpired(now time.Time) bool {
+	return !now.Before(p.ExpiresAt)
+}
+
+// ResourcePath returns the namespace/Kind/name identifying the target object
+func (p *ApplyPlan) ResourcePath() string {
+	return p.Namespace + "/" + p.Kind + "/" + p.Name
+}
+
+// DeploymentChange represents targeted edits to the containers of an existing deployment
+type DeploymentChange struct {
+	Name       string            `json:"name"`
+	Containers []ContainerChange `json:"containers"`
+}
+
+// ContainerChange represents edits to a single container; empty fields are left untouched
+type ContainerChange struct {
+	Name     string            `json:"name"`
+	Image    string            `json:"image,omitempty"`
+	Env      map[string]string `json:"env,omitempty"`
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
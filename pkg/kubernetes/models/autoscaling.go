@@ -0,0 +1,42 @@
+package models
+
+import "time"
+
+// HorizontalPodAutoscaler represents a Kubernetes horizontal pod autoscaler
+type HorizontalPodAutoscaler struct {
+	Name            string                `json:"name"`
+	Namespace       string                `json:"namespace"`
+	TargetKind      string                `json:"target_kind"`
+	TargetName      string                `json:"target_name"`
+	MinReplicas     int32                 `json:"min_replicas"`
+	MaxReplicas     int32                 `json:"max_replicas"`
+	CurrentReplicas int32                 `json:"current_replicas"`
+	DesiredReplicas int32                 `json:"desired_replicas"`
+	Metrics         []AutoscalerMetric    `json:"metrics"`
+	Conditions      []AutoscalerCondition `json:"conditions,omitempty"`
+	LastScaleTime   *time.Time            `json:"last_scale_time,omitempty"`
+	CreatedAt       time.Time             `json:"created_at"`
+}
+
+// AutoscalerMetric represents a metric an autoscaler scales on, with its target and current value
+type AutoscalerMetric struct {
+	Type    string `json:"type"` // Resource, ContainerResource, Pods, Object or External
+	Name    string `json:"name"`
+	Target  string `json:"target"`
+	Current string `json:"current,omitempty"` // Empty until the autoscaler has read the metric
+}
+
+// AutoscalerCondition represents an autoscaler condition such as AbleToScale or ScalingLimited
+type AutoscalerCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Domain methods for HorizontalPodAutoscaler
+
+// Targets checks if the autoscaler scales the given workload
+func (h *HorizontalPodAutoscaler) Targets(kind, name string) bool {
+	return h.TargetKind == kind && h.TargetName == name
+}
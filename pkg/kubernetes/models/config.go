@@ -1,17 +1,85 @@
 package models
 
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultCacheResyncPeriod is used when a cluster enables the cache without a resync period
+const DefaultCacheResyncPeriod = 10 * time.Minute
+
+// DefaultExecAPIVersion is the client authentication API version used by exec plugins when none is configured
+const DefaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+// AuthMode represents how DashOps authenticates against a cluster
+type AuthMode string
+
+const (
+	AuthModeKubeconfig AuthMode = "kubeconfig"
+	AuthModeInCluster  AuthMode = "in_cluster"
+	AuthModeToken      AuthMode = "token"
+	AuthModeExec       AuthMode = "exec"
+)
+
 // KubernetesConfig represents kubernetes configuration
 type KubernetesConfig struct {
-	Name       string     `yaml:"name"`
-	Kubeconfig string     `yaml:"kubeconfig"`
-	Context    string     `yaml:"context"`
-	Permission Permission `yaml:"permission"`
-	Listen     string     `yaml:"-"`
+	Name       string      `yaml:"name"`
+	Kubeconfig string      `yaml:"kubeconfig"`
+	Context    string      `yaml:"context"`
+	Auth       AuthConfig  `yaml:"auth"`
+	Permission Permission  `yaml:"permission"`
+	Cache      CacheConfig `yaml:"cache"`
+	Listen     string      `yaml:"-"`
+}
+
+// ImpersonationConfig represents the opt-in impersonation of the signed-in user on API calls,
+// so the cluster's own RBAC decides what each user can do
+type ImpersonationConfig struct {
+	Enabled     bool   `yaml:"enabled"`
+	UserPrefix  string `yaml:"user_prefix"`  // e.g. "github:" to keep DashOps users apart from other identities
+	GroupPrefix string `yaml:"group_prefix"` // prepended to every impersonated group
+}
+
+// AuthConfig represents credentials configured directly instead of through a kubeconfig file.
+// Without a mode, a cluster with a kubeconfig uses it and any other cluster uses the pod's service account.
+type AuthConfig struct {
+	Mode                  AuthMode            `yaml:"mode"`
+	Server                string              `yaml:"server"`
+	Token                 string              `yaml:"token"`
+	TokenFile             string              `yaml:"token_file"`
+	CAFile                string              `yaml:"ca_file"`
+	CAData                string              `yaml:"ca_data"` // PEM, or base64 encoded PEM as in kubeconfig files
+	InsecureSkipTLSVerify bool                `yaml:"insecure_skip_tls_verify"`
+	Exec                  *ExecAuthConfig     `yaml:"exec"`
+	Impersonation         ImpersonationConfig `yaml:"impersonation"`
+}
+
+// ExecAuthConfig represents an exec credential plugin such as aws-iam-authenticator or gke-gcloud-auth-plugin
+type ExecAuthConfig struct {
+	Command    string            `yaml:"command"`
+	Args       []string          `yaml:"args"`
+	Env        map[string]string `yaml:"env"`
+	APIVersion string            `yaml:"api_version"`
+}
+
+// CacheConfig represents the optional informer cache of a cluster
+type CacheConfig struct {
+	Enabled      bool   `yaml:"enabled"`
+	ResyncPeriod string `yaml:"resync_period"`
 }
 
 // Permission represents kubernetes permissions
 type Permission struct {
 	Deployments DeploymentsPermissions `yaml:"deployments" json:"deployments"`
+	ConfigMaps  ConfigMapsPermissions  `yaml:"configmaps" json:"configmaps"`
+	Exec        []string               `yaml:"exec" json:"exec"`
+	Nodes       NodesPermissions       `yaml:"nodes" json:"nodes"`
+	Apply       []string               `yaml:"apply" json:"apply"`
 }
 
 // DeploymentsPermissions represents deployment permissions
@@ -21,7 +89,240 @@ type DeploymentsPermissions struct {
 	Scale      []string `yaml:"scale" json:"scale"`
 }
 
+// ConfigMapsPermissions represents config map permissions
+type ConfigMapsPermissions struct {
+	Values []string `yaml:"values" json:"values"`
+}
+
+// NodesPermissions represents node permissions
+type NodesPermissions struct {
+	Maintenance []string `yaml:"maintenance" json:"maintenance"`
+}
+
+// Permissions of the Kubernetes module in the central RBAC model, scoped by cluster context and namespace
+const (
+	RBACDeploymentsScale    = "k8s:deployments:scale"
+	RBACDeploymentsRestart  = "k8s:deployments:restart"
+	RBACDeploymentsRollback = "k8s:deployments:rollback"
+	RBACPodsDelete          = "k8s:pods:delete"
+	RBACPodsExec            = "k8s:pods:exec"
+	RBACCronJobsManage      = "k8s:cronjobs:manage"
+	RBACConfigMapsValues    = "k8s:configmaps:values"
+	RBACResourcesApply      = "k8s:resources:apply"
+	RBACNodesMaintain       = "k8s:nodes:maintain"
+	RBACNamespacesManage    = "k8s:namespaces:manage"
+)
+
 // ModuleConfig represents the kubernetes module configuration
 type ModuleConfig struct {
 	Configs []KubernetesConfig `yaml:"kubernetes_configs" json:"kubernetes_configs"`
+	Origin  string             `yaml:"origin" json:"origin"`
+}
+
+// GetResyncPeriod returns the parsed resync period, falling back to the default
+func (c *CacheConfig) GetResyncPeriod() (time.Duration, error) {
+	if c.ResyncPeriod == "" {
+		return DefaultCacheResyncPeriod, nil
+	}
+
+	period, err := time.ParseDuration(c.ResyncPeriod)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cache resync period %q: %w", c.ResyncPeriod, err)
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("cache resync period must be positive")
+	}
+	return period, nil
+}
+
+// GetAuthMode returns the configured auth mode, inferring it for configs written before auth modes existed
+func (c *KubernetesConfig) GetAuthMode() AuthMode {
+	switch {
+	case c.Auth.Mode != "":
+		return c.Auth.Mode
+	case c.Kubeconfig != "":
+		return AuthModeKubeconfig
+	default:
+		return AuthModeInCluster
+	}
+}
+
+// ValidateAuth checks that the settings required by the auth mode are present and do not conflict
+func (c *KubernetesConfig) ValidateAuth() error {
+	// Cached reads are answered without asking the API server, so they would bypass the user's RBAC
+	if c.Auth.Impersonation.Enabled && c.Cache.Enabled {
+		return fmt.Errorf("impersonation cannot be combined with the informer cache")
+	}
+
+	mode := c.GetAuthMode()
+	direct := c.Auth.Server != "" || c.Auth.Token != "" || c.Auth.TokenFile != "" || c.Auth.Exec != nil
+
+	switch mode {
+	case AuthModeKubeconfig:
+		if c.Kubeconfig == "" {
+			return fmt.Errorf("kubeconfig auth requires a kubeconfig path")
+		}
+		if direct {
+			return fmt.Errorf("kubeconfig auth cannot be combined with server, token or exec settings")
+		}
+		if _, err := os.Stat(c.Kubeconfig); err != nil {
+			return fmt.Errorf("kubeconfig is not readable: %w", err)
+		}
+		return nil
+	case AuthModeInCluster:
+		if c.Kubeconfig != "" || direct {
+			return fmt.Errorf("in_cluster auth uses the pod service account and cannot be combined with a kubeconfig, server, token or exec settings")
+		}
+		return nil
+	case AuthModeToken:
+		if c.Auth.Exec != nil {
+			return fmt.Errorf("token auth cannot be combined with exec settings")
+		}
+		if (c.Auth.Token == "") == (c.Auth.TokenFile == "") {
+			return fmt.Errorf("token auth requires exactly one of token or token_file")
+		}
+		if c.Auth.TokenFile != "" {
+			if _, err := os.Stat(c.Auth.TokenFile); err != nil {
+				return fmt.Errorf("token file is not readable: %w", err)
+			}
+		}
+	case AuthModeExec:
+		if c.Auth.Token != "" || c.Auth.TokenFile != "" {
+			return fmt.Errorf("exec auth cannot be combined with token settings")
+		}
+		if c.Auth.Exec == nil || c.Auth.Exec.Command == "" {
+			return fmt.Errorf("exec auth requires an exec command")
+		}
+	default:
+		return fmt.Errorf("unknown auth mode %q", mode)
+	}
+
+	// Token and exec auth talk to the API server directly, so they need its address and how to trust it
+	if c.Kubeconfig != "" {
+		return fmt.Errorf("%s auth cannot be combined with a kubeconfig", mode)
+	}
+	server, err := url.Parse(c.Auth.Server)
+	if c.Auth.Server == "" || err != nil || server.Scheme != "https" || server.Host == "" {
+		return fmt.Errorf("%s auth requires an https server URL", mode)
+	}
+	return c.Auth.validateCA()
+}
+
+// CABundle returns the configured CA certificates as PEM, decoding base64 data when needed
+func (a *AuthConfig) CABundle() ([]byte, error) {
+	data := strings.TrimSpace(a.CAData)
+	if data == "" || strings.HasPrefix(data, "-----BEGIN") {
+		return []byte(data), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("ca_data is neither PEM nor base64 encoded PEM: %w", err)
+	}
+	return decoded, nil
+}
+
+// validateCA checks that exactly one way of trusting the API server is configured
+func (a *AuthConfig) validateCA() error {
+	sources := 0
+	for _, set := range []bool{a.CAFile != "", a.CAData != "", a.InsecureSkipTLSVerify} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return fmt.Errorf("exactly one of ca_file, ca_data or insecure_skip_tls_verify is required")
+	}
+
+	if a.CAFile != "" {
+		if _, err := os.Stat(a.CAFile); err != nil {
+			return fmt.Errorf("ca file is not readable: %w", err)
+		}
+	}
+	if _, err := a.CABundle(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// HasRestartPermission checks if user groups allow restarting deployments
+func (p *Permission) HasRestartPermission(userGroups []string) bool {
+	return hasPermission(p.Deployments.Restart, userGroups)
+}
+
+// HasScalePermission checks if user groups allow scaling deployments
+func (p *Permission) HasScalePermission(userGroups []string) bool {
+	return hasPermission(p.Deployments.Scale, userGroups)
+}
+
+// HasConfigMapValuesPermission checks if user groups allow reading config map values.
+// Unlike the other permissions, values stay hidden until groups are configured.
+func (p *Permission) HasConfigMapValuesPermission(userGroups []string) bool {
+	if len(p.ConfigMaps.Values) == 0 {
+		return false
+	}
+	return hasPermission(p.ConfigMaps.Values, userGroups)
+}
+
+// HasExecPermission checks if user groups allow opening a shell in pods.
+// Like config map values, exec stays disabled until groups are configured.
+func (p *Permission) HasExecPermission(userGroups []string) bool {
+	if len(p.Exec) == 0 {
+		return false
+	}
+	return hasPermission(p.Exec, userGroups)
+}
+
+// HasNodeMaintenancePermission checks if user groups allow cordoning and draining nodes.
+// Node maintenance affects every namespace, so it is denied until groups are configured.
+func (p *Permission) HasNodeMaintenancePermission(userGroups []string) bool {
+	if len(p.Nodes.Maintenance) == 0 {
+		return false
+	}
+	return hasPermission(p.Nodes.Maintenance, userGroups)
+}
+
+// HasApplyPermission checks if user groups allow applying manifests and deployment changes.
+// Applying can change any namespaced object, so it is denied until groups are configured.
+func (p *Permission) HasApplyPermission(userGroups []string) bool {
+	if len(p.Apply) == 0 {
+		return false
+	}
+	return hasPermission(p.Apply, userGroups)
+}
+
+// IsNamespaceAllowed checks if a namespace matches the allowed namespaces.
+// Entries may be exact names or glob patterns such as "team-*".
+func (p *Permission) IsNamespaceAllowed(namespace string) bool {
+	if len(p.Deployments.Namespaces) == 0 {
+		return true // No namespace restriction configured
+	}
+
+	for _, allowed := range p.Deployments.Namespaces {
+		if allowed == namespace {
+			return true
+		}
+		if matched, err := path.Match(allowed, namespace); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasPermission checks if user has any of the required groups
+func hasPermission(requiredGroups []string, userGroups []string) bool {
+	if len(requiredGroups) == 0 {
+		return true // No permissions required
+	}
+
+	for _, required := range requiredGroups {
+		for _, userGroup := range userGroups {
+			if strings.EqualFold(required, userGroup) {
+				return true
+			}
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,141 @@
+package models
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheConfig_GetResyncPeriod_WithEmptyValue_ReturnsDefault(t *testing.T) {
+	// Arrange
+	config := CacheConfig{Enabled: true}
+
+	// Act
+	period, err := config.GetResyncPeriod()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultCacheResyncPeriod, period)
+}
+
+func TestCacheConfig_GetResyncPeriod_WithDuration_ReturnsParsedValue(t *testing.T) {
+	// Arrange
+	config := CacheConfig{Enabled: true, ResyncPeriod: "90s"}
+
+	// Act
+	period, err := config.GetResyncPeriod()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 90*time.Second, period)
+}
+
+func TestCacheConfig_GetResyncPeriod_WithInvalidValue_ReturnsError(t *testing.T) {
+	// Arrange
+	config := CacheConfig{Enabled: true, ResyncPeriod: "often"}
+
+	// Act
+	_, err := config.GetResyncPeriod()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid cache resync period")
+}
+
+func TestKubernetesConfig_GetAuthMode_WithoutMode_InfersFromKubeconfig(t *testing.T) {
+	// Arrange
+	withKubeconfig := KubernetesConfig{Kubeconfig: "/etc/kube/config"}
+	withoutKubeconfig := KubernetesConfig{}
+	explicit := KubernetesConfig{Auth: AuthConfig{Mode: AuthModeToken}}
+
+	// Act & Assert
+	assert.Equal(t, AuthModeKubeconfig, withKubeconfig.GetAuthMode())
+	assert.Equal(t, AuthModeInCluster, withoutKubeconfig.GetAuthMode())
+	assert.Equal(t, AuthModeToken, explicit.GetAuthMode())
+}
+
+func TestKubernetesConfig_ValidateAuth_WithValidConfigs_ReturnsNil(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	kubeconfig := writeTempFile(t, dir, "config", "apiVersion: v1\nkind: Config\n")
+	caFile := writeTempFile(t, dir, "ca.crt", "-----BEGIN CERTIFICATE-----\n")
+	tokenFile := writeTempFile(t, dir, "token", "secret")
+	configs := map[string]KubernetesConfig{
+		"kubeconfig": {Kubeconfig: kubeconfig},
+		"in cluster": {Auth: AuthConfig{Mode: AuthModeInCluster}},
+		"token":      {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", Token: "secret", CAFile: caFile}},
+		"token file": {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", TokenFile: tokenFile, InsecureSkipTLSVerify: true}},
+		"exec": {Auth: AuthConfig{
+			Mode:   AuthModeExec,
+			Server: "https://api.prod:6443",
+			CAData: base64.StdEncoding.EncodeToString([]byte("-----BEGIN CERTIFICATE-----\n")),
+			Exec:   &ExecAuthConfig{Command: "aws", Args: []string{"eks", "get-token"}},
+		}},
+	}
+
+	for name, config := range configs {
+		// Act
+		err := config.ValidateAuth()
+
+		// Assert
+		assert.NoError(t, err, name)
+	}
+}
+
+func TestKubernetesConfig_ValidateAuth_WithInvalidConfigs_ReturnsError(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	kubeconfig := writeTempFile(t, dir, "config", "apiVersion: v1\nkind: Config\n")
+	configs := map[string]KubernetesConfig{
+		"missing kubeconfig":     {Auth: AuthConfig{Mode: AuthModeKubeconfig}},
+		"unreadable kubeconfig":  {Kubeconfig: filepath.Join(dir, "missing")},
+		"kubeconfig with token":  {Kubeconfig: kubeconfig, Auth: AuthConfig{Token: "secret"}},
+		"in cluster with server": {Auth: AuthConfig{Mode: AuthModeInCluster, Server: "https://api.prod:6443"}},
+		"token and token file":   {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", Token: "a", TokenFile: kubeconfig, InsecureSkipTLSVerify: true}},
+		"token without token":    {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", InsecureSkipTLSVerify: true}},
+		"plain http server":      {Auth: AuthConfig{Mode: AuthModeToken, Server: "http://api.prod:6443", Token: "a", InsecureSkipTLSVerify: true}},
+		"missing ca":             {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", Token: "a"}},
+		"ca and insecure":        {Auth: AuthConfig{Mode: AuthModeToken, Server: "https://api.prod:6443", Token: "a", CAData: "-----BEGIN CERTIFICATE-----", InsecureSkipTLSVerify: true}},
+		"exec without command":   {Auth: AuthConfig{Mode: AuthModeExec, Server: "https://api.prod:6443", InsecureSkipTLSVerify: true, Exec: &ExecAuthConfig{}}},
+		"unknown mode":           {Auth: AuthConfig{Mode: "password"}},
+		"impersonation cached":   {Kubeconfig: kubeconfig, Cache: CacheConfig{Enabled: true}, Auth: AuthConfig{Impersonation: ImpersonationConfig{Enabled: true}}},
+	}
+
+	for name, config := range configs {
+		// Act
+		err := config.ValidateAuth()
+
+		// Assert
+		assert.Error(t, err, name)
+	}
+}
+
+func TestAuthConfig_CABundle_WithBase64Data_ReturnsDecodedPEM(t *testing.T) {
+	// Arrange
+	pem := "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+	encoded := AuthConfig{CAData: base64.StdEncoding.EncodeToString([]byte(pem))}
+	plain := AuthConfig{CAData: pem}
+
+	// Act
+	fromEncoded, encodedErr := encoded.CABundle()
+	fromPlain, plainErr := plain.CABundle()
+
+	// Assert
+	require.NoError(t, encodedErr)
+	require.NoError(t, plainErr)
+	assert.Equal(t, pem, string(fromEncoded))
+	assert.Equal(t, "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----", string(fromPlain))
+}
+
+// writeTempFile writes a file in dir and returns its path
+func writeTempFile(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
@@ -0,0 +1,143 @@
+package models
+
+import "time"
+
+// DrainStatus represents the state of a node drain operation
+type DrainStatus string
+
+const (
+	DrainStatusRunning   DrainStatus = "running"
+	DrainStatusSucceeded DrainStatus = "succeeded"
+	DrainStatusFailed    DrainStatus = "failed"
+	DrainStatusTimedOut  DrainStatus = "timed_out"
+	DrainStatusCancelled DrainStatus = "cancelled" // DashOps shut down before the drain finished
+)
+
+// DrainPodStatus represents the eviction progress of a single pod
+type DrainPodStatus string
+
+const (
+	DrainPodPending  DrainPodStatus = "pending"
+	DrainPodBlocked  DrainPodStatus = "blocked"  // Eviction refused, usually by a PodDisruptionBudget
+	DrainPodEvicting DrainPodStatus = "evicting" // Eviction accepted, waiting for the pod to terminate
+	DrainPodEvicted  DrainPodStatus = "evicted"
+	DrainPodSkipped  DrainPodStatus = "skipped"
+	DrainPodFailed   DrainPodStatus = "failed"
+)
+
+// DefaultDrainTimeout is how long a drain runs when no timeout is requested
+const DefaultDrainTimeout = 5 * time.Minute
+
+// MaxDrainTimeout bounds how long a single drain may run
+const MaxDrainTimeout = time.Hour
+
+// DrainOptions represents the options of a node drain
+type DrainOptions struct {
+	GracePeriodSeconds *int64 `json:"grace_period_seconds,omitempty"` // nil uses each pod's own grace period
+	TimeoutSeconds     int64  `json:"timeout_seconds"`
+	DeleteEmptyDirData bool   `json:"delete_emptydir_data"`
+	Force              bool   `json:"force"` // Also evict pods without a controller
+}
+
+// Timeout returns how long the drain may run, falling back to the default
+func (o *DrainOptions) Timeout() time.Duration {
+	if o.TimeoutSeconds <= 0 {
+		return DefaultDrainTimeout
+	}
+	return time.Duration(o.TimeoutSeconds) * time.Second
+}
+
+// DrainCandidate represents a pod scheduled on a node being drained
+type DrainCandidate struct {
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	UID         string `json:"uid"`
+	OwnerKind   string `json:"owner_kind,omitempty"`
+	Mirror      bool   `json:"mirror"`
+	HasEmptyDir bool   `json:"has_empty_dir"`
+	Finished    bool   `json:"finished"`
+}
+
+// DrainPod represents the drain progress of a pod
+type DrainPod struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	UID       string         `json:"uid"`
+	Status    DrainPodStatus `json:"status"`
+	Reason    string         `json:"reason,omitempty"`
+	Attempts  int            `json:"attempts"`
+}
+
+// DrainOperation represents a tracked node drain
+type DrainOperation struct {
+	ID          string       `json:"id"`
+	Context     string       `json:"context"`
+	Node        string       `json:"node"`
+	User        string       `json:"user,omitempty"`
+	Status      DrainStatus  `json:"status"`
+	Message     string       `json:"message,omitempty"`
+	Options     DrainOptions `json:"options"`
+	Pods        []DrainPod   `json:"pods"`
+	StartedAt   time.Time    `json:"started_at"`
+	CompletedAt *time.Time   `json:"completed_at,omitempty"`
+}
+
+// DrainProgress summarizes pod progress of a drain
+type DrainProgress struct {
+	Total    int `json:"total"`
+	Evicted  int `json:"evicted"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+	Inflight int `json:"inflight"`
+}
+
+// Domain methods for DrainOperation
+
+// Copy returns a copy of the drain that does not share its pod list
+func (d *DrainOperation) Copy() DrainOperation {
+	operation := *d
+	operation.Pods = append([]DrainPod(nil), d.Pods...)
+	return operation
+}
+
+// HasPendingPods checks if any pod still waits for its eviction to be accepted or to finish
+func (d *DrainOperation) HasPendingPods() bool {
+	for _, pod := range d.Pods {
+		switch pod.Status {
+		case DrainPodPending, DrainPodBlocked, DrainPodEvicting:
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinished checks if the drain reached a final state
+func (d *DrainOperation) IsFinished() bool {
+	return d.Status != DrainStatusRunning
+}
+
+// Progress counts the pods of the drain by outcome
+func (d *DrainOperation) Progress() DrainProgress {
+	progress := DrainProgress{Total: len(d.Pods)}
+	for _, pod := range d.Pods {
+		switch pod.Status {
+		case DrainPodEvicted:
+			progress.Evicted++
+		case DrainPodSkipped:
+			progress.Skipped++
+		case DrainPodFailed:
+			progress.Failed++
+		default:
+			progress.Inflight++
+		}
+	}
+	return progress
+}
+
+// Complete marks the drain as finished with the given status
+func (d *DrainOperation) Complete(status DrainStatus, message string) {
+	completedAt := time.Now()
+	d.Status = status
+	d.Message = message
+	d.CompletedAt = &completedAt
+}
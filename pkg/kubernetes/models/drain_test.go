@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrainOptions_Timeout_WithoutValue_ReturnsDefault(t *testing.T) {
+	// Arrange
+	defaults := DrainOptions{}
+	custom := DrainOptions{TimeoutSeconds: 90}
+
+	// Act
+	defaultTimeout := defaults.Timeout()
+	customTimeout := custom.Timeout()
+
+	// Assert
+	assert.Equal(t, DefaultDrainTimeout, defaultTimeout)
+	assert.Equal(t, 90*time.Second, customTimeout)
+}
+
+func TestDrainOperation_Progress_CountsPodsByOutcome(t *testing.T) {
+	// Arrange
+	operation := DrainOperation{
+		Status: DrainStatusRunning,
+		Pods: []DrainPod{
+			{Name: "web-1", Status: DrainPodEvicted},
+			{Name: "web-2", Status: DrainPodBlocked},
+			{Name: "fluentd-x", Status: DrainPodSkipped},
+			{Name: "debug", Status: DrainPodFailed},
+		},
+	}
+
+	// Act
+	progress := operation.Progress()
+
+	// Assert
+	assert.Equal(t, DrainProgress{Total: 4, Evicted: 1, Skipped: 1, Failed: 1, Inflight: 1}, progress)
+	assert.True(t, operation.HasPendingPods())
+	assert.False(t, operation.IsFinished())
+}
@@ -7,12 +7,22 @@ import (
 
 // Cluster represents a Kubernetes cluster configuration
 type Cluster struct {
-	Name       string        `yaml:"name" json:"name"`
-	Context    string        `yaml:"context" json:"context"`
-	Kubeconfig string        `yaml:"kubeconfig" json:"kubeconfig"`
-	Server     string        `json:"server,omitempty"`
-	Version    string        `json:"version,omitempty"`
-	Status     ClusterStatus `json:"status"`
+	Name          string        `yaml:"name" json:"name"`
+	Context       string        `yaml:"context" json:"context"`
+	Kubeconfig    string        `yaml:"kubeconfig" json:"kubeconfig"`
+	Server        string        `json:"server,omitempty"`
+	Version       string        `json:"version,omitempty"`
+	Status        ClusterStatus `json:"status"`
+	AuthMode      AuthMode      `json:"auth_mode,omitempty"`
+	Impersonation bool          `json:"impersonation,omitempty"`
+	Error         string        `json:"error,omitempty"`
+	Cache         *ClusterCache `json:"cache,omitempty"`
+}
+
+// ClusterCache represents the sync status of a cluster informer cache
+type ClusterCache struct {
+	Synced   bool       `json:"synced"`
+	SyncedAt *time.Time `json:"synced_at,omitempty"`
 }
 
 // ClusterStatus represents cluster connection status
@@ -28,16 +38,17 @@ const (
 
 // Node represents a Kubernetes node
 type Node struct {
-	Name       string          `json:"name"`
-	Status     NodeStatus      `json:"status"`
-	Roles      []string        `json:"roles"`
-	Age        string          `json:"age"`
-	Version    string          `json:"version"`
-	InternalIP string          `json:"internal_ip"`
-	ExternalIP string          `json:"external_ip,omitempty"`
-	Conditions []NodeCondition `json:"conditions"`
-	Resources  NodeResources   `json:"resources"`
-	CreatedAt  time.Time       `json:"created_at"`
+	Name          string          `json:"name"`
+	Status        NodeStatus      `json:"status"`
+	Roles         []string        `json:"roles"`
+	Age           string          `json:"age"`
+	Version       string          `json:"version"`
+	Unschedulable bool            `json:"unschedulable"`
+	InternalIP    string          `json:"internal_ip"`
+	ExternalIP    string          `json:"external_ip,omitempty"`
+	Conditions    []NodeCondition `json:"conditions"`
+	Resources     NodeResources   `json:"resources"`
+	CreatedAt     time.Time       `json:"created_at"`
 }
 
 // NodeStatus represents node operational status
@@ -58,11 +69,14 @@ type NodeCondition struct {
 	LastTransitionTime time.Time `json:"last_transition_time"`
 }
 
-// NodeResources represents node resource information
+// NodeResources represents node resource information.
+// Used sums the requests of scheduled pods; Usage is live consumption from metrics-server.
 type NodeResources struct {
-	Capacity    ResourceList `json:"capacity"`
-	Allocatable ResourceList `json:"allocatable"`
-	Used        ResourceList `json:"used,omitempty"`
+	Capacity    ResourceList  `json:"capacity"`
+	Allocatable ResourceList  `json:"allocatable"`
+	Used        ResourceList  `json:"used,omitempty"`
+	Limits      ResourceList  `json:"limits,omitempty"`
+	Usage       *ResourceList `json:"usage,omitempty"`
 }
 
 // ResourceList represents resource quantities
@@ -74,11 +88,13 @@ type ResourceList struct {
 
 // Namespace represents a Kubernetes namespace
 type Namespace struct {
-	Name      string            `json:"name"`
-	Status    NamespaceStatus   `json:"status"`
-	Labels    map[string]string `json:"labels,omitempty"`
-	Age       string            `json:"age"`
-	CreatedAt time.Time         `json:"created_at"`
+	Name           string            `json:"name"`
+	Status         NamespaceStatus   `json:"status"`
+	Labels         map[string]string `json:"labels,omitempty"`
+	Age            string            `json:"age"`
+	CreatedAt      time.Time         `json:"created_at"`
+	ResourceQuotas []ResourceQuota   `json:"resource_quotas,omitempty"`
+	LimitRanges    []LimitRange      `json:"limit_ranges,omitempty"`
 }
 
 // NamespaceStatus represents namespace status
@@ -99,6 +115,17 @@ type Deployment struct {
 	CreatedAt      time.Time             `json:"created_at"`
 	Conditions     []DeploymentCondition `json:"conditions"`
 	ServiceContext *ServiceContext       `json:"service_context,omitempty"`
+	Labels         map[string]string     `json:"labels,omitempty"`
+	PodLabels      map[string]string     `json:"pod_labels,omitempty"`
+	Images         []string              `json:"images,omitempty"`
+
+	// Autoscaler is set when a HorizontalPodAutoscaler manages the deployment's replicas
+	Autoscaler *HorizontalPodAutoscaler `json:"autoscaler,omitempty"`
+
+	// Rollout bookkeeping used to compute rollout status
+	Revision           int64 `json:"revision,omitempty"`
+	Generation         int64 `json:"generation,omitempty"`
+	ObservedGeneration int64 `json:"observed_generation,omitempty"`
 }
 
 // PodInfo represents pod information summary
@@ -115,6 +142,7 @@ type DeploymentReplicas struct {
 	Current   int32 `json:"current"`
 	Ready     int32 `json:"ready"`
 	Available int32 `json:"available"`
+	Updated   int32 `json:"updated"`
 }
 
 // DeploymentCondition represents a deployment condition
@@ -126,21 +154,54 @@ type DeploymentCondition struct {
 	LastUpdateTime time.Time `json:"last_update_time"`
 }
 
+// DeploymentRevision represents a ReplicaSet revision in a deployment's rollout history
+type DeploymentRevision struct {
+	Revision    int64     `json:"revision"`
+	ReplicaSet  string    `json:"replica_set"`
+	ChangeCause string    `json:"change_cause,omitempty"`
+	Images      []string  `json:"images"`
+	Replicas    int32     `json:"replicas"`
+	Ready       int32     `json:"ready"`
+	CreatedAt   time.Time `json:"created_at"`
+	Current     bool      `json:"current"`
+}
+
+// RolloutState represents the state of a deployment rollout
+type RolloutState string
+
+const (
+	RolloutStateProgressing RolloutState = "progressing"
+	RolloutStateComplete    RolloutState = "complete"
+	RolloutStateStalled     RolloutState = "stalled"
+)
+
+// RolloutStatus represents the rollout status of a deployment
+type RolloutStatus struct {
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	State     RolloutState       `json:"state"`
+	Message   string             `json:"message"`
+	Revision  int64              `json:"revision"`
+	Replicas  DeploymentReplicas `json:"replicas"`
+}
+
 // Pod represents a Kubernetes pod
 type Pod struct {
-	Name       string         `json:"name"`
-	Namespace  string         `json:"namespace"`
-	Status     PodStatus      `json:"status"`
-	Phase      string         `json:"phase"`
-	Node       string         `json:"node"`
-	Age        string         `json:"age"`
-	Restarts   int32          `json:"restarts"`
-	Ready      string         `json:"ready"`
-	IP         string         `json:"ip,omitempty"`
-	Containers []Container    `json:"containers"`
-	Conditions []PodCondition `json:"conditions"`
-	CreatedAt  time.Time      `json:"created_at"`
-	QoSClass   string         `json:"qos_class,omitempty"`
+	Name       string            `json:"name"`
+	Namespace  string            `json:"namespace"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Status     PodStatus         `json:"status"`
+	Phase      string            `json:"phase"`
+	Node       string            `json:"node"`
+	Age        string            `json:"age"`
+	Restarts   int32             `json:"restarts"`
+	Ready      string            `json:"ready"`
+	IP         string            `json:"ip,omitempty"`
+	Containers []Container       `json:"containers"`
+	Conditions []PodCondition    `json:"conditions"`
+	CreatedAt  time.Time         `json:"created_at"`
+	QoSClass   string            `json:"qos_class,omitempty"`
+	Resources  *ResourceUsage    `json:"resources,omitempty"`
 }
 
 // PodStatus represents pod operational status
@@ -162,6 +223,7 @@ type Container struct {
 	RestartCount int32              `json:"restart_count"`
 	State        ContainerState     `json:"state"`
 	Resources    ContainerResources `json:"resources,omitempty"`
+	Usage        *ResourceList      `json:"usage,omitempty"`
 }
 
 // ContainerState represents container state
@@ -197,6 +259,18 @@ type ContainerResources struct {
 	Limits   ResourceList `json:"limits,omitempty"`
 }
 
+// ResourceUsage compares live usage with requests and limits.
+// Percentages are zero when usage, the request or the limit is unknown.
+type ResourceUsage struct {
+	Requests             ResourceList  `json:"requests"`
+	Limits               ResourceList  `json:"limits"`
+	Usage                *ResourceList `json:"usage,omitempty"`
+	CPURequestPercent    float64       `json:"cpu_request_percent,omitempty"`
+	CPULimitPercent      float64       `json:"cpu_limit_percent,omitempty"`
+	MemoryRequestPercent float64       `json:"memory_request_percent,omitempty"`
+	MemoryLimitPercent   float64       `json:"memory_limit_percent,omitempty"`
+}
+
 // PodCondition represents a pod condition
 type PodCondition struct {
 	Type               string    `json:"type"`
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"io"
+	"time"
+)
+
+// DefaultExecCommand is run when an exec session does not ask for a command
+var DefaultExecCommand = []string{"/bin/sh"}
+
+// ExecSession represents an interactive command run in a pod container
+type ExecSession struct {
+	ID        string     `json:"id"`
+	Context   string     `json:"context"`
+	Namespace string     `json:"namespace"`
+	Pod       string     `json:"pod"`
+	Container string     `json:"container"`
+	Command   []string   `json:"command"`
+	TTY       bool       `json:"tty"`
+	User      string     `json:"user"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	ExitCode  int        `json:"exit_code"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// TerminalSize represents the size of an interactive terminal
+type TerminalSize struct {
+	Width  uint16 `json:"width"`
+	Height uint16 `json:"height"`
+}
+
+// ExecStreams holds the streams bridged to an exec session
+type ExecStreams struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	Resize <-chan TerminalSize
+}
+
+// Domain methods for ExecSession
+
+// Duration returns how long the session ran, or has been running so far
+func (s *ExecSession) Duration() time.Duration {
+	end := time.Now()
+	if s.EndedAt != nil {
+		end = *s.EndedAt
+	}
+	return end.Sub(s.StartedAt)
+}
+
+// Finish marks the session as ended, keeping the error that ended it
+func (s *ExecSession) Finish(err error) {
+	endedAt := time.Now()
+	s.EndedAt = &endedAt
+	if err != nil {
+		s.Error = err.Error()
+	}
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecSession_Finish_WithError_RecordsEndAndError(t *testing.T) {
+	// Arrange
+	session := ExecSession{StartedAt: time.Now().Add(-time.Minute)}
+
+	// Act
+	session.Finish(errors.New("command terminated with exit code 1"))
+
+	// Assert
+	assert.NotNil(t, session.EndedAt)
+	assert.Equal(t, "command terminated with exit code 1", session.Error)
+	assert.InDelta(t, time.Minute.Seconds(), session.Duration().Seconds(), 1)
+}
@@ -0,0 +1,95 @@
+package models
+
+import "time"
+
+// Service represents a Kubernetes service
+type Service struct {
+	Name        string            `json:"name"`
+	Namespace   string            `json:"namespace"`
+	Type        string            `json:"type"`
+	ClusterIP   string            `json:"cluster_ip,omitempty"`
+	ExternalIPs []string          `json:"external_ips,omitempty"`
+	Ports       []ServicePort     `json:"ports"`
+	Selector    map[string]string `json:"selector,omitempty"`
+	Endpoints   ServiceEndpoints  `json:"endpoints"`
+	Age         string            `json:"age"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// ServicePort represents a port exposed by a service
+type ServicePort struct {
+	Name       string `json:"name,omitempty"`
+	Protocol   string `json:"protocol"`
+	Port       int32  `json:"port"`
+	TargetPort string `json:"target_port"`
+	NodePort   int32  `json:"node_port,omitempty"`
+}
+
+// ServiceEndpoints represents the readiness of the endpoints backing a service
+type ServiceEndpoints struct {
+	Ready     int               `json:"ready"`
+	NotReady  int               `json:"not_ready"`
+	Addresses []EndpointAddress `json:"addresses"`
+}
+
+// EndpointAddress represents a single endpoint of a service
+type EndpointAddress struct {
+	IP       string `json:"ip"`
+	PodName  string `json:"pod_name,omitempty"`
+	NodeName string `json:"node_name,omitempty"`
+	Ready    bool   `json:"ready"`
+}
+
+// Ingress represents a Kubernetes ingress
+type Ingress struct {
+	Name         string        `json:"name"`
+	Namespace    string        `json:"namespace"`
+	ClassName    string        `json:"class_name,omitempty"`
+	Rules        []IngressRule `json:"rules"`
+	TLS          []IngressTLS  `json:"tls,omitempty"`
+	LoadBalancer []string      `json:"load_balancer,omitempty"`
+	Age          string        `json:"age"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
+// IngressRule represents the paths routed for a host
+type IngressRule struct {
+	Host  string        `json:"host,omitempty"`
+	Paths []IngressPath `json:"paths"`
+}
+
+// IngressPath represents a path routed to a backend service
+type IngressPath struct {
+	Path        string `json:"path"`
+	PathType    string `json:"path_type,omitempty"`
+	ServiceName string `json:"service_name,omitempty"`
+	ServicePort string `json:"service_port,omitempty"`
+}
+
+// IngressTLS represents TLS configuration for a set of hosts
+type IngressTLS struct {
+	Hosts      []string `json:"hosts"`
+	SecretName string   `json:"secret_name,omitempty"`
+}
+
+// ConfigMap represents a Kubernetes config map; values are only loaded on request
+type ConfigMap struct {
+	Name       string            `json:"name"`
+	Namespace  string            `json:"namespace"`
+	Keys       []string          `json:"keys"`
+	BinaryKeys []string          `json:"binary_keys,omitempty"`
+	Data       map[string]string `json:"data,omitempty"`
+	Immutable  bool              `json:"immutable"`
+	Age        string            `json:"age"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// Domain methods for Service
+
+// IsHealthy checks if a service selecting pods has at least one ready endpoint
+func (s *Service) IsHealthy() bool {
+	if len(s.Selector) == 0 {
+		return true // Endpoints are managed outside Kubernetes
+	}
+	return s.Endpoints.Ready > 0
+}
@@ -0,0 +1,59 @@
+package models
+
+// ResourceQuota represents a namespace resource quota with its hard limits and current usage
+type ResourceQuota struct {
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Scopes    []string        `json:"scopes,omitempty"`
+	Resources []QuotaResource `json:"resources"`
+}
+
+// QuotaResource represents the hard limit and usage of a single quota resource such as requests.cpu
+type QuotaResource struct {
+	Name               string         `json:"name"`
+	Hard               string         `json:"hard"`
+	Used               string         `json:"used"`
+	UtilizationPercent float64        `json:"utilization_percent"`
+	Status             ResourceStatus `json:"status"`
+}
+
+// LimitRange represents the default and allowed resources of a namespace
+type LimitRange struct {
+	Name      string           `json:"name"`
+	Namespace string           `json:"namespace"`
+	Limits    []LimitRangeItem `json:"limits"`
+}
+
+// LimitRangeItem represents the constraints a limit range applies to one kind of object
+type LimitRangeItem struct {
+	Type                 string            `json:"type"` // Container, Pod or PersistentVolumeClaim
+	Max                  map[string]string `json:"max,omitempty"`
+	Min                  map[string]string `json:"min,omitempty"`
+	Default              map[string]string `json:"default,omitempty"`
+	DefaultRequest       map[string]string `json:"default_request,omitempty"`
+	MaxLimitRequestRatio map[string]string `json:"max_limit_request_ratio,omitempty"`
+}
+
+// NamespaceQuotaUsage represents how close a namespace is to its quotas.
+// The namespace is ranked by its most utilized quota resource.
+type NamespaceQuotaUsage struct {
+	Namespace          string          `json:"namespace"`
+	Quota              string          `json:"quota"`
+	Resource           string          `json:"resource"`
+	UtilizationPercent float64         `json:"utilization_percent"`
+	Status             ResourceStatus  `json:"status"`
+	Quotas             []ResourceQuota `json:"quotas"`
+}
+
+// PeakResource returns the most utilized resource of the quota, if any
+func (q *ResourceQuota) PeakResource() (QuotaResource, bool) {
+	var peak QuotaResource
+	found := false
+	for _, resource := range q.Resources {
+		if !found || resource.UtilizationPercent > peak.UtilizationPercent {
+			peak = resource
+			found = true
+		}
+	}
+	return peak, found
+}
@@ -0,0 +1,62 @@
+package models
+
+// SearchMatchField represents which field of a workload matched a search query
+type SearchMatchField string
+
+const (
+	SearchMatchName  SearchMatchField = "name"
+	SearchMatchLabel SearchMatchField = "label"
+	SearchMatchImage SearchMatchField = "image"
+)
+
+// WorkloadMatch represents a deployment or pod matching a search query
+type WorkloadMatch struct {
+	Kind      string           `json:"kind"` // Deployment or Pod
+	Name      string           `json:"name"`
+	Namespace string           `json:"namespace"`
+	MatchedOn SearchMatchField `json:"matched_on"`
+	Images    []string         `json:"images,omitempty"`
+	Status    string           `json:"status,omitempty"`
+	Node      string           `json:"node,omitempty"`
+}
+
+// NamespaceSearchResult represents the matches of a namespace
+type NamespaceSearchResult struct {
+	Namespace   string          `json:"namespace"`
+	Deployments []WorkloadMatch `json:"deployments"`
+	Pods        []WorkloadMatch `json:"pods"`
+}
+
+// ClusterSearchResult represents the matches of a cluster grouped by namespace
+type ClusterSearchResult struct {
+	Name       string                  `json:"name"`
+	Context    string                  `json:"context"`
+	Namespaces []NamespaceSearchResult `json:"namespaces"`
+	Truncated  bool                    `json:"truncated"`
+}
+
+// ClusterSearchFailure represents a cluster that could not be searched
+type ClusterSearchFailure struct {
+	Name    string `json:"name"`
+	Context string `json:"context"`
+	Error   string `json:"error"`
+}
+
+// WorkloadSearchResult represents the result of a search across clusters.
+// Failed clusters are reported next to the partial results of the others.
+type WorkloadSearchResult struct {
+	Query    string                 `json:"query"`
+	Clusters []ClusterSearchResult  `json:"clusters"`
+	Failed   []ClusterSearchFailure `json:"failed"`
+}
+
+// Total returns the number of matches across all clusters
+func (r *WorkloadSearchResult) Total() int {
+	total := 0
+	for _, cluster := range r.Clusters {
+		for _, namespace := range cluster.Namespaces {
+			total += len(namespace.Deployments) + len(namespace.Pods)
+		}
+	}
+	return total
+}
@@ -0,0 +1,107 @@
+package models
+
+import "time"
+
+// StatefulSet represents a Kubernetes stateful set
+type StatefulSet struct {
+	Name           string             `json:"name"`
+	Namespace      string             `json:"namespace"`
+	Replicas       DeploymentReplicas `json:"replicas"`
+	ServiceName    string             `json:"service_name,omitempty"`
+	UpdateStrategy string             `json:"update_strategy"`
+	Images         []string           `json:"images"`
+	Age            string             `json:"age"`
+	CreatedAt      time.Time          `json:"created_at"`
+	HealthStatus   string             `json:"health_status,omitempty"`
+}
+
+// DaemonSet represents a Kubernetes daemon set
+type DaemonSet struct {
+	Name           string              `json:"name"`
+	Namespace      string              `json:"namespace"`
+	Scheduling     DaemonSetScheduling `json:"scheduling"`
+	NodeSelector   map[string]string   `json:"node_selector,omitempty"`
+	UpdateStrategy string              `json:"update_strategy"`
+	Images         []string            `json:"images"`
+	Age            string              `json:"age"`
+	CreatedAt      time.Time           `json:"created_at"`
+	HealthStatus   string              `json:"health_status,omitempty"`
+}
+
+// DaemonSetScheduling represents daemon set pod placement across nodes
+type DaemonSetScheduling struct {
+	Desired      int32 `json:"desired"`
+	Current      int32 `json:"current"`
+	Ready        int32 `json:"ready"`
+	Available    int32 `json:"available"`
+	Updated      int32 `json:"updated"`
+	Misscheduled int32 `json:"misscheduled"`
+}
+
+// JobStatus represents job status
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "Running"
+	JobStatusComplete  JobStatus = "Complete"
+	JobStatusFailed    JobStatus = "Failed"
+	JobStatusSuspended JobStatus = "Suspended"
+)
+
+// Job represents a Kubernetes job
+type Job struct {
+	Name           string     `json:"name"`
+	Namespace      string     `json:"namespace"`
+	Status         JobStatus  `json:"status"`
+	Completions    int32      `json:"completions"`
+	Parallelism    int32      `json:"parallelism"`
+	Active         int32      `json:"active"`
+	Succeeded      int32      `json:"succeeded"`
+	Failed         int32      `json:"failed"`
+	StartTime      *time.Time `json:"start_time,omitempty"`
+	CompletionTime *time.Time `json:"completion_time,omitempty"`
+	Duration       string     `json:"duration,omitempty"`
+	CronJob        string     `json:"cron_job,omitempty"`
+	Images         []string   `json:"images"`
+	Age            string     `json:"age"`
+	CreatedAt      time.Time  `json:"created_at"`
+	HealthStatus   string     `json:"health_status,omitempty"`
+}
+
+// CronJob represents a Kubernetes cron job
+type CronJob struct {
+	Name               string     `json:"name"`
+	Namespace          string     `json:"namespace"`
+	Schedule           string     `json:"schedule"`
+	TimeZone           string     `json:"time_zone,omitempty"`
+	Suspended          bool       `json:"suspended"`
+	ConcurrencyPolicy  string     `json:"concurrency_policy"`
+	ActiveJobs         []string   `json:"active_jobs"`
+	LastScheduleTime   *time.Time `json:"last_schedule_time,omitempty"`
+	LastSuccessfulTime *time.Time `json:"last_successful_time,omitempty"`
+	LastJobStatus      JobStatus  `json:"last_job_status,omitempty"`
+	Images             []string   `json:"images"`
+	Age                string     `json:"age"`
+	CreatedAt          time.Time  `json:"created_at"`
+	HealthStatus       string     `json:"health_status,omitempty"`
+}
+
+// WorkloadFilter represents namespace and label filtering for workload and networking resource lists
+type WorkloadFilter struct {
+	Namespace     string `json:"namespace,omitempty"`
+	LabelSelector string `json:"label_selector,omitempty"`
+}
+
+// Domain methods for Job
+
+// IsFinished checks if job has completed or failed
+func (j *Job) IsFinished() bool {
+	return j.Status == JobStatusComplete || j.Status == JobStatusFailed
+}
+
+// Domain methods for CronJob
+
+// HasActiveJobs checks if cron job currently has running jobs
+func (c *CronJob) HasActiveJobs() bool {
+	return len(c.ActiveJobs) > 0
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJob_IsFinished_WithCompleteOrFailedJob_ReturnsTrue(t *testing.T) {
+	// Arrange
+	complete := Job{Status: JobStatusComplete}
+	failed := Job{Status: JobStatusFailed}
+	running := Job{Status: JobStatusRunning}
+
+	// Act & Assert
+	assert.True(t, complete.IsFinished())
+	assert.True(t, failed.IsFinished())
+	assert.False(t, running.IsFinished())
+}
+
+func TestCronJob_HasActiveJobs_WithRunningJob_ReturnsTrue(t *testing.T) {
+	// Arrange
+	cronJob := CronJob{ActiveJobs: []string{"backup-29012345"}}
+
+	// Act
+	result := cronJob.HasActiveJobs()
+
+	// Assert
+	assert.True(t, result)
+}
@@ -11,17 +11,21 @@ import (
 	k8sExternalIntegration "github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
 	k8sInternal "github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/service-catalog"
 	k8sLogic "github.com/dash-ops/dash-ops/pkg/kubernetes/logic"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/repositories"
 	scPorts "github.com/dash-ops/dash-ops/pkg/service-catalog/ports"
 )
 
 // Module represents the Kubernetes module with all its components
 type Module struct {
 	// Core components
-	Handler *handlers.HTTPHandler
+	Handler  *handlers.HTTPHandler
+	Registry *k8sExternalIntegration.ClientRegistry
 
 	// Logic components
-	HealthCalculator *k8sLogic.HealthCalculator
+	HealthCalculator  *k8sLogic.HealthCalculator
+	PermissionChecker *k8sLogic.PermissionChecker
 
 	// Adapters
 	ResponseAdapter *commonsHttp.ResponseAdapter
@@ -51,61 +55,45 @@ func NewModule(fileConfig []byte) (*Module, error) {
 	// Initialize logic components
 	healthCalculator := k8sLogic.NewHealthCalculator()
 
-	// Initialize external integrations
-	var kubernetesAdapter k8sPorts.KubernetesClientService
-	if len(moduleConfig.Configs) > 0 {
-		// Use the first config for now (in a multi-cluster setup, this would be different)
-		config := &k8sExternalIntegration.KubernetesConfig{
-			Kubeconfig: moduleConfig.Configs[0].Kubeconfig,
-			Context:    moduleConfig.Configs[0].Context,
-		}
-		adapter, err := k8sExternalIntegration.NewKubernetesAdapter(config)
+	// Initialize external integrations: one client per configured cluster
+	registry := k8sExternalIntegration.NewClientRegistry()
+	permissions := make(map[string]k8sModels.Permission)
+	for _, clusterConfig := range moduleConfig.Configs {
+		externalConfig, err := newExternalConfig(&clusterConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create kubernetes adapter: %w", err)
+			return nil, fmt.Errorf("invalid auth configuration for cluster %s: %w", clusterConfig.Name, err)
+		}
+		if clusterConfig.Cache.Enabled {
+			resyncPeriod, err := clusterConfig.Cache.GetResyncPeriod()
+			if err != nil {
+				return nil, fmt.Errorf("invalid cache configuration for cluster %s: %w", clusterConfig.Name, err)
+			}
+			externalConfig.CacheResyncPeriod = resyncPeriod
+		}
+		if err := registry.Register(externalConfig); err != nil {
+			return nil, fmt.Errorf("failed to register cluster %s: %w", clusterConfig.Name, err)
 		}
-		kubernetesAdapter = adapter
+		permissions[k8sExternalIntegration.ContextKey(externalConfig)] = clusterConfig.Permission
 	}
+	permissionChecker := k8sLogic.NewPermissionChecker(permissions)
 
 	// Initialize adapters
 	responseAdapter := commonsHttp.NewResponseAdapter()
 	requestAdapter := commonsHttp.NewRequestAdapter()
 
-	// Use wrapper functions to create repositories required for service-catalog integration
-	var clusterRepo k8sPorts.ClusterRepository
-	var deploymentRepo k8sPorts.DeploymentRepository
+	// Repositories required for service-catalog integration
+	clusterRepo := repositories.NewClustersRepository(registry)
+	deploymentRepo := repositories.NewDeploymentsRepository(registry)
 
-	if kubernetesAdapter != nil {
-		// Cast to concrete type to access wrapper functions
-		if adapter, ok := kubernetesAdapter.(*k8sExternalIntegration.KubernetesAdapter); ok {
-			clusterRepo = k8sExternalIntegration.NewClusterRepository(adapter)
-			deploymentRepo = k8sExternalIntegration.NewDeploymentRepository(adapter)
-		}
-	}
-
-	// Initialize handler with the kubernetes client
-	var handler *handlers.HTTPHandler
-	if kubernetesAdapter != nil {
-		// Cast to concrete type to access the client
-		if _, ok := kubernetesAdapter.(*k8sExternalIntegration.KubernetesAdapter); ok {
-			// Create a client from the module config for DI
-			config := &k8sExternalIntegration.KubernetesConfig{
-				Kubeconfig: moduleConfig.Configs[0].Kubeconfig,
-				Context:    moduleConfig.Configs[0].Context,
-			}
-			client, err := k8sExternalIntegration.NewKubernetesClient(config)
-			if err == nil {
-				handler = handlers.NewHTTPHandler(
-					client,
-					responseAdapter,
-					requestAdapter,
-				)
-			}
-		}
-	}
+	// Initialize handler with the cluster client registry
+	handler := handlers.NewHTTPHandler(registry, healthCalculator, permissionChecker, responseAdapter, requestAdapter)
+	handler.SetAllowedOrigin(moduleConfig.Origin)
 
 	return &Module{
 		Handler:                handler,
+		Registry:               registry,
 		HealthCalculator:       healthCalculator,
+		PermissionChecker:      permissionChecker,
 		ResponseAdapter:        responseAdapter,
 		RequestAdapter:         requestAdapter,
 		ClusterRepo:            clusterRepo,
@@ -114,6 +102,53 @@ func NewModule(fileConfig []byte) (*Module, error) {
 	}, nil
 }
 
+// newExternalConfig validates the auth settings of a cluster and converts them to a client config
+func newExternalConfig(clusterConfig *k8sModels.KubernetesConfig) (*k8sExternalIntegration.KubernetesConfig, error) {
+	if err := clusterConfig.ValidateAuth(); err != nil {
+		return nil, err
+	}
+
+	caData, err := clusterConfig.Auth.CABundle()
+	if err != nil {
+		return nil, err
+	}
+
+	externalConfig := &k8sExternalIntegration.KubernetesConfig{
+		Name:                  clusterConfig.Name,
+		Kubeconfig:            clusterConfig.Kubeconfig,
+		Context:               clusterConfig.Context,
+		CacheEnabled:          clusterConfig.Cache.Enabled,
+		AuthMode:              k8sExternalIntegration.AuthMode(clusterConfig.GetAuthMode()),
+		Server:                clusterConfig.Auth.Server,
+		BearerToken:           clusterConfig.Auth.Token,
+		BearerTokenFile:       clusterConfig.Auth.TokenFile,
+		CAFile:                clusterConfig.Auth.CAFile,
+		InsecureSkipTLSVerify: clusterConfig.Auth.InsecureSkipTLSVerify,
+	}
+	if len(caData) > 0 {
+		externalConfig.CAData = caData
+	}
+	if impersonation := clusterConfig.Auth.Impersonation; impersonation.Enabled {
+		externalConfig.Impersonation = &k8sExternalIntegration.ImpersonationConfig{
+			UserPrefix:  impersonation.UserPrefix,
+			GroupPrefix: impersonation.GroupPrefix,
+		}
+	}
+	if exec := clusterConfig.Auth.Exec; exec != nil {
+		apiVersion := exec.APIVersion
+		if apiVersion == "" {
+			apiVersion = k8sModels.DefaultExecAPIVersion
+		}
+		externalConfig.Exec = &k8sExternalIntegration.ExecCredentialConfig{
+			Command:    exec.Command,
+			Args:       exec.Args,
+			Env:        exec.Env,
+			APIVersion: apiVersion,
+		}
+	}
+	return externalConfig, nil
+}
+
 // RegisterRoutes registers HTTP routes for the Kubernetes module
 func (m *Module) RegisterRoutes(router *mux.Router) {
 	// Create kubernetes prefix subrouter (consistent with other modules)
@@ -121,6 +156,17 @@ func (m *Module) RegisterRoutes(router *mux.Router) {
 	m.Handler.RegisterRoutes(k8sRouter)
 }
 
+// Close stops background work of the module, such as running node drains and the cluster informer caches
+func (m *Module) Close() {
+	// Drains still use the cluster clients while they store their final state
+	if m.Handler != nil {
+		m.Handler.Close()
+	}
+	if m.Registry != nil {
+		m.Registry.Close()
+	}
+}
+
 // LoadDependencies loads dependencies between modules after all modules are initialized
 func (m *Module) LoadDependencies(modules map[string]interface{}) error {
 	// Load service-catalog dependency if available
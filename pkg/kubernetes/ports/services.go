@@ -132,6 +132,20 @@ type EventObject struct {
 	UID       string `json:"uid"`
 }
 
+// DeploymentObjects represents a deployment with the ReplicaSets and Pods matching its selector.
+// Which of them the deployment actually controls is decided by their controller UIDs.
+type DeploymentObjects struct {
+	Deployment  EventObject
+	ReplicaSets []OwnedObject
+	Pods        []OwnedObject
+}
+
+// OwnedObject represents an object with the UID of the controller in its ownerReferences
+type OwnedObject struct {
+	Object        EventObject
+	ControllerUID string
+}
+
 // EventSource represents the source of the event
 type EventSource struct {
 	Component string `json:"component"`
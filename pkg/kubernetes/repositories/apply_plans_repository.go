@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"sync"
+	"time"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// maxPendingApplyPlans bounds the previews waiting for confirmation
+const maxPendingApplyPlans = 100
+
+// ApplyPlansRepository keeps previewed apply plans in memory until they are confirmed or expire
+type ApplyPlansRepository struct {
+	mu    sync.Mutex
+	plans map[string]k8sModels.ApplyPlan
+	order []string
+}
+
+// NewApplyPlansRepository creates a new apply plans repository
+func NewApplyPlansRepository() *ApplyPlansRepository {
+	return &ApplyPlansRepository{
+		plans: make(map[string]k8sModels.ApplyPlan),
+	}
+}
+
+// SavePlan stores a plan, dropping expired plans and the oldest plan when full
+func (r *ApplyPlansRepository) SavePlan(plan *k8sModels.ApplyPlan) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	order := r.order[:0]
+	for _, token := range r.order {
+		existing, exists := r.plans[token]
+		if !exists {
+			continue
+		}
+		if existing.IsExpired(now) {
+			delete(r.plans, token)
+			continue
+		}
+		order = append(order, token)
+	}
+	r.order = order
+
+	if len(r.order) >= maxPendingApplyPlans {
+		delete(r.plans, r.order[0])
+		r.order = r.order[1:]
+	}
+	r.order = append(r.order, plan.Token)
+	r.plans[plan.Token] = *plan
+}
+
+// TakePlan removes and returns the plan of a cluster, so a token can only be confirmed once
+func (r *ApplyPlansRepository) TakePlan(context, token string) (*k8sModels.ApplyPlan, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	plan, exists := r.plans[token]
+	if !exists || plan.Context != context {
+		return nil, false
+	}
+	delete(r.plans, token)
+	return &plan, true
+}
@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+)
+
+// ApplyRepository handles server-side apply of arbitrary namespaced objects
+type ApplyRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewApplyRepository creates a new apply repository
+func NewApplyRepository(registry *kubernetes.ClientRegistry) *ApplyRepository {
+	return &ApplyRepository{
+		registry: registry,
+	}
+}
+
+// IsNamespaced reports whether a kind is namespaced in a cluster
+func (r *ApplyRepository) IsNamespaced(context, apiVersion, kind string) (bool, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return false, err
+	}
+
+	_, namespaced, err := client.ResolveResource(apiVersion, kind)
+	return namespaced, err
+}
+
+// GetLive gets the current state of the object an apply configuration targets.
+// Not found errors are returned as is so callers can tell a create from an update.
+func (r *ApplyRepository) GetLive(ctx context.Context, context string, object map[string]interface{}) (map[string]interface{}, error) {
+	client, target, resource, err := r.resolve(context, object)
+	if err != nil {
+		return nil, err
+	}
+
+	live, err := client.GetResourceLive(ctx, resource, target.GetNamespace(), target.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return live.Object, nil
+}
+
+// Apply server-side applies an apply configuration and returns the resulting object.
+// A dry run returns what the object would look like after admission and defaulting.
+func (r *ApplyRepository) Apply(ctx context.Context, context string, object map[string]interface{}, dryRun bool) (map[string]interface{}, error) {
+	client, target, resource, err := r.resolve(context, object)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.ApplyResource(ctx, resource, target.GetNamespace(), target, dryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply %s %s/%s: %w", target.GetKind(), target.GetNamespace(), target.GetName(), err)
+	}
+	return result.Object, nil
+}
+
+// resolve finds the client and API resource of an apply configuration; only namespaced kinds are supported
+func (r *ApplyRepository) resolve(context string, object map[string]interface{}) (*kubernetes.KubernetesClient, *unstructured.Unstructured, schema.GroupVersionResource, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, nil, schema.GroupVersionResource{}, err
+	}
+
+	target := &unstructured.Unstructured{Object: object}
+	resource, namespaced, err := client.ResolveResource(target.GetAPIVersion(), target.GetKind())
+	if err != nil {
+		return nil, nil, schema.GroupVersionResource{}, err
+	}
+	if !namespaced {
+		return nil, nil, schema.GroupVersionResource{}, fmt.Errorf("%s is cluster scoped and cannot be applied to a namespace", target.GetKind())
+	}
+
+	return client, target, resource, nil
+}
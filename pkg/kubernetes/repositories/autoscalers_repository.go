@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// AutoscalersRepository handles horizontal pod autoscaler data access
+type AutoscalersRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewAutoscalersRepository creates a new autoscalers repository
+func NewAutoscalersRepository(registry *kubernetes.ClientRegistry) *AutoscalersRepository {
+	return &AutoscalersRepository{
+		registry: registry,
+	}
+}
+
+// FindForWorkload returns the autoscaler scaling a workload, or nil when there is none
+func (r *AutoscalersRepository) FindForWorkload(ctx context.Context, context, namespace, kind, name string) (*k8sModels.HorizontalPodAutoscaler, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	autoscalers, err := client.ListHorizontalPodAutoscalers(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list autoscalers in namespace %s: %w", namespace, err)
+	}
+
+	for _, autoscaler := range autoscalers.Items {
+		converted := r.convertAutoscaler(&autoscaler)
+		if converted.Targets(kind, name) {
+			return converted, nil
+		}
+	}
+	return nil, nil
+}
+
+// SetReplicaBounds updates the min and max replicas of an autoscaler
+func (r *AutoscalersRepository) SetReplicaBounds(ctx context.Context, context, namespace, name string, minReplicas, maxReplicas int32) (*k8sModels.HorizontalPodAutoscaler, error) {
+	if name == "" {
+		return nil, fmt.Errorf("autoscaler name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	autoscaler, err := client.SetHorizontalPodAutoscalerBounds(ctx, namespace, name, minReplicas, maxReplicas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update autoscaler %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertAutoscaler(autoscaler), nil
+}
+
+// convertAutoscaler converts a Kubernetes horizontal pod autoscaler to our domain model
+func (r *AutoscalersRepository) convertAutoscaler(autoscaler *autoscalingv2.HorizontalPodAutoscaler) *k8sModels.HorizontalPodAutoscaler {
+	minReplicas := int32(1) // API default when unset
+	if autoscaler.Spec.MinReplicas != nil {
+		minReplicas = *autoscaler.Spec.MinReplicas
+	}
+
+	metrics := make([]k8sModels.AutoscalerMetric, 0, len(autoscaler.Spec.Metrics))
+	for i, spec := range autoscaler.Spec.Metrics {
+		metric := convertMetricSpec(spec)
+		// Status metrics are reported in the same order as the spec once the autoscaler has read them
+		if i < len(autoscaler.Status.CurrentMetrics) {
+			metric.Current = formatMetricStatus(autoscaler.Status.CurrentMetrics[i])
+		}
+		metrics = append(metrics, metric)
+	}
+
+	var conditions []k8sModels.AutoscalerCondition
+	for _, condition := range autoscaler.Status.Conditions {
+		conditions = append(conditions, k8sModels.AutoscalerCondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	var lastScaleTime *time.Time
+	if autoscaler.Status.LastScaleTime != nil {
+		scaledAt := autoscaler.Status.LastScaleTime.Time
+		lastScaleTime = &scaledAt
+	}
+
+	return &k8sModels.HorizontalPodAutoscaler{
+		Name:            autoscaler.Name,
+		Namespace:       autoscaler.Namespace,
+		TargetKind:      autoscaler.Spec.ScaleTargetRef.Kind,
+		TargetName:      autoscaler.Spec.ScaleTargetRef.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     autoscaler.Spec.MaxReplicas,
+		CurrentReplicas: autoscaler.Status.CurrentReplicas,
+		DesiredReplicas: autoscaler.Status.DesiredReplicas,
+		Metrics:         metrics,
+		Conditions:      conditions,
+		LastScaleTime:   lastScaleTime,
+		CreatedAt:       autoscaler.CreationTimestamp.Time,
+	}
+}
+
+// convertMetricSpec converts an autoscaler metric spec to a metric with its target
+func convertMetricSpec(spec autoscalingv2.MetricSpec) k8sModels.AutoscalerMetric {
+	metric := k8sModels.AutoscalerMetric{Type: string(spec.Type)}
+
+	switch spec.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if spec.Resource != nil {
+			metric.Name = string(spec.Resource.Name)
+			metric.Target = formatMetricTarget(spec.Resource.Target)
+		}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if spec.ContainerResource != nil {
+			metric.Name = spec.ContainerResource.Container + "/" + string(spec.ContainerResource.Name)
+			metric.Target = formatMetricTarget(spec.ContainerResource.Target)
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if spec.Pods != nil {
+			metric.Name = spec.Pods.Metric.Name
+			metric.Target = formatMetricTarget(spec.Pods.Target)
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if spec.Object != nil {
+			metric.Name = spec.Object.Metric.Name
+			metric.Target = formatMetricTarget(spec.Object.Target)
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if spec.External != nil {
+			metric.Name = spec.External.Metric.Name
+			metric.Target = formatMetricTarget(spec.External.Target)
+		}
+	}
+
+	return metric
+}
+
+// formatMetricTarget formats a metric target the way kubectl describe hpa does
+func formatMetricTarget(target autoscalingv2.MetricTarget) string {
+	switch {
+	case target.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *target.AverageUtilization)
+	case target.AverageValue != nil:
+		return target.AverageValue.String()
+	case target.Value != nil:
+		return target.Value.String()
+	default:
+		return ""
+	}
+}
+
+// formatMetricStatus formats the current value of an autoscaler metric
+func formatMetricStatus(status autoscalingv2.MetricStatus) string {
+	var current autoscalingv2.MetricValueStatus
+	switch {
+	case status.Resource != nil:
+		current = status.Resource.Current
+	case status.ContainerResource != nil:
+		current = status.ContainerResource.Current
+	case status.Pods != nil:
+		current = status.Pods.Current
+	case status.Object != nil:
+		current = status.Object.Current
+	case status.External != nil:
+		current = status.External.Current
+	default:
+		return ""
+	}
+
+	switch {
+	case current.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *current.AverageUtilization)
+	case current.AverageValue != nil:
+		return current.AverageValue.String()
+	case current.Value != nil:
+		return current.Value.String()
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,156 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// clusterCheckTimeout bounds the connectivity check of a single cluster
+const clusterCheckTimeout = 5 * time.Second
+
+// ClustersRepository handles cluster-related data access
+type ClustersRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewClustersRepository creates a new clusters repository
+func NewClustersRepository(registry *kubernetes.ClientRegistry) *ClustersRepository {
+	return &ClustersRepository{
+		registry: registry,
+	}
+}
+
+// GetCluster gets a specific cluster with its connectivity status
+func (r *ClustersRepository) GetCluster(ctx context.Context, context string) (*k8sModels.Cluster, error) {
+	entry, err := r.registry.GetEntry(context)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := r.checkCluster(ctx, *entry)
+	return &cluster, nil
+}
+
+// ListClusters lists all configured clusters, checking each one concurrently
+func (r *ClustersRepository) ListClusters(ctx context.Context) ([]k8sModels.Cluster, error) {
+	entries := r.registry.ListEntries()
+	clusters := make([]k8sModels.Cluster, len(entries))
+
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry kubernetes.ClusterEntry) {
+			defer wg.Done()
+			clusters[i] = r.checkCluster(ctx, entry)
+		}(i, entry)
+	}
+	wg.Wait()
+
+	return clusters, nil
+}
+
+// ListConfiguredClusters lists the configured clusters without checking their connectivity
+func (r *ClustersRepository) ListConfiguredClusters() []k8sModels.Cluster {
+	entries := r.registry.ListEntries()
+	clusters := make([]k8sModels.Cluster, 0, len(entries))
+	for _, entry := range entries {
+		clusters = append(clusters, k8sModels.Cluster{
+			Name:    entry.Name,
+			Context: entry.Context,
+		})
+	}
+	return clusters
+}
+
+// ValidateCluster validates cluster connectivity
+func (r *ClustersRepository) ValidateCluster(ctx context.Context, context string) error {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	if err := client.TestConnection(ctx); err != nil {
+		return fmt.Errorf("failed to connect to cluster %s: %w", context, err)
+	}
+
+	return nil
+}
+
+// GetClusterInfo gets comprehensive cluster information
+func (r *ClustersRepository) GetClusterInfo(ctx context.Context, context string) (*k8sModels.ClusterInfo, error) {
+	cluster, err := r.GetCluster(ctx, context)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterInfo := &k8sModels.ClusterInfo{
+		Cluster:     *cluster,
+		LastUpdated: time.Now(),
+	}
+	if !cluster.IsConnected() {
+		return clusterInfo, nil
+	}
+
+	nodes, err := NewNodesRepository(r.registry).ListNodes(ctx, context)
+	if err != nil {
+		return nil, err
+	}
+	clusterInfo.Nodes = nodes
+
+	namespaces, err := NewNamespacesRepository(r.registry).ListNamespaces(ctx, context)
+	if err != nil {
+		return nil, err
+	}
+	clusterInfo.Namespaces = namespaces
+
+	return clusterInfo, nil
+}
+
+// checkCluster builds a cluster model with the result of a connectivity check
+func (r *ClustersRepository) checkCluster(ctx context.Context, entry kubernetes.ClusterEntry) k8sModels.Cluster {
+	cluster := k8sModels.Cluster{
+		Name:          entry.Name,
+		Context:       entry.Context,
+		Status:        k8sModels.ClusterStatusUnknown,
+		AuthMode:      k8sModels.AuthMode(entry.AuthMode),
+		Impersonation: entry.Impersonation,
+	}
+
+	if entry.Client == nil {
+		cluster.Status = k8sModels.ClusterStatusError
+		if entry.Err != nil {
+			cluster.Error = entry.Err.Error()
+		}
+		return cluster
+	}
+	cluster.Server = entry.Client.GetServer()
+	if cacheStatus := entry.Client.CacheStatus(); cacheStatus.Enabled {
+		cluster.Cache = &k8sModels.ClusterCache{
+			Synced:   cacheStatus.Synced,
+			SyncedAt: cacheStatus.SyncedAt,
+		}
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, clusterCheckTimeout)
+	defer cancel()
+
+	version, err := entry.Client.GetServerVersion(checkCtx)
+	if err != nil {
+		cluster.Status = k8sModels.ClusterStatusDisconnected
+		return cluster
+	}
+	cluster.Version = version
+
+	if err := entry.Client.TestConnection(checkCtx); err != nil {
+		cluster.Status = k8sModels.ClusterStatusError
+		return cluster
+	}
+	cluster.Status = k8sModels.ClusterStatusConnected
+
+	return cluster
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// ConfigMapsRepository handles config map data access
+type ConfigMapsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewConfigMapsRepository creates a new config maps repository
+func NewConfigMapsRepository(registry *kubernetes.ClientRegistry) *ConfigMapsRepository {
+	return &ConfigMapsRepository{
+		registry: registry,
+	}
+}
+
+// GetConfigMap gets a specific config map; values are only included when withValues is set
+func (r *ConfigMapsRepository) GetConfigMap(ctx context.Context, context, namespace, name string, withValues bool) (*k8sModels.ConfigMap, error) {
+	if name == "" {
+		return nil, fmt.Errorf("config map name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap, err := client.GetConfigMap(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config map %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertConfigMap(configMap, withValues), nil
+}
+
+// ListConfigMaps lists config maps without values, across all namespaces when the filter has none
+func (r *ConfigMapsRepository) ListConfigMaps(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.ConfigMap, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	configMapList, err := client.ListConfigMaps(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list config maps: %w", err)
+	}
+
+	configMaps := make([]k8sModels.ConfigMap, 0, len(configMapList.Items))
+	for i := range configMapList.Items {
+		configMaps = append(configMaps, *r.convertConfigMap(&configMapList.Items[i], false))
+	}
+
+	return configMaps, nil
+}
+
+// convertConfigMap converts a Kubernetes config map to our domain model
+func (r *ConfigMapsRepository) convertConfigMap(configMap *corev1.ConfigMap, withValues bool) *k8sModels.ConfigMap {
+	result := &k8sModels.ConfigMap{
+		Name:      configMap.Name,
+		Namespace: configMap.Namespace,
+		Keys:      make([]string, 0, len(configMap.Data)),
+		Immutable: configMap.Immutable != nil && *configMap.Immutable,
+		Age:       time.Since(configMap.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt: configMap.CreationTimestamp.Time,
+	}
+
+	for key := range configMap.Data {
+		result.Keys = append(result.Keys, key)
+	}
+	sort.Strings(result.Keys)
+
+	for key := range configMap.BinaryData {
+		result.BinaryKeys = append(result.BinaryKeys, key)
+	}
+	sort.Strings(result.BinaryKeys)
+
+	if withValues {
+		result.Data = configMap.Data
+	}
+
+	return result
+}
@@ -0,0 +1,208 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// maxJobNameLength keeps generated job names valid as the job-name label value
+const maxJobNameLength = 63
+
+// CronJobsRepository handles cron job data access
+type CronJobsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewCronJobsRepository creates a new cron jobs repository
+func NewCronJobsRepository(registry *kubernetes.ClientRegistry) *CronJobsRepository {
+	return &CronJobsRepository{
+		registry: registry,
+	}
+}
+
+// GetCronJob gets a specific cron job including the outcome of its last finished job
+func (r *CronJobsRepository) GetCronJob(ctx context.Context, context, namespace, name string) (*k8sModels.CronJob, error) {
+	if name == "" {
+		return nil, fmt.Errorf("cron job name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	cronJob, err := client.GetCronJob(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron job %s/%s: %w", namespace, name, err)
+	}
+
+	jobList, err := client.ListJobs(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs in namespace %s: %w", namespace, err)
+	}
+
+	return r.convertCronJob(cronJob, jobList.Items), nil
+}
+
+// ListCronJobs lists cron jobs, across all namespaces when the filter has none
+func (r *CronJobsRepository) ListCronJobs(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.CronJob, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	cronJobList, err := client.ListCronJobs(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cron jobs: %w", err)
+	}
+
+	// One job listing covers every cron job in scope
+	jobList, err := client.ListJobs(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	cronJobs := make([]k8sModels.CronJob, 0, len(cronJobList.Items))
+	for i := range cronJobList.Items {
+		cronJobs = append(cronJobs, *r.convertCronJob(&cronJobList.Items[i], jobList.Items))
+	}
+
+	return cronJobs, nil
+}
+
+// SetSuspend suspends or resumes a cron job
+func (r *CronJobsRepository) SetSuspend(ctx context.Context, context, namespace, name string, suspend bool) error {
+	if name == "" {
+		return fmt.Errorf("cron job name is required")
+	}
+	if namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	if err := client.SetCronJobSuspend(ctx, namespace, name, suspend); err != nil {
+		return fmt.Errorf("failed to update cron job %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// TriggerCronJob creates a job from the cron job template, like kubectl create job --from=cronjob
+func (r *CronJobsRepository) TriggerCronJob(ctx context.Context, context, namespace, name string) (*k8sModels.Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("cron job name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	cronJob, err := client.GetCronJob(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron job %s/%s: %w", namespace, name, err)
+	}
+
+	annotations := make(map[string]string, len(cronJob.Spec.JobTemplate.Annotations)+1)
+	for key, value := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[key] = value
+	}
+	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            manualJobName(name, time.Now()),
+			Namespace:       namespace,
+			Labels:          cronJob.Spec.JobTemplate.Labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+		Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+
+	created, err := client.CreateJob(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job from cron job %s/%s: %w", namespace, name, err)
+	}
+
+	return convertJob(created), nil
+}
+
+// convertCronJob converts a Kubernetes cron job to our domain model
+func (r *CronJobsRepository) convertCronJob(cronJob *batchv1.CronJob, jobs []batchv1.Job) *k8sModels.CronJob {
+	result := &k8sModels.CronJob{
+		Name:              cronJob.Name,
+		Namespace:         cronJob.Namespace,
+		Schedule:          cronJob.Spec.Schedule,
+		Suspended:         cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+		ConcurrencyPolicy: string(cronJob.Spec.ConcurrencyPolicy),
+		ActiveJobs:        make([]string, 0, len(cronJob.Status.Active)),
+		Images:            podTemplateImages(&cronJob.Spec.JobTemplate.Spec.Template),
+		Age:               time.Since(cronJob.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt:         cronJob.CreationTimestamp.Time,
+	}
+
+	if cronJob.Spec.TimeZone != nil {
+		result.TimeZone = *cronJob.Spec.TimeZone
+	}
+	for _, active := range cronJob.Status.Active {
+		result.ActiveJobs = append(result.ActiveJobs, active.Name)
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		lastSchedule := cronJob.Status.LastScheduleTime.Time
+		result.LastScheduleTime = &lastSchedule
+	}
+	if cronJob.Status.LastSuccessfulTime != nil {
+		lastSuccessful := cronJob.Status.LastSuccessfulTime.Time
+		result.LastSuccessfulTime = &lastSuccessful
+	}
+
+	// The most recently created finished job decides the last outcome
+	var lastFinished *batchv1.Job
+	for i := range jobs {
+		job := &jobs[i]
+		owner := metav1.GetControllerOf(job)
+		if owner == nil || owner.UID != cronJob.UID {
+			continue
+		}
+		status := jobStatus(job)
+		if status != k8sModels.JobStatusComplete && status != k8sModels.JobStatusFailed {
+			continue
+		}
+		if lastFinished == nil || job.CreationTimestamp.After(lastFinished.CreationTimestamp.Time) {
+			lastFinished = job
+		}
+	}
+	if lastFinished != nil {
+		result.LastJobStatus = jobStatus(lastFinished)
+	}
+
+	return result
+}
+
+// manualJobName builds a unique, length-limited name for a manually triggered job
+func manualJobName(cronJobName string, now time.Time) string {
+	suffix := fmt.Sprintf("-manual-%d", now.Unix())
+	if len(cronJobName)+len(suffix) > maxJobNameLength {
+		cronJobName = cronJobName[:maxJobNameLength-len(suffix)]
+	}
+	return cronJobName + suffix
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// DaemonSetsRepository handles daemon set data access
+type DaemonSetsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewDaemonSetsRepository creates a new daemon sets repository
+func NewDaemonSetsRepository(registry *kubernetes.ClientRegistry) *DaemonSetsRepository {
+	return &DaemonSetsRepository{
+		registry: registry,
+	}
+}
+
+// GetDaemonSet gets a specific daemon set
+func (r *DaemonSetsRepository) GetDaemonSet(ctx context.Context, context, namespace, name string) (*k8sModels.DaemonSet, error) {
+	if name == "" {
+		return nil, fmt.Errorf("daemon set name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	daemonSet, err := client.GetDaemonSet(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get daemon set %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertDaemonSet(daemonSet), nil
+}
+
+// ListDaemonSets lists daemon sets, across all namespaces when the filter has none
+func (r *DaemonSetsRepository) ListDaemonSets(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.DaemonSet, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	daemonSetList, err := client.ListDaemonSets(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daemon sets: %w", err)
+	}
+
+	daemonSets := make([]k8sModels.DaemonSet, 0, len(daemonSetList.Items))
+	for i := range daemonSetList.Items {
+		daemonSets = append(daemonSets, *r.convertDaemonSet(&daemonSetList.Items[i]))
+	}
+
+	return daemonSets, nil
+}
+
+// RestartDaemonSet restarts a daemon set
+func (r *DaemonSetsRepository) RestartDaemonSet(ctx context.Context, context, namespace, name string) error {
+	if name == "" {
+		return fmt.Errorf("daemon set name is required")
+	}
+	if namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	if err := client.RestartDaemonSet(ctx, namespace, name); err != nil {
+		return fmt.Errorf("failed to restart daemon set %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// convertDaemonSet converts a Kubernetes daemon set to our domain model
+func (r *DaemonSetsRepository) convertDaemonSet(daemonSet *appsv1.DaemonSet) *k8sModels.DaemonSet {
+	return &k8sModels.DaemonSet{
+		Name:      daemonSet.Name,
+		Namespace: daemonSet.Namespace,
+		Scheduling: k8sModels.DaemonSetScheduling{
+			Desired:      daemonSet.Status.DesiredNumberScheduled,
+			Current:      daemonSet.Status.CurrentNumberScheduled,
+			Ready:        daemonSet.Status.NumberReady,
+			Available:    daemonSet.Status.NumberAvailable,
+			Updated:      daemonSet.Status.UpdatedNumberScheduled,
+			Misscheduled: daemonSet.Status.NumberMisscheduled,
+		},
+		NodeSelector:   daemonSet.Spec.Template.Spec.NodeSelector,
+		UpdateStrategy: string(daemonSet.Spec.UpdateStrategy.Type),
+		Images:         podTemplateImages(&daemonSet.Spec.Template),
+		Age:            time.Since(daemonSet.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt:      daemonSet.CreationTimestamp.Time,
+	}
+}
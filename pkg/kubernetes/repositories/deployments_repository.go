@@ -3,24 +3,34 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+const (
+	// revisionAnnotation is set by the deployment controller on deployments and their ReplicaSets
+	revisionAnnotation = "deployment.kubernetes.io/revision"
+	// changeCauseAnnotation records why a rollout happened
+	changeCauseAnnotation = "kubernetes.io/change-cause"
 )
 
 // DeploymentsRepository handles deployment-related data access
 type DeploymentsRepository struct {
-	client *kubernetes.KubernetesClient
+	registry *kubernetes.ClientRegistry
 }
 
 // NewDeploymentsRepository creates a new deployments repository
-func NewDeploymentsRepository(client *kubernetes.KubernetesClient) *DeploymentsRepository {
+func NewDeploymentsRepository(registry *kubernetes.ClientRegistry) *DeploymentsRepository {
 	return &DeploymentsRepository{
-		client: client,
+		registry: registry,
 	}
 }
 
@@ -33,7 +43,12 @@ func (r *DeploymentsRepository) GetDeployment(ctx context.Context, context, name
 		return nil, fmt.Errorf("namespace is required")
 	}
 
-	deployment, err := r.client.GetDeployment(ctx, namespace, deploymentName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := client.GetDeployment(ctx, namespace, deploymentName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, deploymentName, err)
 	}
@@ -43,6 +58,11 @@ func (r *DeploymentsRepository) GetDeployment(ctx context.Context, context, name
 
 // ListDeployments lists deployments with optional filtering
 func (r *DeploymentsRepository) ListDeployments(ctx context.Context, context string, filter *k8sModels.DeploymentFilter) (*k8sModels.DeploymentList, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build list options based on filter
 	listOptions := metav1.ListOptions{}
 	if filter != nil && filter.LabelSelector != "" {
@@ -53,7 +73,7 @@ func (r *DeploymentsRepository) ListDeployments(ctx context.Context, context str
 
 	if filter != nil && filter.Namespace != "" {
 		// List deployments in specific namespace
-		deploymentList, err := r.client.ListDeployments(ctx, filter.Namespace, listOptions)
+		deploymentList, err := client.ListDeployments(ctx, filter.Namespace, listOptions)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list deployments in namespace %s: %w", filter.Namespace, err)
 		}
@@ -64,13 +84,13 @@ func (r *DeploymentsRepository) ListDeployments(ctx context.Context, context str
 	} else {
 		// For cross-namespace listing, we need to list all namespaces first
 		// and then get deployments from each namespace
-		namespaces, err := r.client.ListNamespaces(ctx, metav1.ListOptions{})
+		namespaces, err := client.ListNamespaces(ctx, metav1.ListOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list namespaces: %w", err)
 		}
 
 		for _, namespace := range namespaces.Items {
-			deploymentList, err := r.client.ListDeployments(ctx, namespace.Name, listOptions)
+			deploymentList, err := client.ListDeployments(ctx, namespace.Name, listOptions)
 			if err != nil {
 				// Log error but continue with other namespaces
 				continue
@@ -105,7 +125,12 @@ func (r *DeploymentsRepository) ScaleDeployment(ctx context.Context, context, na
 		return fmt.Errorf("replicas must be non-negative")
 	}
 
-	err := r.client.ScaleDeployment(ctx, namespace, deploymentName, replicas)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	err = client.ScaleDeployment(ctx, namespace, deploymentName, replicas)
 	if err != nil {
 		return fmt.Errorf("failed to scale deployment %s/%s to %d replicas: %w", namespace, deploymentName, replicas, err)
 	}
@@ -122,7 +147,12 @@ func (r *DeploymentsRepository) RestartDeployment(ctx context.Context, context,
 		return fmt.Errorf("namespace is required")
 	}
 
-	err := r.client.RestartDeployment(ctx, namespace, deploymentName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	err = client.RestartDeployment(ctx, namespace, deploymentName)
 	if err != nil {
 		return fmt.Errorf("failed to restart deployment %s/%s: %w", namespace, deploymentName, err)
 	}
@@ -130,6 +160,151 @@ func (r *DeploymentsRepository) RestartDeployment(ctx context.Context, context,
 	return nil
 }
 
+// GetRolloutHistory gets the ReplicaSet revisions owned by a deployment
+func (r *DeploymentsRepository) GetRolloutHistory(ctx context.Context, context, namespace, deploymentName string) ([]k8sModels.DeploymentRevision, error) {
+	if deploymentName == "" {
+		return nil, fmt.Errorf("deployment name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := client.GetDeployment(ctx, namespace, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+
+	replicaSets, err := r.listOwnedReplicaSets(ctx, client, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRevision := parseRevision(deployment.Annotations)
+	revisions := make([]k8sModels.DeploymentRevision, 0, len(replicaSets))
+	for _, replicaSet := range replicaSets {
+		revision := r.convertReplicaSetRevision(&replicaSet)
+		revision.Current = revision.Revision == currentRevision
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+// RollbackDeployment rolls a deployment back to the pod template of one of its ReplicaSets
+func (r *DeploymentsRepository) RollbackDeployment(ctx context.Context, context, namespace, deploymentName, replicaSetName string) error {
+	if deploymentName == "" {
+		return fmt.Errorf("deployment name is required")
+	}
+	if namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if replicaSetName == "" {
+		return fmt.Errorf("replica set name is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	deployment, err := client.GetDeploymentLive(ctx, namespace, deploymentName)
+	if err != nil {
+		return fmt.Errorf("failed to get deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+
+	replicaSet, err := client.GetReplicaSet(ctx, namespace, replicaSetName)
+	if err != nil {
+		return fmt.Errorf("failed to get replica set %s/%s: %w", namespace, replicaSetName, err)
+	}
+	if !isOwnedBy(replicaSet, deployment) {
+		return fmt.Errorf("replica set %s does not belong to deployment %s", replicaSetName, deploymentName)
+	}
+
+	// Same as kubectl rollout undo: restore the template without the controller-managed hash label
+	template := replicaSet.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	deployment.Spec.Template = *template
+
+	if changeCause, ok := replicaSet.Annotations[changeCauseAnnotation]; ok {
+		if deployment.Annotations == nil {
+			deployment.Annotations = make(map[string]string)
+		}
+		deployment.Annotations[changeCauseAnnotation] = changeCause
+	} else {
+		delete(deployment.Annotations, changeCauseAnnotation)
+	}
+
+	if _, err := client.UpdateDeployment(ctx, deployment); err != nil {
+		return fmt.Errorf("failed to roll back deployment %s/%s to replica set %s: %w", namespace, deploymentName, replicaSetName, err)
+	}
+
+	return nil
+}
+
+// listOwnedReplicaSets lists the ReplicaSets controlled by a deployment
+func (r *DeploymentsRepository) listOwnedReplicaSets(ctx context.Context, client *kubernetes.KubernetesClient, deployment *appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	replicaSetList, err := client.ListReplicaSets(ctx, deployment.Namespace, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replica sets for deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+
+	owned := make([]appsv1.ReplicaSet, 0, len(replicaSetList.Items))
+	for _, replicaSet := range replicaSetList.Items {
+		if isOwnedBy(&replicaSet, deployment) {
+			owned = append(owned, replicaSet)
+		}
+	}
+	return owned, nil
+}
+
+// convertReplicaSetRevision converts a ReplicaSet to a rollout history entry
+func (r *DeploymentsRepository) convertReplicaSetRevision(replicaSet *appsv1.ReplicaSet) k8sModels.DeploymentRevision {
+	return k8sModels.DeploymentRevision{
+		Revision:    parseRevision(replicaSet.Annotations),
+		ReplicaSet:  replicaSet.Name,
+		ChangeCause: replicaSet.Annotations[changeCauseAnnotation],
+		Images:      podTemplateImages(&replicaSet.Spec.Template),
+		Replicas:    replicaSet.Status.Replicas,
+		Ready:       replicaSet.Status.ReadyReplicas,
+		CreatedAt:   replicaSet.CreationTimestamp.Time,
+	}
+}
+
+// GetDeploymentStatus gets deployment status and health
+func (r *DeploymentsRepository) GetDeploymentStatus(ctx context.Context, context, namespace, deploymentName string) (*k8sPorts.DeploymentStatus, error) {
+	deployment, err := r.GetDeployment(ctx, context, namespace, deploymentName)
+	if err != nil {
+		return nil, err
+	}
+
+	healthStatus := "healthy"
+	if deployment.Replicas.Ready < deployment.Replicas.Desired {
+		healthStatus = "degraded"
+	}
+	if deployment.Replicas.Ready == 0 {
+		healthStatus = "unhealthy"
+	}
+
+	return &k8sPorts.DeploymentStatus{
+		Name:         deployment.Name,
+		Namespace:    deployment.Namespace,
+		Replicas:     deployment.Replicas,
+		Conditions:   deployment.Conditions,
+		HealthStatus: healthStatus,
+		LastUpdated:  time.Now(),
+	}, nil
+}
+
 // filterDeployments applies additional filters to the deployment list
 func (r *DeploymentsRepository) filterDeployments(deployments []k8sModels.Deployment, filter *k8sModels.DeploymentFilter) []k8sModels.Deployment {
 	var filtered []k8sModels.Deployment
@@ -188,6 +363,7 @@ func (r *DeploymentsRepository) convertDeployment(deployment *appsv1.Deployment)
 		Current:   deployment.Status.Replicas,
 		Ready:     deployment.Status.ReadyReplicas,
 		Available: deployment.Status.AvailableReplicas,
+		Updated:   deployment.Status.UpdatedReplicas,
 	}
 
 	// Convert conditions
@@ -211,5 +387,44 @@ func (r *DeploymentsRepository) convertDeployment(deployment *appsv1.Deployment)
 		CreatedAt:  deployment.CreationTimestamp.Time,
 		Conditions: conditions,
 		// ServiceContext will be populated by the controller if service-catalog integration is available
+		Labels:             deployment.Labels,
+		PodLabels:          deployment.Spec.Template.Labels,
+		Images:             containerImages(deployment.Spec.Template.Spec.Containers),
+		Revision:           parseRevision(deployment.Annotations),
+		Generation:         deployment.Generation,
+		ObservedGeneration: deployment.Status.ObservedGeneration,
+	}
+}
+
+// containerImages returns the images of a pod template's containers in declaration order
+func containerImages(containers []corev1.Container) []string {
+	images := make([]string, 0, len(containers))
+	for _, container := range containers {
+		images = append(images, container.Image)
+	}
+	return images
+}
+
+// isOwnedBy checks if a ReplicaSet is controlled by the given deployment
+func isOwnedBy(replicaSet *appsv1.ReplicaSet, deployment *appsv1.Deployment) bool {
+	owner := metav1.GetControllerOf(replicaSet)
+	return owner != nil && owner.UID == deployment.UID
+}
+
+// parseRevision reads the deployment controller revision annotation, returning 0 when absent
+func parseRevision(annotations map[string]string) int64 {
+	revision, err := strconv.ParseInt(annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// podTemplateImages returns the container images of a pod template
+func podTemplateImages(template *corev1.PodTemplateSpec) []string {
+	images := make([]string, 0, len(template.Spec.Containers))
+	for _, container := range template.Spec.Containers {
+		images = append(images, container.Image)
 	}
+	return images
 }
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"sync"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// maxTrackedDrainOperations bounds the drain history kept in memory
+const maxTrackedDrainOperations = 100
+
+// DrainOperationsRepository keeps node drain operations in memory so their progress can be polled
+type DrainOperationsRepository struct {
+	mu         sync.RWMutex
+	operations map[string]k8sModels.DrainOperation
+	order      []string
+}
+
+// NewDrainOperationsRepository creates a new drain operations repository
+func NewDrainOperationsRepository() *DrainOperationsRepository {
+	return &DrainOperationsRepository{
+		operations: make(map[string]k8sModels.DrainOperation),
+	}
+}
+
+// SaveOperation stores a snapshot of a tracked drain operation
+func (r *DrainOperationsRepository) SaveOperation(operation *k8sModels.DrainOperation) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.operations[operation.ID]; exists {
+		r.operations[operation.ID] = operation.Copy()
+	}
+}
+
+// GetOperation gets a drain operation of a cluster by ID
+func (r *DrainOperationsRepository) GetOperation(context, id string) (*k8sModels.DrainOperation, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	operation, exists := r.operations[id]
+	if !exists || operation.Context != context {
+		return nil, false
+	}
+	copied := operation.Copy()
+	return &copied, true
+}
+
+// ListOperations lists drain operations of a node, newest first
+func (r *DrainOperationsRepository) ListOperations(context, nodeName string) []k8sModels.DrainOperation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	operations := make([]k8sModels.DrainOperation, 0)
+	for i := len(r.order) - 1; i >= 0; i-- {
+		operation := r.operations[r.order[i]]
+		if operation.Context == context && operation.Node == nodeName {
+			operations = append(operations, operation.Copy())
+		}
+	}
+	return operations
+}
+
+// CreateOperation stores a new drain operation unless a drain of the same node is still running
+func (r *DrainOperationsRepository) CreateOperation(operation *k8sModels.DrainOperation) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.operations {
+		if existing.Context == operation.Context && existing.Node == operation.Node && !existing.IsFinished() {
+			return false
+		}
+	}
+
+	r.order = append(r.order, operation.ID)
+	r.operations[operation.ID] = operation.Copy()
+	r.evictFinished()
+	return true
+}
+
+// evictFinished drops the oldest finished operations beyond the history limit.
+// Running operations are kept so their drains can still save progress and be polled.
+func (r *DrainOperationsRepository) evictFinished() {
+	excess := len(r.order) - maxTrackedDrainOperations
+	if excess <= 0 {
+		return
+	}
+
+	kept := r.order[:0]
+	for _, id := range r.order {
+		operation := r.operations[id]
+		if excess > 0 && operation.IsFinished() {
+			delete(r.operations, id)
+			excess--
+			continue
+		}
+		kept = append(kept, id)
+	}
+	r.order = kept
+}
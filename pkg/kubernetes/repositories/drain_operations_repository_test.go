@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+func TestDrainOperationsRepository_CreateOperation_BeyondLimit_EvictsOldestFinished(t *testing.T) {
+	// Arrange
+	repo := NewDrainOperationsRepository()
+	repo.CreateOperation(&k8sModels.DrainOperation{ID: "running", Context: "prod", Node: "node-running", Status: k8sModels.DrainStatusRunning})
+	for i := 0; i < maxTrackedDrainOperations-1; i++ {
+		repo.CreateOperation(&k8sModels.DrainOperation{ID: fmt.Sprintf("done-%d", i), Context: "prod", Node: fmt.Sprintf("node-%d", i), Status: k8sModels.DrainStatusSucceeded})
+	}
+
+	// Act
+	created := repo.CreateOperation(&k8sModels.DrainOperation{ID: "latest", Context: "prod", Node: "node-latest", Status: k8sModels.DrainStatusRunning})
+
+	// Assert
+	assert.True(t, created)
+	_, runningKept := repo.GetOperation("prod", "running")
+	_, oldestKept := repo.GetOperation("prod", "done-0")
+	_, nextKept := repo.GetOperation("prod", "done-1")
+	assert.True(t, runningKept)
+	assert.False(t, oldestKept)
+	assert.True(t, nextKept)
+	assert.Len(t, repo.order, maxTrackedDrainOperations)
+}
+
+func TestDrainOperationsRepository_CreateOperation_WithOnlyRunningOperations_KeepsAll(t *testing.T) {
+	// Arrange
+	repo := NewDrainOperationsRepository()
+	for i := 0; i < maxTrackedDrainOperations; i++ {
+		repo.CreateOperation(&k8sModels.DrainOperation{ID: fmt.Sprintf("running-%d", i), Context: "prod", Node: fmt.Sprintf("node-%d", i), Status: k8sModels.DrainStatusRunning})
+	}
+
+	// Act
+	created := repo.CreateOperation(&k8sModels.DrainOperation{ID: "latest", Context: "prod", Node: "node-latest", Status: k8sModels.DrainStatusRunning})
+
+	// Assert
+	assert.True(t, created)
+	_, oldestKept := repo.GetOperation("prod", "running-0")
+	assert.True(t, oldestKept)
+	assert.Len(t, repo.order, maxTrackedDrainOperations+1)
+}
@@ -0,0 +1,239 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+// EventsRepository handles event-related data access on top of the core/v1 events API
+type EventsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewEventsRepository creates a new events repository
+func NewEventsRepository(registry *kubernetes.ClientRegistry) *EventsRepository {
+	return &EventsRepository{
+		registry: registry,
+	}
+}
+
+// GetEvents gets events for a specific resource identified by its kind and name
+func (r *EventsRepository) GetEvents(ctx context.Context, context, namespace, resourceType, resourceName string) ([]k8sPorts.Event, error) {
+	if resourceType == "" || resourceName == "" {
+		return nil, fmt.Errorf("resource type and name are required")
+	}
+
+	selector := fields.Set{
+		"involvedObject.kind": resourceType,
+		"involvedObject.name": resourceName,
+	}.AsSelector().String()
+
+	return r.listEvents(ctx, context, namespace, selector)
+}
+
+// GetNamespaceEvents gets all events in a namespace
+func (r *EventsRepository) GetNamespaceEvents(ctx context.Context, context, namespace string) ([]k8sPorts.Event, error) {
+	return r.listEvents(ctx, context, namespace, "")
+}
+
+// GetWarningEvents gets warning events in a namespace
+func (r *EventsRepository) GetWarningEvents(ctx context.Context, context, namespace string) ([]k8sPorts.Event, error) {
+	selector := fields.OneTermEqualSelector("type", corev1.EventTypeWarning).String()
+	return r.listEvents(ctx, context, namespace, selector)
+}
+
+// GetDeploymentObjects gets a deployment with the ReplicaSets and Pods matching its selector
+func (r *EventsRepository) GetDeploymentObjects(ctx context.Context, context, namespace, deploymentName string) (*k8sPorts.DeploymentObjects, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := client.GetDeployment(ctx, namespace, deploymentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector on deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+	listOptions := metav1.ListOptions{LabelSelector: selector.String()}
+
+	replicaSetList, err := client.ListReplicaSets(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replica sets for deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+	podList, err := client.ListPods(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for deployment %s/%s: %w", namespace, deploymentName, err)
+	}
+
+	objects := &k8sPorts.DeploymentObjects{
+		Deployment:  eventObject("Deployment", &deployment.ObjectMeta),
+		ReplicaSets: make([]k8sPorts.OwnedObject, 0, len(replicaSetList.Items)),
+		Pods:        make([]k8sPorts.OwnedObject, 0, len(podList.Items)),
+	}
+	for i := range replicaSetList.Items {
+		objects.ReplicaSets = append(objects.ReplicaSets, ownedObject("ReplicaSet", &replicaSetList.Items[i].ObjectMeta))
+	}
+	for i := range podList.Items {
+		objects.Pods = append(objects.Pods, ownedObject("Pod", &podList.Items[i].ObjectMeta))
+	}
+	return objects, nil
+}
+
+// WatchEvents watches for new events in a namespace until ctx is cancelled.
+// Only events recorded after the call are delivered; the channel is closed when the watch ends.
+func (r *EventsRepository) WatchEvents(ctx context.Context, context, namespace string) (<-chan k8sPorts.Event, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start from the current resource version so existing events are not replayed
+	eventList, err := client.ListEvents(ctx, namespace, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	watcher, err := client.WatchEvents(ctx, namespace, metav1.ListOptions{
+		ResourceVersion: eventList.ResourceVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to watch events: %w", err)
+	}
+
+	events := make(chan k8sPorts.Event)
+	go func() {
+		defer close(events)
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case result, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				if result.Type != watch.Added && result.Type != watch.Modified {
+					continue
+				}
+				event, ok := result.Object.(*corev1.Event)
+				if !ok {
+					continue
+				}
+				select {
+				case events <- r.convertEvent(event):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// listEvents lists events in a namespace matching a field selector
+func (r *EventsRepository) listEvents(ctx context.Context, context, namespace, fieldSelector string) ([]k8sPorts.Event, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	eventList, err := client.ListEvents(ctx, namespace, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events in namespace %s: %w", namespace, err)
+	}
+
+	events := make([]k8sPorts.Event, 0, len(eventList.Items))
+	for i := range eventList.Items {
+		events = append(events, r.convertEvent(&eventList.Items[i]))
+	}
+
+	return events, nil
+}
+
+// eventObject identifies an object the way events refer to it
+func eventObject(kind string, meta *metav1.ObjectMeta) k8sPorts.EventObject {
+	return k8sPorts.EventObject{Kind: kind, Name: meta.Name, Namespace: meta.Namespace, UID: string(meta.UID)}
+}
+
+// ownedObject identifies an object together with its controller
+func ownedObject(kind string, meta *metav1.ObjectMeta) k8sPorts.OwnedObject {
+	owned := k8sPorts.OwnedObject{Object: eventObject(kind, meta)}
+	if owner := metav1.GetControllerOf(meta); owner != nil {
+		owned.ControllerUID = string(owner.UID)
+	}
+	return owned
+}
+
+// convertEvent converts a core/v1 event to the port representation
+func (r *EventsRepository) convertEvent(event *corev1.Event) k8sPorts.Event {
+	firstTime := event.FirstTimestamp.Time
+	lastTime := event.LastTimestamp.Time
+
+	// Events emitted through events.k8s.io only carry eventTime and series
+	if firstTime.IsZero() {
+		firstTime = event.EventTime.Time
+	}
+	if event.Series != nil && !event.Series.LastObservedTime.IsZero() {
+		lastTime = event.Series.LastObservedTime.Time
+	}
+	if lastTime.IsZero() {
+		lastTime = firstTime
+	}
+	if firstTime.IsZero() {
+		firstTime = event.CreationTimestamp.Time
+		lastTime = firstTime
+	}
+
+	count := event.Count
+	if event.Series != nil && event.Series.Count > count {
+		count = event.Series.Count
+	}
+	if count == 0 {
+		count = 1
+	}
+
+	component := event.Source.Component
+	if component == "" {
+		component = event.ReportingController
+	}
+	host := event.Source.Host
+	if host == "" {
+		host = event.ReportingInstance
+	}
+
+	return k8sPorts.Event{
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Object: k8sPorts.EventObject{
+			Kind:      event.InvolvedObject.Kind,
+			Name:      event.InvolvedObject.Name,
+			Namespace: event.InvolvedObject.Namespace,
+			UID:       string(event.InvolvedObject.UID),
+		},
+		Source: k8sPorts.EventSource{
+			Component: component,
+			Host:      host,
+		},
+		Count:     count,
+		FirstTime: firstTime,
+		LastTime:  lastTime,
+	}
+}
@@ -0,0 +1,55 @@
+package repositories
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// maxRecordedExecSessions bounds the exec session history kept in memory
+const maxRecordedExecSessions = 500
+
+// ExecSessionsRepository records exec sessions for auditing.
+// Every session is written to the server log; the most recent ones are also kept in memory.
+type ExecSessionsRepository struct {
+	mu       sync.RWMutex
+	sessions []k8sModels.ExecSession
+}
+
+// NewExecSessionsRepository creates a new exec sessions repository
+func NewExecSessionsRepository() *ExecSessionsRepository {
+	return &ExecSessionsRepository{}
+}
+
+// RecordSession records a finished exec session
+func (r *ExecSessionsRepository) RecordSession(session *k8sModels.ExecSession) {
+	log.Printf("k8s exec session %s: user=%q cluster=%s pod=%s/%s container=%s command=%q duration=%s exit_code=%d error=%q",
+		session.ID, session.User, session.Context, session.Namespace, session.Pod, session.Container,
+		strings.Join(session.Command, " "), session.Duration().Round(time.Millisecond), session.ExitCode, session.Error)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sessions = append(r.sessions, *session)
+	if len(r.sessions) > maxRecordedExecSessions {
+		r.sessions = r.sessions[len(r.sessions)-maxRecordedExecSessions:]
+	}
+}
+
+// ListSessions lists recorded sessions of a cluster namespace, newest first
+func (r *ExecSessionsRepository) ListSessions(context, namespace string) []k8sModels.ExecSession {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sessions := make([]k8sModels.ExecSession, 0)
+	for i := len(r.sessions) - 1; i >= 0; i-- {
+		session := r.sessions[i]
+		if session.Context == context && session.Namespace == namespace {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions
+}
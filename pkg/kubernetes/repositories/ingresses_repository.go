@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// IngressesRepository handles ingress data access
+type IngressesRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewIngressesRepository creates a new ingresses repository
+func NewIngressesRepository(registry *kubernetes.ClientRegistry) *IngressesRepository {
+	return &IngressesRepository{
+		registry: registry,
+	}
+}
+
+// GetIngress gets a specific ingress
+func (r *IngressesRepository) GetIngress(ctx context.Context, context, namespace, name string) (*k8sModels.Ingress, error) {
+	if name == "" {
+		return nil, fmt.Errorf("ingress name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, err := client.GetIngress(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ingress %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertIngress(ingress), nil
+}
+
+// ListIngresses lists ingresses, across all namespaces when the filter has none
+func (r *IngressesRepository) ListIngresses(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Ingress, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	ingressList, err := client.ListIngresses(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ingresses: %w", err)
+	}
+
+	ingresses := make([]k8sModels.Ingress, 0, len(ingressList.Items))
+	for i := range ingressList.Items {
+		ingresses = append(ingresses, *r.convertIngress(&ingressList.Items[i]))
+	}
+
+	return ingresses, nil
+}
+
+// convertIngress converts a Kubernetes ingress to our domain model
+func (r *IngressesRepository) convertIngress(ingress *networkingv1.Ingress) *k8sModels.Ingress {
+	result := &k8sModels.Ingress{
+		Name:      ingress.Name,
+		Namespace: ingress.Namespace,
+		Rules:     make([]k8sModels.IngressRule, 0, len(ingress.Spec.Rules)),
+		Age:       time.Since(ingress.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt: ingress.CreationTimestamp.Time,
+	}
+
+	if ingress.Spec.IngressClassName != nil {
+		result.ClassName = *ingress.Spec.IngressClassName
+	}
+
+	if backend := ingress.Spec.DefaultBackend; backend != nil {
+		result.Rules = append(result.Rules, k8sModels.IngressRule{
+			Paths: []k8sModels.IngressPath{r.convertBackend("/", "", backend)},
+		})
+	}
+
+	for _, rule := range ingress.Spec.Rules {
+		converted := k8sModels.IngressRule{
+			Host:  rule.Host,
+			Paths: make([]k8sModels.IngressPath, 0),
+		}
+		if rule.HTTP != nil {
+			for _, path := range rule.HTTP.Paths {
+				pathType := ""
+				if path.PathType != nil {
+					pathType = string(*path.PathType)
+				}
+				converted.Paths = append(converted.Paths, r.convertBackend(path.Path, pathType, &path.Backend))
+			}
+		}
+		result.Rules = append(result.Rules, converted)
+	}
+
+	for _, tls := range ingress.Spec.TLS {
+		result.TLS = append(result.TLS, k8sModels.IngressTLS{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
+	}
+
+	for _, lb := range ingress.Status.LoadBalancer.Ingress {
+		if lb.Hostname != "" {
+			result.LoadBalancer = append(result.LoadBalancer, lb.Hostname)
+		} else if lb.IP != "" {
+			result.LoadBalancer = append(result.LoadBalancer, lb.IP)
+		}
+	}
+
+	return result
+}
+
+// convertBackend converts an ingress backend into a routed path
+func (r *IngressesRepository) convertBackend(path, pathType string, backend *networkingv1.IngressBackend) k8sModels.IngressPath {
+	result := k8sModels.IngressPath{
+		Path:     path,
+		PathType: pathType,
+	}
+	if backend.Service != nil {
+		result.ServiceName = backend.Service.Name
+		if backend.Service.Port.Name != "" {
+			result.ServicePort = backend.Service.Port.Name
+		} else {
+			result.ServicePort = strconv.Itoa(int(backend.Service.Port.Number))
+		}
+	}
+	return result
+}
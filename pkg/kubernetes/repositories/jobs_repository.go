@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// JobsRepository handles job data access
+type JobsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewJobsRepository creates a new jobs repository
+func NewJobsRepository(registry *kubernetes.ClientRegistry) *JobsRepository {
+	return &JobsRepository{
+		registry: registry,
+	}
+}
+
+// GetJob gets a specific job
+func (r *JobsRepository) GetJob(ctx context.Context, context, namespace, name string) (*k8sModels.Job, error) {
+	if name == "" {
+		return nil, fmt.Errorf("job name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := client.GetJob(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job %s/%s: %w", namespace, name, err)
+	}
+
+	return convertJob(job), nil
+}
+
+// ListJobs lists jobs, across all namespaces when the filter has none
+func (r *JobsRepository) ListJobs(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Job, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	jobList, err := client.ListJobs(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	jobs := make([]k8sModels.Job, 0, len(jobList.Items))
+	for i := range jobList.Items {
+		jobs = append(jobs, *convertJob(&jobList.Items[i]))
+	}
+
+	return jobs, nil
+}
+
+// convertJob converts a Kubernetes job to our domain model
+func convertJob(job *batchv1.Job) *k8sModels.Job {
+	result := &k8sModels.Job{
+		Name:        job.Name,
+		Namespace:   job.Namespace,
+		Status:      jobStatus(job),
+		Completions: 1,
+		Parallelism: 1,
+		Active:      job.Status.Active,
+		Succeeded:   job.Status.Succeeded,
+		Failed:      job.Status.Failed,
+		Images:      podTemplateImages(&job.Spec.Template),
+		Age:         time.Since(job.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt:   job.CreationTimestamp.Time,
+	}
+
+	if job.Spec.Completions != nil {
+		result.Completions = *job.Spec.Completions
+	}
+	if job.Spec.Parallelism != nil {
+		result.Parallelism = *job.Spec.Parallelism
+	}
+	if owner := metav1.GetControllerOf(job); owner != nil && owner.Kind == "CronJob" {
+		result.CronJob = owner.Name
+	}
+
+	if job.Status.StartTime != nil {
+		startTime := job.Status.StartTime.Time
+		result.StartTime = &startTime
+
+		end := time.Now()
+		if job.Status.CompletionTime != nil {
+			end = job.Status.CompletionTime.Time
+			result.CompletionTime = &end
+		}
+		result.Duration = end.Sub(startTime).Round(time.Second).String()
+	}
+
+	return result
+}
+
+// jobStatus derives the job status from its conditions
+func jobStatus(job *batchv1.Job) k8sModels.JobStatus {
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return k8sModels.JobStatusComplete
+		case batchv1.JobFailed:
+			return k8sModels.JobStatusFailed
+		}
+	}
+
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return k8sModels.JobStatusSuspended
+	}
+	return k8sModels.JobStatusRunning
+}
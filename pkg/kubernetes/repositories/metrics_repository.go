@@ -0,0 +1,169 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+	k8sPorts "github.com/dash-ops/dash-ops/pkg/kubernetes/ports"
+)
+
+// MetricsRepository handles resource metrics from the metrics.k8s.io API
+type MetricsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewMetricsRepository creates a new metrics repository
+func NewMetricsRepository(registry *kubernetes.ClientRegistry) *MetricsRepository {
+	return &MetricsRepository{
+		registry: registry,
+	}
+}
+
+// IsMetricsServerAvailable checks if metrics server is available
+func (r *MetricsRepository) IsMetricsServerAvailable(ctx context.Context, context string) (bool, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return false, err
+	}
+
+	return client.IsMetricsAvailable(ctx)
+}
+
+// GetNodeMetrics gets node resources including live usage
+func (r *MetricsRepository) GetNodeMetrics(ctx context.Context, context, nodeName string) (*k8sModels.NodeResources, error) {
+	if err := r.requireMetrics(ctx, context); err != nil {
+		return nil, err
+	}
+
+	node, err := NewNodesRepository(r.registry).GetNode(ctx, context, nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if node.Resources.Usage == nil {
+		return nil, fmt.Errorf("no metrics reported for node %s yet", nodeName)
+	}
+
+	return &node.Resources, nil
+}
+
+// GetPodMetrics gets live container usage of a pod along with its requests and limits
+func (r *MetricsRepository) GetPodMetrics(ctx context.Context, context, namespace, podName string) (*k8sPorts.PodMetrics, error) {
+	if err := r.requireMetrics(ctx, context); err != nil {
+		return nil, err
+	}
+
+	pod, err := NewPodsRepository(r.registry).GetPod(ctx, context, namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &k8sPorts.PodMetrics{
+		PodName:     pod.Name,
+		Namespace:   pod.Namespace,
+		Containers:  make([]k8sPorts.ContainerMetrics, 0, len(pod.Containers)),
+		LastUpdated: time.Now(),
+	}
+	for _, container := range pod.Containers {
+		if container.Usage == nil {
+			return nil, fmt.Errorf("no metrics reported for pod %s/%s yet", namespace, podName)
+		}
+		result.Containers = append(result.Containers, k8sPorts.ContainerMetrics{
+			Name:      container.Name,
+			Resources: container.Resources,
+			Usage:     *container.Usage,
+		})
+	}
+
+	return result, nil
+}
+
+// GetNamespaceMetrics gets aggregated live usage of the pods in a namespace
+func (r *MetricsRepository) GetNamespaceMetrics(ctx context.Context, context, namespace string) (*k8sPorts.NamespaceMetrics, error) {
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if err := r.requireMetrics(ctx, context); err != nil {
+		return nil, err
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := client.ListPods(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+	}
+
+	metricsList, err := client.ListPodMetrics(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod metrics in namespace %s: %w", namespace, err)
+	}
+
+	result := &k8sPorts.NamespaceMetrics{
+		Namespace:   namespace,
+		TotalPods:   len(podList.Items),
+		LastUpdated: time.Now(),
+	}
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			result.RunningPods++
+		}
+	}
+
+	var cpuMillis, memoryBytes int64
+	for _, podMetrics := range metricsList.Items {
+		for _, container := range podMetrics.Containers {
+			cpuMillis += container.Usage.Cpu().MilliValue()
+			memoryBytes += container.Usage.Memory().Value()
+		}
+	}
+	result.Resources = k8sModels.ResourceList{
+		CPU:    resource.NewMilliQuantity(cpuMillis, resource.DecimalSI).String(),
+		Memory: resource.NewQuantity(memoryBytes, resource.BinarySI).String(),
+		Pods:   fmt.Sprintf("%d", len(metricsList.Items)),
+	}
+
+	return result, nil
+}
+
+// requireMetrics returns an error wrapping kubernetes.ErrMetricsUnavailable when metrics are not served
+func (r *MetricsRepository) requireMetrics(ctx context.Context, context string) error {
+	available, err := r.IsMetricsServerAvailable(ctx, context)
+	if err != nil {
+		return err
+	}
+	if !available {
+		return fmt.Errorf("%w in cluster %s", kubernetes.ErrMetricsUnavailable, context)
+	}
+	return nil
+}
+
+// applyContainerUsage copies live container usage from pod metrics onto a pod
+func applyContainerUsage(pod *k8sModels.Pod, metrics *kubernetes.PodMetrics) {
+	for i := range pod.Containers {
+		for _, containerMetrics := range metrics.Containers {
+			if containerMetrics.Name == pod.Containers[i].Name {
+				usage := usageToResourceList(containerMetrics.Usage)
+				pod.Containers[i].Usage = &usage
+				break
+			}
+		}
+	}
+}
+
+// usageToResourceList converts metrics usage to a resource list
+func usageToResourceList(usage corev1.ResourceList) k8sModels.ResourceList {
+	return k8sModels.ResourceList{
+		CPU:    usage.Cpu().String(),
+		Memory: usage.Memory().String(),
+	}
+}
@@ -14,13 +14,13 @@ import (
 
 // NamespacesRepository handles namespace-related data access
 type NamespacesRepository struct {
-	client *kubernetes.KubernetesClient
+	registry *kubernetes.ClientRegistry
 }
 
 // NewNamespacesRepository creates a new namespaces repository
-func NewNamespacesRepository(client *kubernetes.KubernetesClient) *NamespacesRepository {
+func NewNamespacesRepository(registry *kubernetes.ClientRegistry) *NamespacesRepository {
 	return &NamespacesRepository{
-		client: client,
+		registry: registry,
 	}
 }
 
@@ -30,7 +30,12 @@ func (r *NamespacesRepository) GetNamespace(ctx context.Context, context, namesp
 		return nil, fmt.Errorf("namespace name is required")
 	}
 
-	namespace, err := r.client.GetNamespace(ctx, namespaceName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := client.GetNamespace(ctx, namespaceName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get namespace %s: %w", namespaceName, err)
 	}
@@ -40,7 +45,12 @@ func (r *NamespacesRepository) GetNamespace(ctx context.Context, context, namesp
 
 // ListNamespaces lists all namespaces in a cluster
 func (r *NamespacesRepository) ListNamespaces(ctx context.Context, context string) ([]k8sModels.Namespace, error) {
-	namespaceList, err := r.client.ListNamespaces(ctx, metav1.ListOptions{})
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaceList, err := client.ListNamespaces(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list namespaces: %w", err)
 	}
@@ -64,7 +74,12 @@ func (r *NamespacesRepository) CreateNamespace(ctx context.Context, context, nam
 		return nil, fmt.Errorf("invalid namespace name: %w", err)
 	}
 
-	createdNamespace, err := r.client.CreateNamespace(ctx, namespaceName, labels)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	createdNamespace, err := client.CreateNamespace(ctx, namespaceName, labels)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create namespace %s: %w", namespaceName, err)
 	}
@@ -83,7 +98,12 @@ func (r *NamespacesRepository) DeleteNamespace(ctx context.Context, context, nam
 		return fmt.Errorf("cannot delete system namespace: %s", namespaceName)
 	}
 
-	err := r.client.DeleteNamespace(ctx, namespaceName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteNamespace(ctx, namespaceName)
 	if err != nil {
 		return fmt.Errorf("failed to delete namespace %s: %w", namespaceName, err)
 	}
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
@@ -14,13 +16,13 @@ import (
 
 // NodesRepository handles node-related data access
 type NodesRepository struct {
-	client *kubernetes.KubernetesClient
+	registry *kubernetes.ClientRegistry
 }
 
 // NewNodesRepository creates a new nodes repository
-func NewNodesRepository(client *kubernetes.KubernetesClient) *NodesRepository {
+func NewNodesRepository(registry *kubernetes.ClientRegistry) *NodesRepository {
 	return &NodesRepository{
-		client: client,
+		registry: registry,
 	}
 }
 
@@ -30,63 +32,175 @@ func (r *NodesRepository) GetNode(ctx context.Context, context, nodeName string)
 		return nil, fmt.Errorf("node name is required")
 	}
 
-	node, err := r.client.GetNode(ctx, nodeName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := client.GetNode(ctx, nodeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
 	}
 
-	return r.convertNode(ctx, node), nil
+	var usage *k8sModels.ResourceList
+	if available, err := client.IsMetricsAvailable(ctx); err == nil && available {
+		if metrics, err := client.GetNodeMetrics(ctx, nodeName); err == nil {
+			nodeUsage := usageToResourceList(metrics.Usage)
+			usage = &nodeUsage
+		}
+	}
+
+	return r.convertNode(ctx, client, node, usage), nil
 }
 
 // ListNodes lists all nodes in a cluster
 func (r *NodesRepository) ListNodes(ctx context.Context, context string) ([]k8sModels.Node, error) {
-	nodeList, err := r.client.ListNodes(ctx, metav1.ListOptions{})
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeList, err := client.ListNodes(ctx, metav1.ListOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list nodes: %w", err)
 	}
 
+	usageByNode := r.listNodeUsage(ctx, client)
+
 	var nodes []k8sModels.Node
 	for _, node := range nodeList.Items {
-		nodes = append(nodes, *r.convertNode(ctx, &node))
+		nodes = append(nodes, *r.convertNode(ctx, client, &node, usageByNode[node.Name]))
 	}
 
 	return nodes, nil
 }
 
-// GetNodeMetrics gets node resource metrics
+// GetNodeMetrics gets node resource metrics, including live usage when metrics-server is available
 func (r *NodesRepository) GetNodeMetrics(ctx context.Context, context, nodeName string) (*k8sModels.NodeResources, error) {
+	node, err := r.GetNode(ctx, context, nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node metrics for %s: %w", nodeName, err)
+	}
+
+	return &node.Resources, nil
+}
+
+// SetUnschedulable cordons or uncordons a node
+func (r *NodesRepository) SetUnschedulable(ctx context.Context, context, nodeName string, unschedulable bool) error {
 	if nodeName == "" {
-		return nil, fmt.Errorf("node name is required")
+		return fmt.Errorf("node name is required")
 	}
 
-	node, err := r.client.GetNode(ctx, nodeName)
+	client, err := r.registry.GetClient(context)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get node metrics for %s: %w", nodeName, err)
+		return err
 	}
 
-	// Extract resource information from node status
-	resources := &k8sModels.NodeResources{
-		Capacity: k8sModels.ResourceList{
-			CPU:    node.Status.Capacity.Cpu().String(),
-			Memory: node.Status.Capacity.Memory().String(),
-			Pods:   node.Status.Capacity.Pods().String(),
-		},
-		Allocatable: k8sModels.ResourceList{
-			CPU:    node.Status.Allocatable.Cpu().String(),
-			Memory: node.Status.Allocatable.Memory().String(),
-			Pods:   node.Status.Allocatable.Pods().String(),
-		},
+	if err := client.SetNodeUnschedulable(ctx, nodeName, unschedulable); err != nil {
+		return fmt.Errorf("failed to update node %s: %w", nodeName, err)
 	}
 
-	// TODO: Add actual usage metrics when metrics server is available
-	// For now, we'll return empty used resources
-	resources.Used = k8sModels.ResourceList{}
+	return nil
+}
 
-	return resources, nil
+// ListDrainCandidates lists the pods scheduled on a node as drain candidates
+func (r *NodesRepository) ListDrainCandidates(ctx context.Context, context, nodeName string) ([]k8sModels.DrainCandidate, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := client.ListPods(ctx, "", metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", nodeName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	candidates := make([]k8sModels.DrainCandidate, 0, len(pods.Items))
+	for _, pod := range pods.Items {
+		candidates = append(candidates, r.convertDrainCandidate(&pod))
+	}
+	return candidates, nil
+}
+
+// EvictPod requests the eviction of a pod; a pod that no longer exists counts as evicted
+func (r *NodesRepository) EvictPod(ctx context.Context, context, namespace, podName string, gracePeriodSeconds *int64) error {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	err = client.EvictPod(ctx, namespace, podName, gracePeriodSeconds)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to evict pod %s/%s: %w", namespace, podName, err)
+	}
+	return nil
+}
+
+// IsPodGone checks if an evicted pod was removed. A pod recreated under the same name has a new UID.
+func (r *NodesRepository) IsPodGone(ctx context.Context, context, namespace, podName, uid string) (bool, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return false, err
+	}
+
+	pod, err := client.GetPodLive(ctx, namespace, podName)
+	if apierrors.IsNotFound(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
+	}
+	return string(pod.UID) != uid, nil
+}
+
+// convertDrainCandidate converts a Kubernetes pod to a drain candidate
+func (r *NodesRepository) convertDrainCandidate(pod *corev1.Pod) k8sModels.DrainCandidate {
+	candidate := k8sModels.DrainCandidate{
+		Namespace: pod.Namespace,
+		Name:      pod.Name,
+		UID:       string(pod.UID),
+		Finished:  pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed,
+	}
+
+	if owner := metav1.GetControllerOf(pod); owner != nil {
+		candidate.OwnerKind = owner.Kind
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		candidate.Mirror = true
+	}
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			candidate.HasEmptyDir = true
+			break
+		}
+	}
+
+	return candidate
+}
+
+// listNodeUsage returns live usage keyed by node name, or nil when metrics are unavailable
+func (r *NodesRepository) listNodeUsage(ctx context.Context, client *kubernetes.KubernetesClient) map[string]*k8sModels.ResourceList {
+	if available, err := client.IsMetricsAvailable(ctx); err != nil || !available {
+		return nil
+	}
+
+	metricsList, err := client.ListNodeMetrics(ctx)
+	if err != nil {
+		return nil
+	}
+
+	usageByNode := make(map[string]*k8sModels.ResourceList, len(metricsList.Items))
+	for _, metrics := range metricsList.Items {
+		usage := usageToResourceList(metrics.Usage)
+		usageByNode[metrics.Name] = &usage
+	}
+	return usageByNode
 }
 
 // convertNode converts a Kubernetes node to our domain model
-func (r *NodesRepository) convertNode(ctx context.Context, node *corev1.Node) *k8sModels.Node {
+func (r *NodesRepository) convertNode(ctx context.Context, client *kubernetes.KubernetesClient, node *corev1.Node, usage *k8sModels.ResourceList) *k8sModels.Node {
 	// Determine node status
 	var status k8sModels.NodeStatus
 	for _, condition := range node.Status.Conditions {
@@ -147,24 +261,28 @@ func (r *NodesRepository) convertNode(ctx context.Context, node *corev1.Node) *k
 			Memory: node.Status.Allocatable.Memory().String(),
 			Pods:   node.Status.Allocatable.Pods().String(),
 		},
-		// Used resources would require metrics-server to be installed
-		// For now, we can at least count the pods
+		// Used sums pod requests; live consumption comes from metrics-server
 		Used: k8sModels.ResourceList{
 			CPU:    "0",
 			Memory: "0",
 			Pods:   "0",
 		},
+		Limits: k8sModels.ResourceList{
+			CPU:    "0",
+			Memory: "0",
+		},
+		Usage: usage,
 	}
 
 	// Try to get pod count and calculate resource usage on this node
-	pods, err := r.client.ListPods(ctx, "", metav1.ListOptions{
+	pods, err := client.ListPods(ctx, "", metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name),
 	})
 	if err == nil {
 		resources.Used.Pods = fmt.Sprintf("%d", len(pods.Items))
 
 		// Calculate estimated CPU and Memory usage from pod requests
-		var totalCPURequest, totalMemoryRequest int64
+		var totalCPURequest, totalMemoryRequest, totalCPULimit, totalMemoryLimit int64
 		for _, pod := range pods.Items {
 			for _, container := range pod.Spec.Containers {
 				if container.Resources.Requests != nil {
@@ -177,8 +295,14 @@ func (r *NodesRepository) convertNode(ctx context.Context, node *corev1.Node) *k
 						totalMemoryRequest += memoryRequest.Value()
 					}
 				}
+				if container.Resources.Limits != nil {
+					totalCPULimit += container.Resources.Limits.Cpu().MilliValue()
+					totalMemoryLimit += container.Resources.Limits.Memory().Value()
+				}
 			}
 		}
+		resources.Limits.CPU = resource.NewMilliQuantity(totalCPULimit, resource.DecimalSI).String()
+		resources.Limits.Memory = resource.NewQuantity(totalMemoryLimit, resource.BinarySI).String()
 
 		// Convert to string format
 		if totalCPURequest > 0 {
@@ -192,15 +316,16 @@ func (r *NodesRepository) convertNode(ctx context.Context, node *corev1.Node) *k
 	}
 
 	return &k8sModels.Node{
-		Name:       node.Name,
-		Status:     status,
-		Roles:      roles,
-		Age:        time.Since(node.CreationTimestamp.Time).Round(time.Second).String(),
-		Version:    node.Status.NodeInfo.KubeletVersion,
-		InternalIP: internalIP,
-		ExternalIP: externalIP,
-		Conditions: conditions,
-		Resources:  resources,
-		CreatedAt:  node.CreationTimestamp.Time,
+		Name:          node.Name,
+		Status:        status,
+		Roles:         roles,
+		Age:           time.Since(node.CreationTimestamp.Time).Round(time.Second).String(),
+		Version:       node.Status.NodeInfo.KubeletVersion,
+		Unschedulable: node.Spec.Unschedulable,
+		InternalIP:    internalIP,
+		ExternalIP:    externalIP,
+		Conditions:    conditions,
+		Resources:     resources,
+		CreatedAt:     node.CreationTimestamp.Time,
 	}
 }
@@ -3,6 +3,7 @@ package repositories
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"regexp"
@@ -11,20 +12,25 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
 	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
 )
 
+// defaultContainerAnnotation names the container kubectl uses when none is given
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
 // PodsRepository handles pod-related data access
 type PodsRepository struct {
-	client *kubernetes.KubernetesClient
+	registry *kubernetes.ClientRegistry
 }
 
 // NewPodsRepository creates a new pods repository
-func NewPodsRepository(client *kubernetes.KubernetesClient) *PodsRepository {
+func NewPodsRepository(registry *kubernetes.ClientRegistry) *PodsRepository {
 	return &PodsRepository{
-		client: client,
+		registry: registry,
 	}
 }
 
@@ -37,16 +43,35 @@ func (r *PodsRepository) GetPod(ctx context.Context, context, namespace, podName
 		return nil, fmt.Errorf("namespace is required")
 	}
 
-	pod, err := r.client.GetPod(ctx, namespace, podName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := client.GetPod(ctx, namespace, podName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, podName, err)
 	}
 
-	return r.convertPod(pod), nil
+	result := r.convertPod(pod)
+
+	// Live usage is optional; pods are still returned when metrics-server is missing
+	if available, err := client.IsMetricsAvailable(ctx); err == nil && available {
+		if metrics, err := client.GetPodMetrics(ctx, namespace, podName); err == nil {
+			applyContainerUsage(result, metrics)
+		}
+	}
+
+	return result, nil
 }
 
 // ListPods lists pods with optional filtering
 func (r *PodsRepository) ListPods(ctx context.Context, context string, filter *k8sModels.PodFilter) (*k8sModels.PodList, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build list options based on filter
 	listOptions := metav1.ListOptions{}
 	if filter != nil {
@@ -62,7 +87,7 @@ func (r *PodsRepository) ListPods(ctx context.Context, context string, filter *k
 
 	if filter != nil && filter.Namespace != "" {
 		// List pods in specific namespace
-		podList, err := r.client.ListPods(ctx, filter.Namespace, listOptions)
+		podList, err := client.ListPods(ctx, filter.Namespace, listOptions)
 		if err != nil {
 			return nil, fmt.Errorf("failed to list pods in namespace %s: %w", filter.Namespace, err)
 		}
@@ -73,13 +98,13 @@ func (r *PodsRepository) ListPods(ctx context.Context, context string, filter *k
 	} else {
 		// For cross-namespace listing, we need to list all namespaces first
 		// and then get pods from each namespace
-		namespaces, err := r.client.ListNamespaces(ctx, metav1.ListOptions{})
+		namespaces, err := client.ListNamespaces(ctx, metav1.ListOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list namespaces: %w", err)
 		}
 
 		for _, namespace := range namespaces.Items {
-			podList, err := r.client.ListPods(ctx, namespace.Name, listOptions)
+			podList, err := client.ListPods(ctx, namespace.Name, listOptions)
 			if err != nil {
 				// Log error but continue with other namespaces
 				continue
@@ -91,6 +116,12 @@ func (r *PodsRepository) ListPods(ctx context.Context, context string, filter *k
 		}
 	}
 
+	namespace := ""
+	if filter != nil {
+		namespace = filter.Namespace
+	}
+	r.attachPodMetrics(ctx, client, namespace, listOptions.LabelSelector, pods)
+
 	// Apply additional filters
 	if filter != nil {
 		pods = r.filterPods(pods, filter)
@@ -111,7 +142,12 @@ func (r *PodsRepository) DeletePod(ctx context.Context, context, namespace, podN
 		return fmt.Errorf("namespace is required")
 	}
 
-	err := r.client.DeletePod(ctx, namespace, podName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeletePod(ctx, namespace, podName)
 	if err != nil {
 		return fmt.Errorf("failed to delete pod %s/%s: %w", namespace, podName, err)
 	}
@@ -129,7 +165,12 @@ func (r *PodsRepository) GetPodLogs(ctx context.Context, context string, filter
 	}
 
 	// Get pod to determine available containers
-	pod, err := r.client.GetPod(ctx, filter.Namespace, filter.PodName)
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := client.GetPod(ctx, filter.Namespace, filter.PodName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod for logs: %w", err)
 	}
@@ -139,7 +180,7 @@ func (r *PodsRepository) GetPodLogs(ctx context.Context, context string, filter
 	// If no specific container is specified, get logs from all containers
 	if filter.ContainerName == "" {
 		for _, container := range pod.Spec.Containers {
-			containerLogs, err := r.getContainerLogs(ctx, filter.Namespace, filter.PodName, container.Name, filter.TailLines)
+			containerLogs, err := r.getContainerLogs(ctx, client, filter.Namespace, filter.PodName, container.Name, filter.TailLines)
 			if err != nil {
 				// Log error but continue with other containers
 				fmt.Printf("Warning: failed to get logs for container %s: %v\n", container.Name, err)
@@ -149,7 +190,7 @@ func (r *PodsRepository) GetPodLogs(ctx context.Context, context string, filter
 		}
 	} else {
 		// Get logs from specific container
-		containerLogs, err := r.getContainerLogs(ctx, filter.Namespace, filter.PodName, filter.ContainerName, filter.TailLines)
+		containerLogs, err := r.getContainerLogs(ctx, client, filter.Namespace, filter.PodName, filter.ContainerName, filter.TailLines)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get logs for container %s: %w", filter.ContainerName, err)
 		}
@@ -159,8 +200,153 @@ func (r *PodsRepository) GetPodLogs(ctx context.Context, context string, filter
 	return logs, nil
 }
 
+// StreamPodLogs opens a log stream for a pod container; the caller must close it
+func (r *PodsRepository) StreamPodLogs(ctx context.Context, context string, filter *k8sModels.LogFilter) (io.ReadCloser, error) {
+	if filter.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if filter.PodName == "" {
+		return nil, fmt.Errorf("pod name is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	logOptions := &corev1.PodLogOptions{
+		Container:  filter.ContainerName,
+		Follow:     filter.Follow,
+		Previous:   filter.Previous,
+		Timestamps: filter.Timestamps,
+	}
+	if filter.TailLines > 0 {
+		logOptions.TailLines = &filter.TailLines
+	}
+	if filter.SinceSeconds > 0 {
+		logOptions.SinceSeconds = &filter.SinceSeconds
+	} else if filter.SinceTime != nil {
+		sinceTime := metav1.NewTime(*filter.SinceTime)
+		logOptions.SinceTime = &sinceTime
+	}
+
+	stream, err := client.GetPodLogs(ctx, filter.Namespace, filter.PodName, logOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stream logs for %s/%s: %w", filter.Namespace, filter.PodName, err)
+	}
+
+	return stream, nil
+}
+
+// ExecPod runs the session command in a pod container until it exits or ctx is cancelled.
+// An empty container is resolved to the pod's default container and stored on the session.
+func (r *PodsRepository) ExecPod(ctx context.Context, session *k8sModels.ExecSession, streams k8sModels.ExecStreams) error {
+	if session.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if session.Pod == "" {
+		return fmt.Errorf("pod name is required")
+	}
+
+	client, err := r.registry.GetClient(session.Context)
+	if err != nil {
+		return err
+	}
+
+	pod, err := client.GetPod(ctx, session.Namespace, session.Pod)
+	if err != nil {
+		return fmt.Errorf("failed to get pod %s/%s: %w", session.Namespace, session.Pod, err)
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return fmt.Errorf("cannot exec into pod %s/%s in phase %s", session.Namespace, session.Pod, pod.Status.Phase)
+	}
+
+	container, err := resolveExecContainer(pod, session.Container)
+	if err != nil {
+		return err
+	}
+	session.Container = container
+
+	options := &corev1.PodExecOptions{
+		Container: container,
+		Command:   session.Command,
+		Stdin:     streams.Stdin != nil,
+		Stdout:    streams.Stdout != nil,
+		Stderr:    streams.Stderr != nil && !session.TTY,
+		TTY:       session.TTY,
+	}
+	streamOptions := remotecommand.StreamOptions{
+		Stdin:  streams.Stdin,
+		Stdout: streams.Stdout,
+		Tty:    session.TTY,
+	}
+	// A TTY merges stderr into stdout
+	if !session.TTY {
+		streamOptions.Stderr = streams.Stderr
+	}
+	if session.TTY && streams.Resize != nil {
+		streamOptions.TerminalSizeQueue = &terminalSizeQueue{ctx: ctx, sizes: streams.Resize}
+	}
+
+	err = client.ExecPod(ctx, session.Namespace, session.Pod, options, streamOptions)
+	// A command exiting non-zero is a normal end of the session, not a failure
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		session.ExitCode = exitErr.ExitStatus()
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("exec in %s/%s container %s failed: %w", session.Namespace, session.Pod, container, err)
+	}
+
+	return nil
+}
+
+// resolveExecContainer validates the requested container or picks the pod's default one
+func resolveExecContainer(pod *corev1.Pod, container string) (string, error) {
+	if container == "" {
+		if defaultContainer := pod.Annotations[defaultContainerAnnotation]; defaultContainer != "" {
+			container = defaultContainer
+		} else if len(pod.Spec.Containers) > 0 {
+			return pod.Spec.Containers[0].Name, nil
+		}
+	}
+
+	for _, c := range pod.Spec.Containers {
+		if c.Name == container {
+			return container, nil
+		}
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		if c.Name == container {
+			return container, nil
+		}
+	}
+
+	return "", fmt.Errorf("container %q not found in pod %s/%s", container, pod.Namespace, pod.Name)
+}
+
+// terminalSizeQueue adapts a resize channel to the remotecommand size queue
+type terminalSizeQueue struct {
+	ctx   context.Context
+	sizes <-chan k8sModels.TerminalSize
+}
+
+// Next blocks until the next resize; nil ends the resize loop
+func (q *terminalSizeQueue) Next() *remotecommand.TerminalSize {
+	select {
+	case size, ok := <-q.sizes:
+		if !ok {
+			return nil
+		}
+		return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+	case <-q.ctx.Done():
+		return nil
+	}
+}
+
 // getContainerLogs gets logs for a specific container
-func (r *PodsRepository) getContainerLogs(ctx context.Context, namespace, podName, containerName string, tailLines int64) ([]k8sModels.ContainerLog, error) {
+func (r *PodsRepository) getContainerLogs(ctx context.Context, client *kubernetes.KubernetesClient, namespace, podName, containerName string, tailLines int64) ([]k8sModels.ContainerLog, error) {
 	// Get logs stream
 	logOptions := &corev1.PodLogOptions{
 		Container:  containerName,
@@ -168,7 +354,7 @@ func (r *PodsRepository) getContainerLogs(ctx context.Context, namespace, podNam
 		Timestamps: true,
 	}
 
-	logStream, err := r.client.GetPodLogs(ctx, namespace, podName, logOptions)
+	logStream, err := client.GetPodLogs(ctx, namespace, podName, logOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -285,6 +471,33 @@ func (r *PodsRepository) filterPods(pods []k8sModels.Pod, filter *k8sModels.PodF
 	return filtered
 }
 
+// attachPodMetrics adds live container usage to pods when metrics-server is available
+func (r *PodsRepository) attachPodMetrics(ctx context.Context, client *kubernetes.KubernetesClient, namespace, labelSelector string, pods []k8sModels.Pod) {
+	if len(pods) == 0 {
+		return
+	}
+	if available, err := client.IsMetricsAvailable(ctx); err != nil || !available {
+		return
+	}
+
+	metricsList, err := client.ListPodMetrics(ctx, namespace, labelSelector)
+	if err != nil {
+		return
+	}
+
+	metricsByPod := make(map[string]*kubernetes.PodMetrics, len(metricsList.Items))
+	for i := range metricsList.Items {
+		metrics := &metricsList.Items[i]
+		metricsByPod[metrics.Namespace+"/"+metrics.Name] = metrics
+	}
+
+	for i := range pods {
+		if metrics, ok := metricsByPod[pods[i].Namespace+"/"+pods[i].Name]; ok {
+			applyContainerUsage(&pods[i], metrics)
+		}
+	}
+}
+
 // convertPod converts a Kubernetes pod to our domain model
 func (r *PodsRepository) convertPod(pod *corev1.Pod) *k8sModels.Pod {
 	// Determine pod status and phase
@@ -357,25 +570,25 @@ func (r *PodsRepository) convertPod(pod *corev1.Pod) *k8sModels.Pod {
 				}
 			}
 
-			// Convert resources
-			if container.Resources.Requests != nil || container.Resources.Limits != nil {
-				containerModel.Resources = k8sModels.ContainerResources{
-					Requests: k8sModels.ResourceList{
-						CPU:    container.Resources.Requests.Cpu().String(),
-						Memory: container.Resources.Requests.Memory().String(),
-					},
-					Limits: k8sModels.ResourceList{
-						CPU:    container.Resources.Limits.Cpu().String(),
-						Memory: container.Resources.Limits.Memory().String(),
-					},
-				}
-			}
-
 			if containerStatus.Ready {
 				readyCount++
 			}
 		}
 
+		// Convert resources
+		if container.Resources.Requests != nil || container.Resources.Limits != nil {
+			containerModel.Resources = k8sModels.ContainerResources{
+				Requests: k8sModels.ResourceList{
+					CPU:    container.Resources.Requests.Cpu().String(),
+					Memory: container.Resources.Requests.Memory().String(),
+				},
+				Limits: k8sModels.ResourceList{
+					CPU:    container.Resources.Limits.Cpu().String(),
+					Memory: container.Resources.Limits.Memory().String(),
+				},
+			}
+		}
+
 		containers = append(containers, containerModel)
 	}
 
@@ -400,6 +613,7 @@ func (r *PodsRepository) convertPod(pod *corev1.Pod) *k8sModels.Pod {
 	return &k8sModels.Pod{
 		Name:       pod.Name,
 		Namespace:  pod.Namespace,
+		Labels:     pod.Labels,
 		Status:     status,
 		Phase:      phase,
 		Node:       pod.Spec.NodeName,
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// QuotasRepository handles resource quota and limit range data access
+type QuotasRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewQuotasRepository creates a new quotas repository
+func NewQuotasRepository(registry *kubernetes.ClientRegistry) *QuotasRepository {
+	return &QuotasRepository{
+		registry: registry,
+	}
+}
+
+// ListResourceQuotas lists resource quotas of a namespace, or of every namespace when it is empty
+func (r *QuotasRepository) ListResourceQuotas(ctx context.Context, context, namespace string) ([]k8sModels.ResourceQuota, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	quotaList, err := client.ListResourceQuotas(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	quotas := make([]k8sModels.ResourceQuota, 0, len(quotaList.Items))
+	for i := range quotaList.Items {
+		quotas = append(quotas, r.convertResourceQuota(&quotaList.Items[i]))
+	}
+	return quotas, nil
+}
+
+// ListLimitRanges lists limit ranges of a namespace, or of every namespace when it is empty
+func (r *QuotasRepository) ListLimitRanges(ctx context.Context, context, namespace string) ([]k8sModels.LimitRange, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	limitRangeList, err := client.ListLimitRanges(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit ranges: %w", err)
+	}
+
+	limitRanges := make([]k8sModels.LimitRange, 0, len(limitRangeList.Items))
+	for i := range limitRangeList.Items {
+		limitRanges = append(limitRanges, r.convertLimitRange(&limitRangeList.Items[i]))
+	}
+	return limitRanges, nil
+}
+
+// convertResourceQuota converts a Kubernetes resource quota to our domain model.
+// Utilization is left to the resource calculator.
+func (r *QuotasRepository) convertResourceQuota(quota *corev1.ResourceQuota) k8sModels.ResourceQuota {
+	names := make([]string, 0, len(quota.Status.Hard))
+	for name := range quota.Status.Hard {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	resources := make([]k8sModels.QuotaResource, 0, len(names))
+	for _, name := range names {
+		hard := quota.Status.Hard[corev1.ResourceName(name)]
+		used := quota.Status.Used[corev1.ResourceName(name)]
+		resources = append(resources, k8sModels.QuotaResource{
+			Name: name,
+			Hard: hard.String(),
+			Used: used.String(),
+		})
+	}
+
+	scopes := make([]string, 0, len(quota.Spec.Scopes))
+	for _, scope := range quota.Spec.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return k8sModels.ResourceQuota{
+		Name:      quota.Name,
+		Namespace: quota.Namespace,
+		Scopes:    scopes,
+		Resources: resources,
+	}
+}
+
+// convertLimitRange converts a Kubernetes limit range to our domain model
+func (r *QuotasRepository) convertLimitRange(limitRange *corev1.LimitRange) k8sModels.LimitRange {
+	limits := make([]k8sModels.LimitRangeItem, 0, len(limitRange.Spec.Limits))
+	for _, item := range limitRange.Spec.Limits {
+		limits = append(limits, k8sModels.LimitRangeItem{
+			Type:                 string(item.Type),
+			Max:                  convertResourceQuantities(item.Max),
+			Min:                  convertResourceQuantities(item.Min),
+			Default:              convertResourceQuantities(item.Default),
+			DefaultRequest:       convertResourceQuantities(item.DefaultRequest),
+			MaxLimitRequestRatio: convertResourceQuantities(item.MaxLimitRequestRatio),
+		})
+	}
+
+	return k8sModels.LimitRange{
+		Name:      limitRange.Name,
+		Namespace: limitRange.Namespace,
+		Limits:    limits,
+	}
+}
+
+// convertResourceQuantities formats a resource list as strings keyed by resource name
+func convertResourceQuantities(list corev1.ResourceList) map[string]string {
+	if len(list) == 0 {
+		return nil
+	}
+	quantities := make(map[string]string, len(list))
+	for name, quantity := range list {
+		quantities[string(name)] = quantity.String()
+	}
+	return quantities
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// ServicesRepository handles service data access, including endpoint readiness from EndpointSlices
+type ServicesRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewServicesRepository creates a new services repository
+func NewServicesRepository(registry *kubernetes.ClientRegistry) *ServicesRepository {
+	return &ServicesRepository{
+		registry: registry,
+	}
+}
+
+// GetService gets a specific service with its endpoints
+func (r *ServicesRepository) GetService(ctx context.Context, context, namespace, name string) (*k8sModels.Service, error) {
+	if name == "" {
+		return nil, fmt.Errorf("service name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := client.GetService(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s/%s: %w", namespace, name, err)
+	}
+
+	sliceList, err := client.ListEndpointSlices(ctx, namespace, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoints of service %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertService(service, sliceList.Items), nil
+}
+
+// ListServices lists services with their endpoints, across all namespaces when the filter has none
+func (r *ServicesRepository) ListServices(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.Service, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	serviceList, err := client.ListServices(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list services: %w", err)
+	}
+
+	// One slice listing covers every service in scope
+	sliceList, err := client.ListEndpointSlices(ctx, namespace, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list endpoint slices: %w", err)
+	}
+
+	slicesByService := make(map[string][]discoveryv1.EndpointSlice)
+	for _, slice := range sliceList.Items {
+		key := slice.Namespace + "/" + slice.Labels[discoveryv1.LabelServiceName]
+		slicesByService[key] = append(slicesByService[key], slice)
+	}
+
+	services := make([]k8sModels.Service, 0, len(serviceList.Items))
+	for i := range serviceList.Items {
+		service := &serviceList.Items[i]
+		slices := slicesByService[service.Namespace+"/"+service.Name]
+		services = append(services, *r.convertService(service, slices))
+	}
+
+	return services, nil
+}
+
+// convertService converts a Kubernetes service and its endpoint slices to our domain model
+func (r *ServicesRepository) convertService(service *corev1.Service, slices []discoveryv1.EndpointSlice) *k8sModels.Service {
+	ports := make([]k8sModels.ServicePort, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		ports = append(ports, k8sModels.ServicePort{
+			Name:       port.Name,
+			Protocol:   string(port.Protocol),
+			Port:       port.Port,
+			TargetPort: port.TargetPort.String(),
+			NodePort:   port.NodePort,
+		})
+	}
+
+	externalIPs := append([]string{}, service.Spec.ExternalIPs...)
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		if ingress.Hostname != "" {
+			externalIPs = append(externalIPs, ingress.Hostname)
+		} else if ingress.IP != "" {
+			externalIPs = append(externalIPs, ingress.IP)
+		}
+	}
+
+	return &k8sModels.Service{
+		Name:        service.Name,
+		Namespace:   service.Namespace,
+		Type:        string(service.Spec.Type),
+		ClusterIP:   service.Spec.ClusterIP,
+		ExternalIPs: externalIPs,
+		Ports:       ports,
+		Selector:    service.Spec.Selector,
+		Endpoints:   r.convertEndpoints(slices),
+		Age:         time.Since(service.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt:   service.CreationTimestamp.Time,
+	}
+}
+
+// convertEndpoints summarizes endpoint readiness across a service's endpoint slices
+func (r *ServicesRepository) convertEndpoints(slices []discoveryv1.EndpointSlice) k8sModels.ServiceEndpoints {
+	endpoints := k8sModels.ServiceEndpoints{
+		Addresses: make([]k8sModels.EndpointAddress, 0),
+	}
+
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means the endpoint should be considered ready
+			ready := endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready
+
+			address := k8sModels.EndpointAddress{Ready: ready}
+			if len(endpoint.Addresses) > 0 {
+				address.IP = endpoint.Addresses[0]
+			}
+			if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+				address.PodName = endpoint.TargetRef.Name
+			}
+			if endpoint.NodeName != nil {
+				address.NodeName = *endpoint.NodeName
+			}
+
+			if ready {
+				endpoints.Ready++
+			} else {
+				endpoints.NotReady++
+			}
+			endpoints.Addresses = append(endpoints.Addresses, address)
+		}
+	}
+
+	return endpoints
+}
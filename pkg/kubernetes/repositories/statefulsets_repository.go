@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/dash-ops/dash-ops/pkg/kubernetes/integrations/external/kubernetes"
+	k8sModels "github.com/dash-ops/dash-ops/pkg/kubernetes/models"
+)
+
+// StatefulSetsRepository handles stateful set data access
+type StatefulSetsRepository struct {
+	registry *kubernetes.ClientRegistry
+}
+
+// NewStatefulSetsRepository creates a new stateful sets repository
+func NewStatefulSetsRepository(registry *kubernetes.ClientRegistry) *StatefulSetsRepository {
+	return &StatefulSetsRepository{
+		registry: registry,
+	}
+}
+
+// GetStatefulSet gets a specific stateful set
+func (r *StatefulSetsRepository) GetStatefulSet(ctx context.Context, context, namespace, name string) (*k8sModels.StatefulSet, error) {
+	if name == "" {
+		return nil, fmt.Errorf("stateful set name is required")
+	}
+	if namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	statefulSet, err := client.GetStatefulSet(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stateful set %s/%s: %w", namespace, name, err)
+	}
+
+	return r.convertStatefulSet(statefulSet), nil
+}
+
+// ListStatefulSets lists stateful sets, across all namespaces when the filter has none
+func (r *StatefulSetsRepository) ListStatefulSets(ctx context.Context, context string, filter *k8sModels.WorkloadFilter) ([]k8sModels.StatefulSet, error) {
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, listOptions := workloadListOptions(filter)
+	statefulSetList, err := client.ListStatefulSets(ctx, namespace, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stateful sets: %w", err)
+	}
+
+	statefulSets := make([]k8sModels.StatefulSet, 0, len(statefulSetList.Items))
+	for i := range statefulSetList.Items {
+		statefulSets = append(statefulSets, *r.convertStatefulSet(&statefulSetList.Items[i]))
+	}
+
+	return statefulSets, nil
+}
+
+// ScaleStatefulSet scales a stateful set to specified replicas
+func (r *StatefulSetsRepository) ScaleStatefulSet(ctx context.Context, context, namespace, name string, replicas int32) error {
+	if name == "" {
+		return fmt.Errorf("stateful set name is required")
+	}
+	if namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if replicas < 0 {
+		return fmt.Errorf("replicas must be non-negative")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	if err := client.ScaleStatefulSet(ctx, namespace, name, replicas); err != nil {
+		return fmt.Errorf("failed to scale stateful set %s/%s to %d replicas: %w", namespace, name, replicas, err)
+	}
+
+	return nil
+}
+
+// RestartStatefulSet restarts a stateful set
+func (r *StatefulSetsRepository) RestartStatefulSet(ctx context.Context, context, namespace, name string) error {
+	if name == "" {
+		return fmt.Errorf("stateful set name is required")
+	}
+	if namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+
+	client, err := r.registry.GetClient(context)
+	if err != nil {
+		return err
+	}
+
+	if err := client.RestartStatefulSet(ctx, namespace, name); err != nil {
+		return fmt.Errorf("failed to restart stateful set %s/%s: %w", namespace, name, err)
+	}
+
+	return nil
+}
+
+// convertStatefulSet converts a Kubernetes stateful set to our domain model
+func (r *StatefulSetsRepository) convertStatefulSet(statefulSet *appsv1.StatefulSet) *k8sModels.StatefulSet {
+	var desired int32 = 1
+	if statefulSet.Spec.Replicas != nil {
+		desired = *statefulSet.Spec.Replicas
+	}
+
+	return &k8sModels.StatefulSet{
+		Name:      statefulSet.Name,
+		Namespace: statefulSet.Namespace,
+		Replicas: k8sModels.DeploymentReplicas{
+			Desired:   desired,
+			Current:   statefulSet.Status.CurrentReplicas,
+			Ready:     statefulSet.Status.ReadyReplicas,
+			Available: statefulSet.Status.AvailableReplicas,
+			Updated:   statefulSet.Status.UpdatedReplicas,
+		},
+		ServiceName:    statefulSet.Spec.ServiceName,
+		UpdateStrategy: string(statefulSet.Spec.UpdateStrategy.Type),
+		Images:         podTemplateImages(&statefulSet.Spec.Template),
+		Age:            time.Since(statefulSet.CreationTimestamp.Time).Round(time.Second).String(),
+		CreatedAt:      statefulSet.CreationTimestamp.Time,
+	}
+}
+
+// workloadListOptions builds the namespace and list options for a workload filter
+func workloadListOptions(filter *k8sModels.WorkloadFilter) (string, metav1.ListOptions) {
+	if filter == nil {
+		return metav1.NamespaceAll, metav1.ListOptions{}
+	}
+	return filter.Namespace, metav1.ListOptions{LabelSelector: filter.LabelSelector}
+}
@@ -1,8 +1,55 @@
 package kubernetes
 
-// ScaleDeploymentRequest represents deployment scaling request
+// ScaleDeploymentRequest represents deployment scaling request.
+// Force scales a deployment even though its autoscaler will override the change.
 type ScaleDeploymentRequest struct {
 	Replicas int32 `json:"replicas" validate:"min=0,max=100"`
+	Force    bool  `json:"force,omitempty"`
+}
+
+// SetAutoscalerBoundsRequest represents autoscaler min/max replicas request
+type SetAutoscalerBoundsRequest struct {
+	MinReplicas int32 `json:"min_replicas" validate:"min=1"`
+	MaxReplicas int32 `json:"max_replicas" validate:"min=1,max=1000"`
+}
+
+// RollbackDeploymentRequest represents deployment rollback request; revision 0 means the previous revision
+type RollbackDeploymentRequest struct {
+	Revision int64 `json:"revision" validate:"min=0"`
+}
+
+// ApplyPreviewRequest represents a dry-run request for either a manifest or a deployment change
+type ApplyPreviewRequest struct {
+	Manifest   string                   `json:"manifest,omitempty"`
+	Deployment *DeploymentChangeRequest `json:"deployment,omitempty"`
+}
+
+// DeploymentChangeRequest represents image, env or resources edits to an existing deployment
+type DeploymentChangeRequest struct {
+	Name       string                   `json:"name" validate:"required"`
+	Containers []ContainerChangeRequest `json:"containers" validate:"required,min=1"`
+}
+
+// ContainerChangeRequest represents edits to a single container; omitted fields are left untouched
+type ContainerChangeRequest struct {
+	Name     string            `json:"name" validate:"required"`
+	Image    string            `json:"image,omitempty"`
+	Env      map[string]string `json:"env,omitempty"`
+	Requests map[string]string `json:"requests,omitempty"`
+	Limits   map[string]string `json:"limits,omitempty"`
+}
+
+// ApplyConfirmRequest represents the confirmation of a previewed change
+type ApplyConfirmRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// DrainNodeRequest represents node drain request; a zero timeout uses the default of five minutes
+type DrainNodeRequest struct {
+	GracePeriodSeconds *int64 `json:"grace_period_seconds,omitempty" validate:"omitempty,min=0"`
+	TimeoutSeconds     int64  `json:"timeout_seconds,omitempty" validate:"min=0,max=3600"`
+	DeleteEmptyDirData bool   `json:"delete_emptydir_data,omitempty"`
+	Force              bool   `json:"force,omitempty"`
 }
 
 // CreateNamespaceRequest represents namespace creation request
@@ -62,3 +109,23 @@ type ResourceTarget struct {
 	Namespace string `json:"namespace" validate:"required"`
 	Name      string `json:"name" validate:"required"`
 }
+
+// Exec WebSocket message types
+const (
+	ExecMessageStdin  = "stdin"
+	ExecMessageResize = "resize"
+	ExecMessageStdout = "stdout"
+	ExecMessageStderr = "stderr"
+	ExecMessageExit   = "exit"
+)
+
+// ExecMessage represents a message exchanged over the exec WebSocket.
+// Clients send stdin and resize messages; the server sends stdout, stderr and exit.
+type ExecMessage struct {
+	Type  string `json:"type"`
+	Data  string `json:"data,omitempty"`
+	Cols  uint16 `json:"cols,omitempty"`
+	Rows  uint16 `json:"rows,omitempty"`
+	Code  int    `json:"code,omitempty"`
+	Error string `json:"error,omitempty"`
+}
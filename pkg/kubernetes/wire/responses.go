@@ -4,11 +4,21 @@ import "time"
 
 // ClusterResponse represents cluster information response
 type ClusterResponse struct {
-	Name    string `json:"name"`
-	Context string `json:"context"`
-	Server  string `json:"server,omitempty"`
-	Version string `json:"version,omitempty"`
-	Status  string `json:"status"`
+	Name          string                `json:"name"`
+	Context       string                `json:"context"`
+	Server        string                `json:"server,omitempty"`
+	Version       string                `json:"version,omitempty"`
+	Status        string                `json:"status"`
+	AuthMode      string                `json:"auth_mode,omitempty"`
+	Impersonation bool                  `json:"impersonation,omitempty"`
+	Error         string                `json:"error,omitempty"`
+	Cache         *ClusterCacheResponse `json:"cache,omitempty"`
+}
+
+// ClusterCacheResponse represents informer cache status response
+type ClusterCacheResponse struct {
+	Synced   bool       `json:"synced"`
+	SyncedAt *time.Time `json:"synced_at,omitempty"`
 }
 
 // ClusterInfoResponse represents comprehensive cluster information response
@@ -22,26 +32,28 @@ type ClusterInfoResponse struct {
 
 // ClusterSummaryResponse represents cluster summary response
 type ClusterSummaryResponse struct {
-	TotalNodes       int `json:"total_nodes"`
-	ReadyNodes       int `json:"ready_nodes"`
-	TotalNamespaces  int `json:"total_namespaces"`
-	TotalDeployments int `json:"total_deployments"`
-	TotalPods        int `json:"total_pods"`
-	RunningPods      int `json:"running_pods"`
+	TotalNodes       int                   `json:"total_nodes"`
+	ReadyNodes       int                   `json:"ready_nodes"`
+	TotalNamespaces  int                   `json:"total_namespaces"`
+	TotalDeployments int                   `json:"total_deployments"`
+	TotalPods        int                   `json:"total_pods"`
+	RunningPods      int                   `json:"running_pods"`
+	Resources        NodeResourcesResponse `json:"resources"`
 }
 
 // NodeResponse represents node information response
 type NodeResponse struct {
-	Name       string                  `json:"name"`
-	Status     string                  `json:"status"`
-	Roles      []string                `json:"roles"`
-	Age        string                  `json:"age"`
-	Version    string                  `json:"version"`
-	InternalIP string                  `json:"internal_ip"`
-	ExternalIP string                  `json:"external_ip,omitempty"`
-	Conditions []NodeConditionResponse `json:"conditions"`
-	Resources  NodeResourcesResponse   `json:"resources"`
-	CreatedAt  time.Time               `json:"created_at"`
+	Name          string                  `json:"name"`
+	Status        string                  `json:"status"`
+	Roles         []string                `json:"roles"`
+	Age           string                  `json:"age"`
+	Version       string                  `json:"version"`
+	Unschedulable bool                    `json:"unschedulable"`
+	InternalIP    string                  `json:"internal_ip"`
+	ExternalIP    string                  `json:"external_ip,omitempty"`
+	Conditions    []NodeConditionResponse `json:"conditions"`
+	Resources     NodeResourcesResponse   `json:"resources"`
+	CreatedAt     time.Time               `json:"created_at"`
 }
 
 // NodeConditionResponse represents node condition response
@@ -55,9 +67,11 @@ type NodeConditionResponse struct {
 
 // NodeResourcesResponse represents node resources response
 type NodeResourcesResponse struct {
-	Capacity    ResourceListResponse `json:"capacity"`
-	Allocatable ResourceListResponse `json:"allocatable"`
-	Used        ResourceListResponse `json:"used,omitempty"`
+	Capacity    ResourceListResponse  `json:"capacity"`
+	Allocatable ResourceListResponse  `json:"allocatable"`
+	Used        ResourceListResponse  `json:"used,omitempty"`
+	Limits      ResourceListResponse  `json:"limits,omitempty"`
+	Usage       *ResourceListResponse `json:"usage,omitempty"`
 }
 
 // ResourceListResponse represents resource list response
@@ -69,11 +83,65 @@ type ResourceListResponse struct {
 
 // NamespaceResponse represents namespace information response
 type NamespaceResponse struct {
-	Name      string            `json:"name"`
-	Status    string            `json:"status"`
-	Labels    map[string]string `json:"labels,omitempty"`
-	Age       string            `json:"age"`
-	CreatedAt time.Time         `json:"created_at"`
+	Name           string                  `json:"name"`
+	Status         string                  `json:"status"`
+	Labels         map[string]string       `json:"labels,omitempty"`
+	Age            string                  `json:"age"`
+	CreatedAt      time.Time               `json:"created_at"`
+	ResourceQuotas []ResourceQuotaResponse `json:"resource_quotas,omitempty"`
+	LimitRanges    []LimitRangeResponse    `json:"limit_ranges,omitempty"`
+}
+
+// ResourceQuotaResponse represents resource quota response
+type ResourceQuotaResponse struct {
+	Name      string                  `json:"name"`
+	Namespace string                  `json:"namespace"`
+	Scopes    []string                `json:"scopes,omitempty"`
+	Resources []QuotaResourceResponse `json:"resources"`
+}
+
+// QuotaResourceResponse represents the hard limit and usage of a quota resource
+type QuotaResourceResponse struct {
+	Name               string  `json:"name"`
+	Hard               string  `json:"hard"`
+	Used               string  `json:"used"`
+	UtilizationPercent float64 `json:"utilization_percent"`
+	Status             string  `json:"status"` // healthy, warning, critical, unknown
+}
+
+// LimitRangeResponse represents limit range response
+type LimitRangeResponse struct {
+	Name      string                   `json:"name"`
+	Namespace string                   `json:"namespace"`
+	Limits    []LimitRangeItemResponse `json:"limits"`
+}
+
+// LimitRangeItemResponse represents the constraints of a limit range for one kind of object
+type LimitRangeItemResponse struct {
+	Type                 string            `json:"type"`
+	Max                  map[string]string `json:"max,omitempty"`
+	Min                  map[string]string `json:"min,omitempty"`
+	Default              map[string]string `json:"default,omitempty"`
+	DefaultRequest       map[string]string `json:"default_request,omitempty"`
+	MaxLimitRequestRatio map[string]string `json:"max_limit_request_ratio,omitempty"`
+}
+
+// NamespaceQuotaUsageResponse represents the quota utilization of a namespace
+type NamespaceQuotaUsageResponse struct {
+	Namespace          string                  `json:"namespace"`
+	Quota              string                  `json:"quota"`
+	Resource           string                  `json:"resource"`
+	UtilizationPercent float64                 `json:"utilization_percent"`
+	Status             string                  `json:"status"`
+	Quotas             []ResourceQuotaResponse `json:"quotas"`
+}
+
+// QuotaRankingResponse represents namespaces ranked by quota utilization, most utilized first
+type QuotaRankingResponse struct {
+	Namespaces []NamespaceQuotaUsageResponse `json:"namespaces"`
+	Total      int                           `json:"total"`
+	Critical   int                           `json:"critical"`
+	Warning    int                           `json:"warning"`
 }
 
 // DeploymentResponse represents deployment information response
@@ -88,6 +156,37 @@ type DeploymentResponse struct {
 	ServiceContext      *ServiceContextResponse       `json:"service_context,omitempty"`
 	HealthStatus        string                        `json:"health_status,omitempty"`
 	AvailabilityPercent float64                       `json:"availability_percent"`
+	RecentEvents        []EventResponse               `json:"recent_events,omitempty"`
+	Services            []ServiceReferenceResponse    `json:"services,omitempty"`
+	Autoscaler          *AutoscalerResponse           `json:"autoscaler,omitempty"`
+}
+
+// AutoscalerResponse represents horizontal pod autoscaler response
+type AutoscalerResponse struct {
+	Name            string                        `json:"name"`
+	MinReplicas     int32                         `json:"min_replicas"`
+	MaxReplicas     int32                         `json:"max_replicas"`
+	CurrentReplicas int32                         `json:"current_replicas"`
+	DesiredReplicas int32                         `json:"desired_replicas"`
+	Metrics         []AutoscalerMetricResponse    `json:"metrics"`
+	Conditions      []AutoscalerConditionResponse `json:"conditions,omitempty"`
+	LastScaleTime   *time.Time                    `json:"last_scale_time,omitempty"`
+}
+
+// AutoscalerMetricResponse represents an autoscaler metric response
+type AutoscalerMetricResponse struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Target  string `json:"target"`
+	Current string `json:"current,omitempty"`
+}
+
+// AutoscalerConditionResponse represents an autoscaler condition response
+type AutoscalerConditionResponse struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
 }
 
 // PodInfoResponse represents pod information response
@@ -104,6 +203,251 @@ type DeploymentReplicasResponse struct {
 	Current   int32 `json:"current"`
 	Ready     int32 `json:"ready"`
 	Available int32 `json:"available"`
+	Updated   int32 `json:"updated"`
+}
+
+// DeploymentRevisionResponse represents a revision in a deployment's rollout history
+type DeploymentRevisionResponse struct {
+	Revision    int64     `json:"revision"`
+	ReplicaSet  string    `json:"replica_set"`
+	ChangeCause string    `json:"change_cause,omitempty"`
+	Images      []string  `json:"images"`
+	Replicas    int32     `json:"replicas"`
+	Ready       int32     `json:"ready"`
+	CreatedAt   time.Time `json:"created_at"`
+	Current     bool      `json:"current"`
+}
+
+// RolloutHistoryResponse represents deployment rollout history response
+type RolloutHistoryResponse struct {
+	Name      string                       `json:"name"`
+	Namespace string                       `json:"namespace"`
+	Revisions []DeploymentRevisionResponse `json:"revisions"`
+}
+
+// RolloutStatusResponse represents deployment rollout status response
+type RolloutStatusResponse struct {
+	Name      string                     `json:"name"`
+	Namespace string                     `json:"namespace"`
+	State     string                     `json:"state"`
+	Message   string                     `json:"message"`
+	Revision  int64                      `json:"revision"`
+	Replicas  DeploymentReplicasResponse `json:"replicas"`
+}
+
+// StatefulSetResponse represents stateful set information response
+type StatefulSetResponse struct {
+	Name           string                     `json:"name"`
+	Namespace      string                     `json:"namespace"`
+	Replicas       DeploymentReplicasResponse `json:"replicas"`
+	ServiceName    string                     `json:"service_name,omitempty"`
+	UpdateStrategy string                     `json:"update_strategy"`
+	Images         []string                   `json:"images"`
+	Age            string                     `json:"age"`
+	CreatedAt      time.Time                  `json:"created_at"`
+	HealthStatus   string                     `json:"health_status"`
+}
+
+// StatefulSetListResponse represents stateful set list response
+type StatefulSetListResponse struct {
+	StatefulSets []StatefulSetResponse `json:"stateful_sets"`
+	Total        int                   `json:"total"`
+	Namespace    string                `json:"namespace,omitempty"`
+}
+
+// DaemonSetResponse represents daemon set information response
+type DaemonSetResponse struct {
+	Name           string                      `json:"name"`
+	Namespace      string                      `json:"namespace"`
+	Scheduling     DaemonSetSchedulingResponse `json:"scheduling"`
+	NodeSelector   map[string]string           `json:"node_selector,omitempty"`
+	UpdateStrategy string                      `json:"update_strategy"`
+	Images         []string                    `json:"images"`
+	Age            string                      `json:"age"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	HealthStatus   string                      `json:"health_status"`
+}
+
+// DaemonSetSchedulingResponse represents daemon set scheduling response
+type DaemonSetSchedulingResponse struct {
+	Desired      int32 `json:"desired"`
+	Current      int32 `json:"current"`
+	Ready        int32 `json:"ready"`
+	Available    int32 `json:"available"`
+	Updated      int32 `json:"updated"`
+	Misscheduled int32 `json:"misscheduled"`
+}
+
+// DaemonSetListResponse represents daemon set list response
+type DaemonSetListResponse struct {
+	DaemonSets []DaemonSetResponse `json:"daemon_sets"`
+	Total      int                 `json:"total"`
+	Namespace  string              `json:"namespace,omitempty"`
+}
+
+// JobResponse represents job information response
+type JobResponse struct {
+	Name           string     `json:"name"`
+	Namespace      string     `json:"namespace"`
+	Status         string     `json:"status"`
+	Completions    int32      `json:"completions"`
+	Parallelism    int32      `json:"parallelism"`
+	Active         int32      `json:"active"`
+	Succeeded      int32      `json:"succeeded"`
+	Failed         int32      `json:"failed"`
+	StartTime      *time.Time `json:"start_time,omitempty"`
+	CompletionTime *time.Time `json:"completion_time,omitempty"`
+	Duration       string     `json:"duration,omitempty"`
+	CronJob        string     `json:"cron_job,omitempty"`
+	Images         []string   `json:"images"`
+	Age            string     `json:"age"`
+	CreatedAt      time.Time  `json:"created_at"`
+	HealthStatus   string     `json:"health_status"`
+}
+
+// JobListResponse represents job list response
+type JobListResponse struct {
+	Jobs      []JobResponse `json:"jobs"`
+	Total     int           `json:"total"`
+	Namespace string        `json:"namespace,omitempty"`
+}
+
+// CronJobResponse represents cron job information response
+type CronJobResponse struct {
+	Name               string     `json:"name"`
+	Namespace          string     `json:"namespace"`
+	Schedule           string     `json:"schedule"`
+	TimeZone           string     `json:"time_zone,omitempty"`
+	Suspended          bool       `json:"suspended"`
+	ConcurrencyPolicy  string     `json:"concurrency_policy"`
+	ActiveJobs         []string   `json:"active_jobs"`
+	LastScheduleTime   *time.Time `json:"last_schedule_time,omitempty"`
+	LastSuccessfulTime *time.Time `json:"last_successful_time,omitempty"`
+	LastJobStatus      string     `json:"last_job_status,omitempty"`
+	Images             []string   `json:"images"`
+	Age                string     `json:"age"`
+	CreatedAt          time.Time  `json:"created_at"`
+	HealthStatus       string     `json:"health_status"`
+}
+
+// CronJobListResponse represents cron job list response
+type CronJobListResponse struct {
+	CronJobs  []CronJobResponse `json:"cron_jobs"`
+	Total     int               `json:"total"`
+	Namespace string            `json:"namespace,omitempty"`
+}
+
+// ServiceResponse represents service information response
+type ServiceResponse struct {
+	Name        string                   `json:"name"`
+	Namespace   string                   `json:"namespace"`
+	Type        string                   `json:"type"`
+	ClusterIP   string                   `json:"cluster_ip,omitempty"`
+	ExternalIPs []string                 `json:"external_ips,omitempty"`
+	Ports       []ServicePortResponse    `json:"ports"`
+	Selector    map[string]string        `json:"selector,omitempty"`
+	Endpoints   ServiceEndpointsResponse `json:"endpoints"`
+	Healthy     bool                     `json:"healthy"`
+	Age         string                   `json:"age"`
+	CreatedAt   time.Time                `json:"created_at"`
+}
+
+// ServicePortResponse represents service port response
+type ServicePortResponse struct {
+	Name       string `json:"name,omitempty"`
+	Protocol   string `json:"protocol"`
+	Port       int32  `json:"port"`
+	TargetPort string `json:"target_port"`
+	NodePort   int32  `json:"node_port,omitempty"`
+}
+
+// ServiceEndpointsResponse represents service endpoints readiness response
+type ServiceEndpointsResponse struct {
+	Ready     int                       `json:"ready"`
+	NotReady  int                       `json:"not_ready"`
+	Addresses []EndpointAddressResponse `json:"addresses"`
+}
+
+// EndpointAddressResponse represents endpoint address response
+type EndpointAddressResponse struct {
+	IP       string `json:"ip"`
+	PodName  string `json:"pod_name,omitempty"`
+	NodeName string `json:"node_name,omitempty"`
+	Ready    bool   `json:"ready"`
+}
+
+// ServiceListResponse represents service list response
+type ServiceListResponse struct {
+	Services  []ServiceResponse `json:"services"`
+	Total     int               `json:"total"`
+	Namespace string            `json:"namespace,omitempty"`
+}
+
+// ServiceReferenceResponse represents a service linked from a deployment
+type ServiceReferenceResponse struct {
+	Name           string                `json:"name"`
+	Type           string                `json:"type"`
+	ClusterIP      string                `json:"cluster_ip,omitempty"`
+	Ports          []ServicePortResponse `json:"ports"`
+	ReadyEndpoints int                   `json:"ready_endpoints"`
+}
+
+// IngressResponse represents ingress information response
+type IngressResponse struct {
+	Name         string                `json:"name"`
+	Namespace    string                `json:"namespace"`
+	ClassName    string                `json:"class_name,omitempty"`
+	Rules        []IngressRuleResponse `json:"rules"`
+	TLS          []IngressTLSResponse  `json:"tls,omitempty"`
+	LoadBalancer []string              `json:"load_balancer,omitempty"`
+	Age          string                `json:"age"`
+	CreatedAt    time.Time             `json:"created_at"`
+}
+
+// IngressRuleResponse represents ingress rule response
+type IngressRuleResponse struct {
+	Host  string                `json:"host,omitempty"`
+	Paths []IngressPathResponse `json:"paths"`
+}
+
+// IngressPathResponse represents ingress path response
+type IngressPathResponse struct {
+	Path        string `json:"path"`
+	PathType    string `json:"path_type,omitempty"`
+	ServiceName string `json:"service_name,omitempty"`
+	ServicePort string `json:"service_port,omitempty"`
+}
+
+// IngressTLSResponse represents ingress TLS response
+type IngressTLSResponse struct {
+	Hosts      []string `json:"hosts"`
+	SecretName string   `json:"secret_name,omitempty"`
+}
+
+// IngressListResponse represents ingress list response
+type IngressListResponse struct {
+	Ingresses []IngressResponse `json:"ingresses"`
+	Total     int               `json:"total"`
+	Namespace string            `json:"namespace,omitempty"`
+}
+
+// ConfigMapResponse represents config map information response
+type ConfigMapResponse struct {
+	Name       string            `json:"name"`
+	Namespace  string            `json:"namespace"`
+	Keys       []string          `json:"keys"`
+	BinaryKeys []string          `json:"binary_keys,omitempty"`
+	Data       map[string]string `json:"data,omitempty"`
+	Immutable  bool              `json:"immutable"`
+	Age        string            `json:"age"`
+	CreatedAt  time.Time         `json:"created_at"`
+}
+
+// ConfigMapListResponse represents config map list response
+type ConfigMapListResponse struct {
+	ConfigMaps []ConfigMapResponse `json:"config_maps"`
+	Total      int                 `json:"total"`
+	Namespace  string              `json:"namespace,omitempty"`
 }
 
 // DeploymentConditionResponse represents deployment condition response
@@ -128,18 +472,20 @@ type ServiceContextResponse struct {
 
 // PodResponse represents pod information response
 type PodResponse struct {
-	Name       string                 `json:"name"`
-	Namespace  string                 `json:"namespace"`
-	Status     string                 `json:"status"`
-	Phase      string                 `json:"phase"`
-	Node       string                 `json:"node"`
-	Age        string                 `json:"age"`
-	Restarts   int32                  `json:"restarts"`
-	Ready      string                 `json:"ready"`
-	IP         string                 `json:"ip,omitempty"`
-	Containers []ContainerResponse    `json:"containers"`
-	Conditions []PodConditionResponse `json:"conditions"`
-	CreatedAt  time.Time              `json:"created_at"`
+	Name         string                 `json:"name"`
+	Namespace    string                 `json:"namespace"`
+	Status       string                 `json:"status"`
+	Phase        string                 `json:"phase"`
+	Node         string                 `json:"node"`
+	Age          string                 `json:"age"`
+	Restarts     int32                  `json:"restarts"`
+	Ready        string                 `json:"ready"`
+	IP           string                 `json:"ip,omitempty"`
+	Containers   []ContainerResponse    `json:"containers"`
+	Conditions   []PodConditionResponse `json:"conditions"`
+	CreatedAt    time.Time              `json:"created_at"`
+	Resources    *ResourceUsageResponse `json:"resources,omitempty"`
+	RecentEvents []EventResponse        `json:"recent_events,omitempty"`
 }
 
 // ContainerResponse represents container information response
@@ -150,6 +496,7 @@ type ContainerResponse struct {
 	RestartCount int32                      `json:"restart_count"`
 	State        ContainerStateResponse     `json:"state"`
 	Resources    ContainerResourcesResponse `json:"resources,omitempty"`
+	Usage        *ResourceListResponse      `json:"usage,omitempty"`
 }
 
 // ContainerStateResponse represents container state response
@@ -185,6 +532,17 @@ type ContainerResourcesResponse struct {
 	Limits   ResourceListResponse `json:"limits,omitempty"`
 }
 
+// ResourceUsageResponse represents usage compared with requests and limits response
+type ResourceUsageResponse struct {
+	Requests             ResourceListResponse  `json:"requests"`
+	Limits               ResourceListResponse  `json:"limits"`
+	Usage                *ResourceListResponse `json:"usage,omitempty"`
+	CPURequestPercent    float64               `json:"cpu_request_percent,omitempty"`
+	CPULimitPercent      float64               `json:"cpu_limit_percent,omitempty"`
+	MemoryRequestPercent float64               `json:"memory_request_percent,omitempty"`
+	MemoryLimitPercent   float64               `json:"memory_limit_percent,omitempty"`
+}
+
 // PodConditionResponse represents pod condition response
 type PodConditionResponse struct {
 	Type               string    `json:"type"`
@@ -227,12 +585,187 @@ type ContainerLogResponse struct {
 	Level     string    `json:"level,omitempty"`
 }
 
+// MetricsAvailabilityResponse represents metrics-server availability response
+type MetricsAvailabilityResponse struct {
+	Context   string `json:"context"`
+	Available bool   `json:"available"`
+}
+
+// PodMetricsResponse represents pod metrics response
+type PodMetricsResponse struct {
+	PodName     string                     `json:"pod_name"`
+	Namespace   string                     `json:"namespace"`
+	Containers  []ContainerMetricsResponse `json:"containers"`
+	LastUpdated time.Time                  `json:"last_updated"`
+}
+
+// ContainerMetricsResponse represents container metrics response
+type ContainerMetricsResponse struct {
+	Name      string                     `json:"name"`
+	Resources ContainerResourcesResponse `json:"resources"`
+	Usage     ResourceListResponse       `json:"usage"`
+}
+
+// NamespaceMetricsResponse represents namespace metrics response
+type NamespaceMetricsResponse struct {
+	Namespace   string               `json:"namespace"`
+	TotalPods   int                  `json:"total_pods"`
+	RunningPods int                  `json:"running_pods"`
+	Usage       ResourceListResponse `json:"usage"`
+	LastUpdated time.Time            `json:"last_updated"`
+}
+
+// EventResponse represents Kubernetes event response
+type EventResponse struct {
+	Type      string              `json:"type"`
+	Reason    string              `json:"reason"`
+	Message   string              `json:"message"`
+	Object    EventObjectResponse `json:"object"`
+	Source    string              `json:"source,omitempty"`
+	Count     int32               `json:"count"`
+	FirstTime time.Time           `json:"first_time"`
+	LastTime  time.Time           `json:"last_time"`
+}
+
+// EventObjectResponse represents the object an event is about
+type EventObjectResponse struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ExecSessionResponse represents a recorded exec session response
+type ExecSessionResponse struct {
+	ID        string     `json:"id"`
+	Namespace string     `json:"namespace"`
+	Pod       string     `json:"pod"`
+	Container string     `json:"container"`
+	Command   []string   `json:"command"`
+	TTY       bool       `json:"tty"`
+	User      string     `json:"user"`
+	StartedAt time.Time  `json:"started_at"`
+	EndedAt   *time.Time `json:"ended_at,omitempty"`
+	Duration  string     `json:"duration"`
+	ExitCode  int        `json:"exit_code"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// ExecSessionListResponse represents recorded exec sessions response
+type ExecSessionListResponse struct {
+	Sessions  []ExecSessionResponse `json:"sessions"`
+	Total     int                   `json:"total"`
+	Namespace string                `json:"namespace"`
+}
+
+// DrainOperationResponse represents node drain operation response
+type DrainOperationResponse struct {
+	ID                 string                `json:"id"`
+	Node               string                `json:"node"`
+	User               string                `json:"user,omitempty"`
+	Status             string                `json:"status"`
+	Message            string                `json:"message,omitempty"`
+	GracePeriodSeconds *int64                `json:"grace_period_seconds,omitempty"`
+	TimeoutSeconds     int64                 `json:"timeout_seconds"`
+	Progress           DrainProgressResponse `json:"progress"`
+	Pods               []DrainPodResponse    `json:"pods"`
+	StartedAt          time.Time             `json:"started_at"`
+	CompletedAt        *time.Time            `json:"completed_at,omitempty"`
+}
+
+// DrainProgressResponse represents drain progress counters response
+type DrainProgressResponse struct {
+	Total    int `json:"total"`
+	Evicted  int `json:"evicted"`
+	Skipped  int `json:"skipped"`
+	Failed   int `json:"failed"`
+	Inflight int `json:"inflight"`
+}
+
+// DrainPodResponse represents the drain progress of a pod response
+type DrainPodResponse struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+	Attempts  int    `json:"attempts"`
+}
+
+// DrainOperationListResponse represents node drain operations response
+type DrainOperationListResponse struct {
+	Drains []DrainOperationResponse `json:"drains"`
+	Total  int                      `json:"total"`
+	Node   string                   `json:"node"`
+}
+
+// ApplyPlanResponse represents the result of a dry-run apply.
+// Token is empty when the change would not modify the live object.
+type ApplyPlanResponse struct {
+	Token      string                   `json:"token,omitempty"`
+	ExpiresAt  *time.Time               `json:"expires_at,omitempty"`
+	Action     string                   `json:"action"`
+	APIVersion string                   `json:"api_version"`
+	Kind       string                   `json:"kind"`
+	Namespace  string                   `json:"namespace"`
+	Name       string                   `json:"name"`
+	Changes    []ManifestChangeResponse `json:"changes"`
+}
+
+// ManifestChangeResponse represents a single field difference of a dry-run apply
+type ManifestChangeResponse struct {
+	Path      string      `json:"path"`
+	Operation string      `json:"operation"`
+	Before    interface{} `json:"before,omitempty"`
+	After     interface{} `json:"after,omitempty"`
+}
+
+// WorkloadSearchResponse represents workload search results across clusters
+type WorkloadSearchResponse struct {
+	Query    string                         `json:"query"`
+	Total    int                            `json:"total"`
+	Clusters []ClusterSearchResultResponse  `json:"clusters"`
+	Failed   []ClusterSearchFailureResponse `json:"failed"`
+}
+
+// ClusterSearchResultResponse represents the search matches of a cluster
+type ClusterSearchResultResponse struct {
+	Name       string                          `json:"name"`
+	Context    string                          `json:"context"`
+	Truncated  bool                            `json:"truncated"`
+	Namespaces []NamespaceSearchResultResponse `json:"namespaces"`
+}
+
+// NamespaceSearchResultResponse represents the search matches of a namespace
+type NamespaceSearchResultResponse struct {
+	Namespace   string                  `json:"namespace"`
+	Deployments []WorkloadMatchResponse `json:"deployments"`
+	Pods        []WorkloadMatchResponse `json:"pods"`
+}
+
+// WorkloadMatchResponse represents a workload matching a search
+type WorkloadMatchResponse struct {
+	Kind      string   `json:"kind"`
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	MatchedOn string   `json:"matched_on"`
+	Images    []string `json:"images,omitempty"`
+	Status    string   `json:"status,omitempty"`
+	Node      string   `json:"node,omitempty"`
+}
+
+// ClusterSearchFailureResponse represents a cluster that could not be searched
+type ClusterSearchFailureResponse struct {
+	Name    string `json:"name"`
+	Context string `json:"context"`
+	Error   string `json:"error"`
+}
+
 // OperationResponse represents operation result response
 type OperationResponse struct {
 	Success   bool   `json:"success"`
 	Message   string `json:"message"`
 	Operation string `json:"operation"`
 	Resource  string `json:"resource"`
+	Warning   string `json:"warning,omitempty"`
 }
 
 // BatchOperationResponse represents batch operation result response
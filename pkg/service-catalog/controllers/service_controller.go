@@ -46,6 +46,13 @@ func (sc *ServiceController) CreateService(ctx context.Context, service *scModel
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
+	// Central roles also decide who may register services; team ownership only covers existing ones
+	if user.HasRBAC() {
+		if err := sc.validator.ValidateUserPermissions(service, user, "create"); err != nil {
+			return nil, fmt.Errorf("permission denied: %w", err)
+		}
+	}
+
 	// Check if service already exists
 	exists, err := sc.serviceRepo.Exists(ctx, service.Metadata.Name)
 	if err != nil {
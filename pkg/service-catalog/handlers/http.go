@@ -93,6 +93,8 @@ func (h *HTTPHandler) createServiceHandler(w http.ResponseWriter, r *http.Reques
 		// Check if it's a validation error
 		if strings.Contains(err.Error(), "validation failed") {
 			h.responseAdapter.WriteError(w, http.StatusBadRequest, "Validation failed: "+err.Error())
+		} else if strings.Contains(err.Error(), "permission denied") {
+			h.responseAdapter.WriteError(w, http.StatusForbidden, err.Error())
 		} else {
 			h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to create service: "+err.Error())
 		}
@@ -167,6 +169,10 @@ func (h *HTTPHandler) updateServiceHandler(w http.ResponseWriter, r *http.Reques
 	// Call controller
 	updatedService, err := h.controller.UpdateService(r.Context(), service, user)
 	if err != nil {
+		if strings.Contains(err.Error(), "permission denied") {
+			h.responseAdapter.WriteError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to update service: "+err.Error())
 		return
 	}
@@ -196,6 +202,10 @@ func (h *HTTPHandler) deleteServiceHandler(w http.ResponseWriter, r *http.Reques
 	// Call controller
 	err = h.controller.DeleteService(r.Context(), name, user)
 	if err != nil {
+		if strings.Contains(err.Error(), "permission denied") {
+			h.responseAdapter.WriteError(w, http.StatusForbidden, err.Error())
+			return
+		}
 		h.responseAdapter.WriteError(w, http.StatusInternalServerError, "Failed to delete service: "+err.Error())
 		return
 	}
@@ -296,15 +306,34 @@ func (h *HTTPHandler) parseServiceFilter(r *http.Request) *scModels.ServiceFilte
 	return filter
 }
 
-// getUserContext extracts user context from request
+// getUserContext extracts user context from request.
+// Without an authenticated user, e.g. when auth is not configured, a default user is returned.
 func (h *HTTPHandler) getUserContext(r *http.Request) (*scModels.UserContext, error) {
-	// TODO: Implement OAuth2 integration to get user context
-	// For now, return a default user context for testing
+	userData, ok := commonsHttp.UserDataFromRequest(r)
+	if !ok {
+		return &scModels.UserContext{
+			Username: "test-user",
+			Name:     "Test User",
+			Email:    "test@example.com",
+			Teams:    []string{"test-team"},
+		}, nil
+	}
+
+	// GitHub groups are org*team, while services name their team by slug
+	teams := make([]string, 0, len(userData.Groups))
+	for _, group := range userData.Groups {
+		teams = append(teams, group)
+		if _, slug, found := strings.Cut(group, "*"); found {
+			teams = append(teams, slug)
+		}
+	}
+
 	return &scModels.UserContext{
-		Username: "test-user",
-		Name:     "Test User",
-		Email:    "test@example.com",
-		Teams:    []string{"test-team"},
+		Username:    userData.Username,
+		Name:        userData.Username,
+		Email:       userData.Email,
+		Teams:       teams,
+		Permissions: userData.Permissions,
 	}, nil
 }
 
@@ -5,9 +5,17 @@ import (
 	"regexp"
 	"strings"
 
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 	scModels "github.com/dash-ops/dash-ops/pkg/service-catalog/models"
 )
 
+// serviceRBACPermissions maps service operations to their central RBAC permissions
+var serviceRBACPermissions = map[string]string{
+	"create": scModels.RBACServiceCreate,
+	"update": scModels.RBACServiceEdit,
+	"delete": scModels.RBACServiceDelete,
+}
+
 // ServiceValidator provides service validation logic
 type ServiceValidator struct{}
 
@@ -320,6 +328,15 @@ func (sv *ServiceValidator) ValidateUserPermissions(service *scModels.Service, u
 		return fmt.Errorf("user context is required")
 	}
 
+	// Central roles replace team ownership when configured
+	if user.HasRBAC() {
+		rbacPermission, ok := serviceRBACPermissions[operation]
+		if ok && !user.Permissions.Allows(rbacPermission, commonsModels.PermissionScope{Service: service.Metadata.Name}) {
+			return fmt.Errorf("user does not have permission to %s service '%s'", operation, service.Metadata.Name)
+		}
+		return nil
+	}
+
 	// For write operations, check team membership
 	if operation == "create" || operation == "update" || operation == "delete" {
 		if !service.CanBeModifiedBy(user.Teams) {
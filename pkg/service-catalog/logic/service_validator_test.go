@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 	scModels "github.com/dash-ops/dash-ops/pkg/service-catalog/models"
 )
 
@@ -419,3 +420,26 @@ func TestServiceValidator_validateMemorySpec_WithEmptyMemory_ReturnsError(t *tes
 	// Assert
 	assert.Error(t, err)
 }
+
+func TestServiceValidator_ValidateUserPermissions_WithRBAC_UsesGrantsScopedToService(t *testing.T) {
+	// Arrange
+	validator := NewServiceValidator()
+	service := &scModels.Service{
+		Metadata: scModels.ServiceMetadata{Name: "payments-api"},
+		Spec:     scModels.ServiceSpec{Team: scModels.ServiceTeam{GitHubTeam: "payments"}},
+	}
+	user := &scModels.UserContext{
+		Teams: []string{"payments"},
+		Permissions: &commonsModels.Permissions{Grants: []commonsModels.PermissionGrant{
+			{Permission: "catalog:service:edit", Scope: commonsModels.PermissionScope{Service: "payments-*"}},
+		}},
+	}
+
+	// Act
+	updateErr := validator.ValidateUserPermissions(service, user, "update")
+	deleteErr := validator.ValidateUserPermissions(service, user, "delete")
+
+	// Assert
+	assert.NoError(t, updateErr)
+	assert.Error(t, deleteErr, "team ownership no longer grants deletes once RBAC is configured")
+}
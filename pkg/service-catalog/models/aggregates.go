@@ -3,6 +3,8 @@ package models
 import (
 	"strings"
 	"time"
+
+	commonsModels "github.com/dash-ops/dash-ops/pkg/commons/models"
 )
 
 // ServiceList represents a list of services for API responses
@@ -85,6 +87,14 @@ type UserContext struct {
 	Name     string   `json:"name"`
 	Email    string   `json:"email"`
 	Teams    []string `json:"teams,omitempty"`
+
+	// Permissions of the user's central roles; nil when RBAC is not configured
+	Permissions *commonsModels.Permissions `json:"-"`
+}
+
+// HasRBAC checks if service changes are authorized by central roles instead of team ownership
+func (uc *UserContext) HasRBAC() bool {
+	return uc != nil && uc.Permissions != nil
 }
 
 // Methods for ServiceList
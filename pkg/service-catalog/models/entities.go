@@ -53,6 +53,13 @@ type ServiceSpec struct {
 // ServiceTier represents service business tier
 type ServiceTier string
 
+// Permissions of the service catalog in the central RBAC model, scoped by service name
+const (
+	RBACServiceCreate = "catalog:service:create"
+	RBACServiceEdit   = "catalog:service:edit"
+	RBACServiceDelete = "catalog:service:delete"
+)
+
 const (
 	TierCritical  ServiceTier = "TIER-1"
 	TierImportant ServiceTier = "TIER-2"